@@ -0,0 +1,527 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// fanOutTarget is one app/profile combination of a --apps/--profiles run.
+type fanOutTarget struct {
+	App     string
+	Profile string
+}
+
+func (t fanOutTarget) label() string {
+	var parts []string
+	if t.App != "" {
+		parts = append(parts, "app "+t.App)
+	}
+	if t.Profile != "" {
+		parts = append(parts, "profile "+t.Profile)
+	}
+	return strings.Join(parts, " ")
+}
+
+// fanOutRun is the captured result of one child process.
+type fanOutRun struct {
+	stdout  []byte
+	stderr  []byte
+	err     error
+	elapsed time.Duration
+}
+
+// fanOutExec runs asc with args in a child process. Children keep flag
+// parsing, credentials, and stdout separate so targets can run concurrently.
+var fanOutExec = func(ctx context.Context, args []string, env []string) fanOutRun {
+	executable, err := os.Executable()
+	if err != nil {
+		return fanOutRun{err: err}
+	}
+	var stdout, stderr bytes.Buffer
+	child := exec.CommandContext(ctx, executable, args...)
+	child.Env = append(os.Environ(), env...)
+	child.Stdout = &stdout
+	child.Stderr = &stderr
+	err = child.Run()
+	return fanOutRun{stdout: stdout.Bytes(), stderr: stderr.Bytes(), err: err}
+}
+
+// fanOutRootFlags are root flags handled by the parent and never passed to
+// children.
+var fanOutRootFlags = map[string]bool{
+	"apps":        true,
+	"profiles":    true,
+	"parallel":    true,
+	"report":      true,
+	"report-file": true,
+}
+
+// runFanOut runs the selected command once per --apps/--profiles target and
+// merges the results. Table, markdown, and CSV output gain App and Profile
+// columns; JSON wraps each target's output in a result; NDJSON adds app and
+// profile keys to every record. Failed targets make the whole run fail.
+func runFanOut(ctx context.Context, root *ffcli.Command, args []string) error {
+	leaf, path := resolveCommand(root, args)
+	commandName := strings.Join(path, " ")
+	if leaf.FlagSet == nil || leaf.FlagSet.Lookup("output") == nil {
+		return shared.UsageErrorf("%s does not support --apps or --profiles (it has no --output flag)", commandName)
+	}
+	selectors := shared.FanOutAppSelectors()
+	if len(selectors) > 0 {
+		if leaf.FlagSet.Lookup("app") == nil {
+			return shared.UsageErrorf("%s does not take --app, so it cannot run per app with --apps", commandName)
+		}
+		appSet := false
+		leaf.FlagSet.Visit(func(f *flag.Flag) {
+			if f.Name == "app" {
+				appSet = true
+			}
+		})
+		if appSet {
+			return shared.UsageError("--app and --apps are mutually exclusive")
+		}
+	}
+
+	format, pretty := commandOutputFormat(root, args)
+	format = strings.ToLower(format)
+	var childFormat string
+	switch format {
+	case "json":
+		childFormat = "json"
+	case "ndjson":
+		childFormat = "ndjson"
+	case "table", "markdown", "md", "csv":
+		childFormat = "csv"
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+	if pretty && format != "json" {
+		return fmt.Errorf("--pretty is only valid with JSON output")
+	}
+
+	targets, err := resolveFanOutTargets(ctx, selectors)
+	if err != nil {
+		return err
+	}
+
+	runs := make([]fanOutRun, len(targets))
+	var (
+		wg       sync.WaitGroup
+		stderrMu sync.Mutex
+		slots    = make(chan struct{}, shared.FanOutParallel())
+	)
+	for i, target := range targets {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			var env []string
+			if target.App != "" {
+				env = append(env, "ASC_APP_ID="+target.App)
+			}
+			started := time.Now()
+			run := fanOutExec(ctx, fanOutChildArgs(root, args, target, childFormat), env)
+			run.elapsed = time.Since(started)
+			runs[i] = run
+
+			stderrMu.Lock()
+			defer stderrMu.Unlock()
+			writePrefixedLines(run.stderr, "["+target.label()+"] ")
+		}()
+	}
+	wg.Wait()
+
+	results := make([]asc.FanOutResult, len(targets))
+	for i, target := range targets {
+		results[i] = asc.FanOutResult{App: target.App, Profile: target.Profile, Status: asc.FanOutStatusSuccess}
+		if runs[i].err != nil {
+			results[i].Status = asc.FanOutStatusFailure
+			results[i].Error = fanOutError(runs[i]).Error()
+		}
+	}
+
+	switch childFormat {
+	case "json":
+		err = printFanOutJSON(commandName, results, runs, pretty)
+	case "ndjson":
+		err = printFanOutNDJSON(targets, results, runs)
+	default:
+		err = printFanOutRows(format, targets, results, runs)
+	}
+	if err != nil {
+		return err
+	}
+
+	cases := make([]shared.JUnitTestCase, len(targets))
+	var failed []string
+	for i, target := range targets {
+		cases[i] = shared.JUnitTestCase{
+			Name:      target.label(),
+			Classname: commandName,
+			Time:      runs[i].elapsed,
+			SystemErr: string(runs[i].stderr),
+		}
+		if results[i].Status == asc.FanOutStatusFailure {
+			cases[i].Failure = "ERROR"
+			cases[i].Message = results[i].Error
+			failed = append(failed, fmt.Sprintf("%s: %s", target.label(), results[i].Error))
+		}
+	}
+	shared.SetReportTestCases(cases)
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%d of %d runs failed: %s", len(failed), len(targets), strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// fanOutChildArgs rewrites args for one target: fan-out and report flags are
+// dropped, the target's --profile and --app are set, and the command's
+// --output is replaced by format.
+func fanOutChildArgs(root *ffcli.Command, args []string, target fanOutTarget, format string) []string {
+	childArgs := []string{"--no-update"}
+	if target.Profile != "" {
+		childArgs = append(childArgs, "--profile", target.Profile)
+	}
+
+	leaf, _ := resolveCommand(root, args)
+	current := root
+	i := 0
+	for i < len(args) && current != leaf {
+		token := args[i]
+		if sub := findDirectSubcommand(current, token); sub != nil {
+			childArgs = append(childArgs, token)
+			current = sub
+			i++
+			continue
+		}
+		next, consumed := consumeFlagToken(current.FlagSet, token, args, i)
+		if !consumed {
+			break
+		}
+		if current != root || !fanOutRootFlags[flagTokenName(token)] {
+			childArgs = append(childArgs, args[i:next]...)
+		}
+		i = next
+	}
+
+	if target.App != "" {
+		childArgs = append(childArgs, "--app", target.App)
+	}
+	childArgs = append(childArgs, "--output", format)
+
+	// Drop the command's own --output and --pretty (and --app when it is
+	// replaced); everything from the first positional argument on is passed
+	// through unchanged.
+	for i < len(args) {
+		token := args[i]
+		next, consumed := consumeFlagToken(current.FlagSet, token, args, i)
+		if !consumed || token == "--" {
+			break
+		}
+		name := flagTokenName(token)
+		if name != "output" && name != "pretty" && (name != "app" || target.App == "") {
+			childArgs = append(childArgs, args[i:next]...)
+		}
+		i = next
+	}
+	return append(childArgs, args[i:]...)
+}
+
+func flagTokenName(token string) string {
+	name, _ := splitFlagToken(strings.TrimLeft(token, "-"))
+	return name
+}
+
+// resolveFanOutTargets expands --profiles and --apps into targets. "all" and
+// bundle-prefix: selectors list each profile's apps; explicit IDs are only
+// checked against those lists when several profiles are selected, to run
+// each app under the profile that can see it.
+func resolveFanOutTargets(ctx context.Context, selectors []string) ([]fanOutTarget, error) {
+	profiles := shared.FanOutProfiles()
+	if len(profiles) == 0 {
+		profiles = []string{""}
+	}
+	if len(selectors) == 0 {
+		targets := make([]fanOutTarget, 0, len(profiles))
+		for _, profile := range profiles {
+			targets = append(targets, fanOutTarget{Profile: profile})
+		}
+		return targets, nil
+	}
+
+	needList := len(profiles) > 1
+	for _, selector := range selectors {
+		if selector == shared.FanOutAllApps || strings.HasPrefix(selector, shared.FanOutBundlePrefix) {
+			needList = true
+		}
+	}
+
+	var targets []fanOutTarget
+	found := map[string]bool{}
+	for _, profile := range profiles {
+		if !needList {
+			for _, selector := range selectors {
+				targets = append(targets, fanOutTarget{App: selector, Profile: profile})
+			}
+			continue
+		}
+		apps, err := listFanOutApps(ctx, profile)
+		if err != nil {
+			if profile != "" {
+				return nil, fmt.Errorf("list apps for profile %q: %w", profile, err)
+			}
+			return nil, fmt.Errorf("list apps: %w", err)
+		}
+		for _, app := range apps {
+			if matchesFanOutSelectors(selectors, app) {
+				found[app.ID] = true
+				targets = append(targets, fanOutTarget{App: app.ID, Profile: profile})
+			}
+		}
+	}
+
+	if needList {
+		for _, selector := range selectors {
+			if selector != shared.FanOutAllApps && !strings.HasPrefix(selector, shared.FanOutBundlePrefix) && !found[selector] {
+				return nil, fmt.Errorf("app %q was not found in any selected profile", selector)
+			}
+		}
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("--apps %s matched no apps", strings.Join(selectors, ","))
+	}
+	return targets, nil
+}
+
+// fanOutApp is the part of an app listing used to match --apps selectors.
+type fanOutApp struct {
+	ID       string
+	BundleID string
+}
+
+func listFanOutApps(ctx context.Context, profile string) ([]fanOutApp, error) {
+	args := []string{"--no-update"}
+	if profile != "" {
+		args = append(args, "--profile", profile)
+	}
+	args = append(args, "apps", "list", "--paginate", "--output", "json")
+	run := fanOutExec(ctx, args, nil)
+	if run.err != nil {
+		return nil, fanOutError(run)
+	}
+
+	var response struct {
+		Data []struct {
+			ID         string `json:"id"`
+			Attributes struct {
+				BundleID string `json:"bundleId"`
+			} `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(run.stdout, &response); err != nil {
+		return nil, fmt.Errorf("parse apps list output: %w", err)
+	}
+	apps := make([]fanOutApp, 0, len(response.Data))
+	for _, item := range response.Data {
+		apps = append(apps, fanOutApp{ID: item.ID, BundleID: item.Attributes.BundleID})
+	}
+	return apps, nil
+}
+
+func matchesFanOutSelectors(selectors []string, app fanOutApp) bool {
+	for _, selector := range selectors {
+		switch {
+		case selector == shared.FanOutAllApps:
+			return true
+		case strings.HasPrefix(selector, shared.FanOutBundlePrefix):
+			prefix := strings.TrimSuffix(strings.TrimPrefix(selector, shared.FanOutBundlePrefix), "*")
+			if strings.HasPrefix(app.BundleID, prefix) {
+				return true
+			}
+		case selector == app.ID:
+			return true
+		}
+	}
+	return false
+}
+
+// fanOutError describes a failed child. Children print their own errors, so
+// the message is taken from the last "Error:" line of stderr.
+func fanOutError(run fanOutRun) error {
+	lines := strings.Split(strings.TrimSpace(string(run.stderr)), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if message, ok := strings.CutPrefix(strings.TrimSpace(lines[i]), "Error:"); ok {
+			return fmt.Errorf("%s", strings.TrimSpace(message))
+		}
+	}
+	return run.err
+}
+
+func writePrefixedLines(data []byte, prefix string) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		fmt.Fprintf(os.Stderr, "%s%s\n", prefix, scanner.Text())
+	}
+}
+
+func printFanOutJSON(commandName string, results []asc.FanOutResult, runs []fanOutRun, pretty bool) error {
+	for i := range results {
+		output := bytes.TrimSpace(runs[i].stdout)
+		if len(output) == 0 {
+			continue
+		}
+		if json.Valid(output) {
+			results[i].Output = json.RawMessage(output)
+			continue
+		}
+		encoded, _ := json.Marshal(string(output))
+		results[i].Output = encoded
+	}
+	report := &asc.FanOutReport{Command: commandName, Results: results}
+	if pretty {
+		return asc.PrintPrettyJSON(report)
+	}
+	return asc.PrintJSON(report)
+}
+
+// printFanOutNDJSON writes every record of every successful target with app
+// and profile keys prepended.
+func printFanOutNDJSON(targets []fanOutTarget, results []asc.FanOutResult, runs []fanOutRun) error {
+	for i, target := range targets {
+		if results[i].Status != asc.FanOutStatusSuccess {
+			continue
+		}
+		var prefix strings.Builder
+		if target.App != "" {
+			key, _ := json.Marshal(target.App)
+			prefix.WriteString(`"app":` + string(key) + ",")
+		}
+		if target.Profile != "" {
+			key, _ := json.Marshal(target.Profile)
+			prefix.WriteString(`"profile":` + string(key) + ",")
+		}
+		for _, line := range bytes.Split(runs[i].stdout, []byte("\n")) {
+			line = bytes.TrimSpace(line)
+			if len(line) == 0 {
+				continue
+			}
+			if line[0] != '{' || !json.Valid(line) {
+				results[i].Status = asc.FanOutStatusFailure
+				results[i].Error = "unexpected non-NDJSON output"
+				break
+			}
+			record := bytes.TrimSpace(line[1:])
+			fields := prefix.String()
+			if bytes.Equal(record, []byte("}")) {
+				fields = strings.TrimSuffix(fields, ",")
+			}
+			if _, err := fmt.Fprintf(os.Stdout, "{%s%s\n", fields, record); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// printFanOutRows merges the CSV output of every successful target into one
+// table with App and Profile columns. Columns missing from a target's output
+// are left empty.
+func printFanOutRows(format string, targets []fanOutTarget, results []asc.FanOutResult, runs []fanOutRun) error {
+	withApp := len(shared.FanOutAppSelectors()) > 0
+	withProfile := len(shared.FanOutProfiles()) > 0
+	var headers []string
+	if withApp {
+		headers = append(headers, "App")
+	}
+	if withProfile {
+		headers = append(headers, "Profile")
+	}
+	columns := make(map[string]int, len(headers))
+	for i, header := range headers {
+		columns[header] = i
+	}
+
+	type mergedRow struct {
+		target fanOutTarget
+		values map[int]string
+	}
+	var merged []mergedRow
+	for i, target := range targets {
+		if results[i].Status != asc.FanOutStatusSuccess || len(bytes.TrimSpace(runs[i].stdout)) == 0 {
+			continue
+		}
+		reader := csv.NewReader(bytes.NewReader(runs[i].stdout))
+		reader.FieldsPerRecord = -1
+		records, err := reader.ReadAll()
+		if err != nil || len(records) == 0 {
+			results[i].Status = asc.FanOutStatusFailure
+			results[i].Error = "unexpected non-CSV output"
+			continue
+		}
+		index := make([]int, len(records[0]))
+		for j, header := range records[0] {
+			column, ok := columns[header]
+			if !ok {
+				column = len(headers)
+				columns[header] = column
+				headers = append(headers, header)
+			}
+			index[j] = column
+		}
+		for _, record := range records[1:] {
+			values := make(map[int]string, len(record))
+			for j, value := range record {
+				if j < len(index) {
+					values[index[j]] = value
+				}
+			}
+			merged = append(merged, mergedRow{target: target, values: values})
+		}
+	}
+
+	rows := make([][]string, 0, len(merged))
+	for _, m := range merged {
+		row := make([]string, len(headers))
+		for column, value := range m.values {
+			row[column] = value
+		}
+		column := 0
+		if withApp {
+			row[column] = m.target.App
+			column++
+		}
+		if withProfile {
+			row[column] = m.target.Profile
+		}
+		rows = append(rows, row)
+	}
+
+	switch format {
+	case "table":
+		asc.RenderTable(headers, rows)
+	case "csv":
+		return asc.RenderCSV(headers, rows)
+	default:
+		asc.RenderMarkdown(headers, rows)
+	}
+	return nil
+}
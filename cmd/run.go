@@ -11,6 +11,7 @@ import (
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared/errfmt"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/update"
@@ -35,6 +36,18 @@ func Run(args []string, versionInfo string) int {
 		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
 		return ExitUsage
 	}
+	if err := shared.ValidateOutputSelectionFlags(); err != nil {
+		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
+		return ExitUsage
+	}
+	if err := shared.ValidateCassetteFlags(); err != nil {
+		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
+		return ExitUsage
+	}
+	if err := shared.ValidateFanOutFlags(); err != nil {
+		fmt.Fprint(os.Stderr, errfmt.FormatStderr(err))
+		return ExitUsage
+	}
 
 	if versionRequested {
 		if err := root.Run(context.Background()); err != nil {
@@ -68,7 +81,7 @@ func Run(args []string, versionInfo string) int {
 	}
 
 	start := time.Now()
-	runErr := root.Run(context.Background())
+	runErr := runRoot(context.Background(), root, args)
 	elapsed := time.Since(start)
 
 	// Get command name (full subcommand path)
@@ -100,10 +113,86 @@ func Run(args []string, versionInfo string) int {
 	return ExitSuccess
 }
 
+// runRoot runs the parsed command. With --record or --replay, HTTP traffic is
+// captured to or served from a cassette. With --simulate, mutating API
+// requests are recorded instead of sent and the plan is printed to stderr,
+// leaving the command's own output (including GET results) on stdout. With
+// --apps or --profiles, the command runs once per target in child processes
+// and their results are merged.
+func runRoot(ctx context.Context, root *ffcli.Command, args []string) error {
+	shared.ResetResolvedRefs()
+	if shared.FanOutEnabled() {
+		return runFanOut(ctx, root, args)
+	}
+
+	stopCassette, err := shared.StartCassette()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := stopCassette(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to close cassette: %v\n", err)
+		}
+	}()
+
+	if !shared.SimulateEnabled() {
+		return root.Run(ctx)
+	}
+
+	plan := &asc.SimulationPlan{}
+	asc.SetSimulationPlan(plan)
+	defer asc.SetSimulationPlan(nil)
+
+	runErr := root.Run(ctx)
+	if len(plan.Operations()) == 0 {
+		return runErr
+	}
+
+	format, pretty := commandOutputFormat(root, args)
+	if err := printToStderr(func() error {
+		return shared.PrintSimulationPlan(plan.Report(), format, pretty)
+	}); err != nil {
+		return err
+	}
+	return runErr
+}
+
+// printToStderr runs fn with os.Stdout pointed at os.Stderr, so output
+// helpers that write to stdout can be reused for diagnostics.
+func printToStderr(fn func() error) error {
+	original := os.Stdout
+	os.Stdout = os.Stderr
+	defer func() { os.Stdout = original }()
+	return fn()
+}
+
+// commandOutputFormat returns the --output and --pretty values of the
+// command selected by args, falling back to the default output format.
+func commandOutputFormat(root *ffcli.Command, args []string) (string, bool) {
+	cmd, _ := resolveCommand(root, args)
+	format, pretty := shared.DefaultOutputFormat(), false
+	if cmd == nil || cmd.FlagSet == nil {
+		return format, pretty
+	}
+	if f := cmd.FlagSet.Lookup("output"); f != nil {
+		format = f.Value.String()
+	}
+	if f := cmd.FlagSet.Lookup("pretty"); f != nil {
+		pretty = f.Value.String() == "true"
+	}
+	return format, pretty
+}
+
 // getCommandName extracts the full subcommand path from the parsed args.
 // args is os.Args[1:] (without program name).
-// It finds the first token matching a known subcommand name, then walks the tree.
 func getCommandName(root *ffcli.Command, args []string) string {
+	_, path := resolveCommand(root, args)
+	return strings.Join(path, " ")
+}
+
+// resolveCommand finds the first token matching a known subcommand name, then
+// walks the tree. It returns the deepest matching command and its path.
+func resolveCommand(root *ffcli.Command, args []string) (*ffcli.Command, []string) {
 	current := root
 	path := []string{current.Name}
 
@@ -136,7 +225,7 @@ func getCommandName(root *ffcli.Command, args []string) string {
 		break
 	}
 
-	return strings.Join(path, " ")
+	return current, path
 }
 
 func findDirectSubcommand(current *ffcli.Command, token string) *ffcli.Command {
@@ -215,6 +304,9 @@ func writeJUnitReport(commandName string, runErr error, elapsed time.Duration) e
 		Timestamp: time.Now(),
 		Name:      "asc",
 	}
+	if cases := shared.ReportTestCases(); len(cases) > 0 {
+		report.Tests = cases
+	}
 
 	return report.Write(reportFile)
 }
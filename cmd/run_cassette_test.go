@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun_ReplayServesCassetteWithoutCredentials(t *testing.T) {
+	t.Setenv("ASC_NO_UPDATE", "1")
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_KEY_ID", "")
+	t.Setenv("ASC_ISSUER_ID", "")
+	t.Setenv("ASC_PRIVATE_KEY_PATH", "")
+	t.Setenv("ASC_CACHE_TTL", "")
+
+	cassette := filepath.Join(t.TempDir(), "apps.jsonl")
+	entry := `{"request":{"method":"GET","url":"https://api.appstoreconnect.apple.com/v1/apps?limit=1"},` +
+		`"response":{"status":200,"headers":{"Content-Type":["application/json"]},"body":{"data":[{"type":"apps","id":"replayed-app","attributes":{"name":"Replayed"}}]}}}` + "\n"
+	if err := os.WriteFile(cassette, []byte(entry), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	var code int
+	stdout, stderr := captureCommandOutput(t, func() {
+		code = Run([]string{"--replay", cassette, "apps", "list", "--limit", "1"}, "1.0.0")
+	})
+	if code != ExitSuccess {
+		t.Fatalf("expected success, got %d (stderr %q)", code, stderr)
+	}
+	if !strings.Contains(stdout, "replayed-app") {
+		t.Fatalf("expected replayed response, got %q", stdout)
+	}
+}
+
+func TestRun_RecordAndReplayAreMutuallyExclusive(t *testing.T) {
+	t.Setenv("ASC_NO_UPDATE", "1")
+	cassette := filepath.Join(t.TempDir(), "c.jsonl")
+	if err := os.WriteFile(cassette, nil, 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	var code int
+	_, stderr := captureCommandOutput(t, func() {
+		code = Run([]string{"--record", cassette, "--replay", cassette, "apps", "list"}, "1.0.0")
+	})
+	if code != ExitUsage {
+		t.Fatalf("expected usage exit, got %d", code)
+	}
+	if !strings.Contains(stderr, "mutually exclusive") {
+		t.Fatalf("expected mutually exclusive error, got %q", stderr)
+	}
+}
@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// fanOutTestRoot builds a root with "builds latest" (takes --app) and
+// "apps list" (does not).
+func fanOutTestRoot() *ffcli.Command {
+	exec := func(context.Context, []string) error {
+		return errors.New("fan-out must not run the command in-process")
+	}
+	latestFS := flag.NewFlagSet("builds latest", flag.ContinueOnError)
+	latestFS.String("app", "", "App ID")
+	latestFS.String("output", "json", "Output format")
+	latestFS.Bool("pretty", false, "Pretty-print JSON output")
+	latestFS.String("platform", "", "Platform")
+	listFS := flag.NewFlagSet("apps list", flag.ContinueOnError)
+	listFS.String("output", "json", "Output format")
+
+	root := &ffcli.Command{
+		Name:    "asc",
+		FlagSet: flag.NewFlagSet("asc", flag.ContinueOnError),
+		Subcommands: []*ffcli.Command{
+			{
+				Name:        "builds",
+				FlagSet:     flag.NewFlagSet("builds", flag.ContinueOnError),
+				Subcommands: []*ffcli.Command{{Name: "latest", FlagSet: latestFS, Exec: exec}},
+			},
+			{
+				Name:        "apps",
+				FlagSet:     flag.NewFlagSet("apps", flag.ContinueOnError),
+				Subcommands: []*ffcli.Command{{Name: "list", FlagSet: listFS, Exec: exec}},
+			},
+		},
+	}
+	shared.BindRootFlags(root.FlagSet)
+	return root
+}
+
+// stubFanOutExec replaces child processes with fn and records every call.
+func stubFanOutExec(t *testing.T, fn func(args []string) fanOutRun) *[][]string {
+	t.Helper()
+	var (
+		mu    sync.Mutex
+		calls [][]string
+	)
+	previous := fanOutExec
+	fanOutExec = func(_ context.Context, args []string, _ []string) fanOutRun {
+		mu.Lock()
+		calls = append(calls, args)
+		mu.Unlock()
+		return fn(args)
+	}
+	t.Cleanup(func() {
+		fanOutExec = previous
+		shared.SetFanOut("", "", shared.DefaultFanOutParallel)
+		shared.SetReportTestCases(nil)
+	})
+	return &calls
+}
+
+func argValue(args []string, name string) string {
+	for i, arg := range args {
+		if arg == name && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+func TestFanOutChildArgs(t *testing.T) {
+	root := fanOutTestRoot()
+	args := []string{"--apps", "1,2", "--parallel", "2", "--debug", "builds", "latest", "--output", "table", "--platform", "IOS", "--pretty"}
+	if err := root.Parse(args); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	got := fanOutChildArgs(root, args, fanOutTarget{App: "1", Profile: "work"}, "csv")
+	want := []string{"--no-update", "--profile", "work", "--debug", "builds", "latest", "--app", "1", "--output", "csv", "--platform", "IOS"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("fanOutChildArgs() = %q, want %q", got, want)
+	}
+}
+
+func TestFanOutChildArgs_KeepsAppWithProfilesOnly(t *testing.T) {
+	root := fanOutTestRoot()
+	args := []string{"--profiles", "a,b", "builds", "latest", "--app=42"}
+	if err := root.Parse(args); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	got := fanOutChildArgs(root, args, fanOutTarget{Profile: "a"}, "json")
+	want := []string{"--no-update", "--profile", "a", "builds", "latest", "--output", "json", "--app=42"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("fanOutChildArgs() = %q, want %q", got, want)
+	}
+}
+
+func TestRunFanOut_MergesTableRowsAndAggregatesErrors(t *testing.T) {
+	calls := stubFanOutExec(t, func(args []string) fanOutRun {
+		switch argValue(args, "--app") {
+		case "1":
+			return fanOutRun{stdout: []byte("Version,Build\n1.0,10\n")}
+		case "2":
+			return fanOutRun{stdout: []byte("Version,Build\n2.0,20\n2.0,19\n")}
+		default:
+			return fanOutRun{stderr: []byte("Error: no builds found\n"), err: errors.New("exit status 1")}
+		}
+	})
+	root := fanOutTestRoot()
+	args := []string{"--apps", "1,2,3", "builds", "latest", "--output", "csv"}
+	if err := root.Parse(args); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	var runErr error
+	stdout, stderr := captureCommandOutput(t, func() {
+		runErr = runRoot(context.Background(), root, args)
+	})
+
+	if len(*calls) != 3 {
+		t.Fatalf("expected 3 child runs, got %d", len(*calls))
+	}
+	wantStdout := "App,Version,Build\n1,1.0,10\n2,2.0,20\n2,2.0,19\n"
+	if stdout != wantStdout {
+		t.Fatalf("stdout = %q, want %q", stdout, wantStdout)
+	}
+	if runErr == nil || !strings.Contains(runErr.Error(), "1 of 3 runs failed: app 3: no builds found") {
+		t.Fatalf("expected aggregated error, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "[app 3] Error: no builds found") {
+		t.Fatalf("expected prefixed child stderr, got %q", stderr)
+	}
+	cases := shared.ReportTestCases()
+	if len(cases) != 3 || cases[2].Failure == "" || cases[0].Failure != "" {
+		t.Fatalf("expected one JUnit case per target, got %+v", cases)
+	}
+}
+
+func TestRunFanOut_JSONWrapsOutputPerTarget(t *testing.T) {
+	stubFanOutExec(t, func(args []string) fanOutRun {
+		return fanOutRun{stdout: []byte(`{"data":{"id":"` + argValue(args, "--profile") + `"}}`)}
+	})
+	root := fanOutTestRoot()
+	args := []string{"--profiles", "a,b", "--parallel", "1", "builds", "latest"}
+	if err := root.Parse(args); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	stdout, _ := captureCommandOutput(t, func() {
+		if err := runRoot(context.Background(), root, args); err != nil {
+			t.Fatalf("runRoot() error: %v", err)
+		}
+	})
+
+	var report asc.FanOutReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("expected JSON report, got %q: %v", stdout, err)
+	}
+	if len(report.Results) != 2 || report.Results[1].Profile != "b" || string(report.Results[1].Output) != `{"data":{"id":"b"}}` {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+}
+
+func TestRunFanOut_RejectsCommandsWithoutAppFlag(t *testing.T) {
+	stubFanOutExec(t, func([]string) fanOutRun {
+		t.Error("no child should run")
+		return fanOutRun{}
+	})
+	root := fanOutTestRoot()
+	args := []string{"--apps", "1", "apps", "list"}
+	if err := root.Parse(args); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	var runErr error
+	_, stderr := captureCommandOutput(t, func() {
+		runErr = runRoot(context.Background(), root, args)
+	})
+	if !errors.Is(runErr, flag.ErrHelp) || !strings.Contains(stderr, "does not take --app") {
+		t.Fatalf("expected usage error, got %v (stderr %q)", runErr, stderr)
+	}
+}
+
+func TestResolveFanOutTargets_ListsAppsPerProfile(t *testing.T) {
+	stubFanOutExec(t, func(args []string) fanOutRun {
+		apps := map[string]string{
+			"a": `{"data":[{"id":"1","attributes":{"bundleId":"com.acme.one"}},{"id":"2","attributes":{"bundleId":"com.other.two"}}]}`,
+			"b": `{"data":[{"id":"3","attributes":{"bundleId":"com.acme.three"}}]}`,
+		}
+		return fanOutRun{stdout: []byte(apps[argValue(args, "--profile")])}
+	})
+
+	shared.SetFanOut("bundle-prefix:com.acme.*", "a,b", 2)
+	targets, err := resolveFanOutTargets(context.Background(), shared.FanOutAppSelectors())
+	if err != nil {
+		t.Fatalf("resolveFanOutTargets() error: %v", err)
+	}
+	want := []fanOutTarget{{App: "1", Profile: "a"}, {App: "3", Profile: "b"}}
+	if !slices.Equal(targets, want) {
+		t.Fatalf("targets = %+v, want %+v", targets, want)
+	}
+
+	shared.SetFanOut("2,9", "a,b", 2)
+	if _, err := resolveFanOutTargets(context.Background(), shared.FanOutAppSelectors()); err == nil || !strings.Contains(err.Error(), `app "9" was not found`) {
+		t.Fatalf("expected unknown app error, got %v", err)
+	}
+}
+
+func TestValidateFanOutFlags(t *testing.T) {
+	t.Cleanup(func() {
+		shared.SetFanOut("", "", shared.DefaultFanOutParallel)
+		shared.SetSelectedProfile("")
+	})
+	tests := []struct {
+		name     string
+		apps     string
+		profiles string
+		parallel int
+		profile  string
+		wantErr  string
+	}{
+		{name: "disabled", parallel: shared.DefaultFanOutParallel},
+		{name: "ids", apps: "1,2", parallel: 4},
+		{name: "parallel too low", apps: "1", parallel: 0, wantErr: "--parallel"},
+		{name: "all with ids", apps: "all,1", parallel: 4, wantErr: "--apps all"},
+		{name: "empty prefix", apps: "bundle-prefix:*", parallel: 4, wantErr: "needs a bundle ID prefix"},
+		{name: "profile and profiles", profiles: "a", profile: "b", parallel: 4, wantErr: "mutually exclusive"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shared.SetFanOut(tt.apps, tt.profiles, tt.parallel)
+			shared.SetSelectedProfile(tt.profile)
+			err := shared.ValidateFanOutFlags()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
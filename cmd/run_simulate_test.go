@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type simulateRoundTripper func(*http.Request) (*http.Response, error)
+
+func (fn simulateRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return fn(req)
+}
+
+func writeSimulateTestKey(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "AuthKey.p8")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	return path
+}
+
+// simulateTestRoot builds a root whose "widgets create" command looks up a
+// widget, creates another, and prints the created ID.
+func simulateTestRoot(t *testing.T, sent *[]string) *ffcli.Command {
+	t.Helper()
+	keyPath := writeSimulateTestKey(t)
+	transport := simulateRoundTripper(func(req *http.Request) (*http.Response, error) {
+		*sent = append(*sent, req.Method+" "+req.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"data":[{"type":"apps","id":"app-1"}]}`)),
+		}, nil
+	})
+
+	createFS := flag.NewFlagSet("widgets create", flag.ContinueOnError)
+	output := createFS.String("output", "json", "Output format")
+	root := &ffcli.Command{
+		Name:    "asc",
+		FlagSet: flag.NewFlagSet("asc", flag.ContinueOnError),
+		Subcommands: []*ffcli.Command{{
+			Name: "widgets",
+			Subcommands: []*ffcli.Command{{
+				Name:    "create",
+				FlagSet: createFS,
+				Exec: func(ctx context.Context, args []string) error {
+					client, err := asc.NewClientWithHTTPClient("KEY", "ISSUER", keyPath, &http.Client{Transport: transport})
+					if err != nil {
+						return err
+					}
+					if _, err := client.Do(ctx, http.MethodGet, "/v1/apps", nil); err != nil {
+						return err
+					}
+					body := `{"data":{"type":"betaGroups","attributes":{"name":"Beta","password":"hunter2"}}}`
+					resp, err := client.Do(ctx, http.MethodPost, "/v1/betaGroups", strings.NewReader(body))
+					if err != nil {
+						return err
+					}
+					fmt.Fprintf(os.Stdout, "created %s as %s\n", resp, *output)
+					return nil
+				},
+			}},
+		}},
+	}
+	shared.BindRootFlags(root.FlagSet)
+	return root
+}
+
+func TestRunRoot_SimulatePrintsPlanToStderr(t *testing.T) {
+	var sent []string
+	root := simulateTestRoot(t, &sent)
+	args := []string{"--simulate", "widgets", "create", "--output", "json"}
+	if err := root.Parse(args); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	stdout, stderr := captureCommandOutput(t, func() {
+		if err := runRoot(context.Background(), root, args); err != nil {
+			t.Fatalf("runRoot() error: %v", err)
+		}
+	})
+
+	if len(sent) != 1 || sent[0] != "GET /v1/apps" {
+		t.Fatalf("expected only the GET to be sent, got %v", sent)
+	}
+	if !strings.HasPrefix(stdout, "created ") {
+		t.Fatalf("expected command output to stay on stdout, got %q", stdout)
+	}
+	var report asc.SimulationReport
+	if err := json.Unmarshal([]byte(stderr), &report); err != nil {
+		t.Fatalf("expected JSON plan on stderr, got %q: %v", stderr, err)
+	}
+	if !report.Simulated || len(report.Operations) != 1 {
+		t.Fatalf("unexpected plan: %+v", report)
+	}
+	op := report.Operations[0]
+	if op.Method != http.MethodPost || op.Path != "/v1/betaGroups" {
+		t.Fatalf("unexpected operation: %+v", op)
+	}
+	if strings.Contains(string(op.Body), "hunter2") || !strings.Contains(string(op.Body), `"password":"[REDACTED]"`) {
+		t.Fatalf("expected password to be redacted, got %s", op.Body)
+	}
+}
+
+func TestRunRoot_WithoutSimulateSendsRequests(t *testing.T) {
+	var sent []string
+	root := simulateTestRoot(t, &sent)
+	args := []string{"widgets", "create"}
+	if err := root.Parse(args); err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	stdout, _ := captureCommandOutput(t, func() {
+		if err := runRoot(context.Background(), root, args); err != nil {
+			t.Fatalf("runRoot() error: %v", err)
+		}
+	})
+	if len(sent) != 2 || !strings.Contains(stdout, "created") {
+		t.Fatalf("expected both requests and command output, got sent=%v stdout=%q", sent, stdout)
+	}
+}
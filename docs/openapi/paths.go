@@ -0,0 +1,199 @@
+// Package openapi embeds the App Store Connect endpoint index (paths.txt) so
+// the CLI can validate and complete raw API paths offline.
+package openapi
+
+import (
+	_ "embed"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//go:embed paths.txt
+var pathsIndex string
+
+// Endpoint is one METHOD + path template from the index, e.g.
+// GET /v1/apps/{id}/builds.
+type Endpoint struct {
+	Method string
+	Path   string
+}
+
+func (e Endpoint) String() string {
+	return e.Method + " " + e.Path
+}
+
+var (
+	endpointsOnce sync.Once
+	endpoints     []Endpoint
+)
+
+// Endpoints returns every indexed endpoint, sorted by method and path.
+func Endpoints() []Endpoint {
+	endpointsOnce.Do(func() {
+		endpoints = parseIndex(pathsIndex)
+	})
+	return endpoints
+}
+
+func parseIndex(index string) []Endpoint {
+	var parsed []Endpoint
+	for _, line := range strings.Split(index, "\n") {
+		method, path, ok := strings.Cut(strings.TrimSpace(line), " ")
+		if !ok {
+			continue
+		}
+		parsed = append(parsed, Endpoint{Method: strings.ToUpper(method), Path: strings.TrimSpace(path)})
+	}
+	return parsed
+}
+
+// Match returns the endpoint whose template matches method and a concrete
+// path (without query string). Template parameters such as {id} match any
+// single non-empty segment.
+func Match(method, path string) (Endpoint, bool) {
+	method = strings.ToUpper(method)
+	segments := splitPath(path)
+	for _, endpoint := range Endpoints() {
+		if endpoint.Method == method && matchSegments(splitPath(endpoint.Path), segments) {
+			return endpoint, true
+		}
+	}
+	return Endpoint{}, false
+}
+
+// Methods returns the methods indexed for a concrete path, sorted.
+func Methods(path string) []string {
+	segments := splitPath(path)
+	seen := map[string]bool{}
+	var methods []string
+	for _, endpoint := range Endpoints() {
+		if !seen[endpoint.Method] && matchSegments(splitPath(endpoint.Path), segments) {
+			seen[endpoint.Method] = true
+			methods = append(methods, endpoint.Method)
+		}
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+// Suggest returns up to limit endpoints for method that best match path: the
+// longest run of matching leading segments, preferring endpoints whose next
+// segment is a near miss (e.g. "buildz" for "builds"). The leading version
+// segment alone is not considered a match.
+func Suggest(method, path string, limit int) []Endpoint {
+	method = strings.ToUpper(method)
+	segments := splitPath(path)
+	best := 2
+	var matches []Endpoint
+	for _, endpoint := range Endpoints() {
+		if method != "" && endpoint.Method != method {
+			continue
+		}
+		template := splitPath(endpoint.Path)
+		shared := sharedPrefix(template, segments)
+		score := shared * 2
+		if shared < len(template) && shared < len(segments) && similar(template[shared], segments[shared]) {
+			score++
+		}
+		switch {
+		case score > best:
+			best = score
+			matches = []Endpoint{endpoint}
+		case score == best && score > 2:
+			matches = append(matches, endpoint)
+		}
+	}
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// Complete returns the indexed paths for method that start with prefix. An
+// empty method matches any method.
+func Complete(method, prefix string) []string {
+	method = strings.ToUpper(method)
+	seen := map[string]bool{}
+	var paths []string
+	for _, endpoint := range Endpoints() {
+		if method != "" && endpoint.Method != method {
+			continue
+		}
+		if strings.HasPrefix(endpoint.Path, prefix) && !seen[endpoint.Path] {
+			seen[endpoint.Path] = true
+			paths = append(paths, endpoint.Path)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func isParam(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+func matchSegments(template, segments []string) bool {
+	if len(template) != len(segments) {
+		return false
+	}
+	for i, segment := range template {
+		if isParam(segment) {
+			if segments[i] == "" {
+				return false
+			}
+			continue
+		}
+		if segment != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sharedPrefix(template, segments []string) int {
+	count := 0
+	for i := 0; i < len(template) && i < len(segments); i++ {
+		if template[i] != segments[i] && !(isParam(template[i]) && segments[i] != "") {
+			break
+		}
+		count++
+	}
+	return count
+}
+
+// similar reports whether two path segments differ by a prefix or at most two
+// single-character edits.
+func similar(a, b string) bool {
+	if a == "" || b == "" || isParam(a) {
+		return false
+	}
+	if strings.HasPrefix(a, b) || strings.HasPrefix(b, a) {
+		return true
+	}
+	return editDistance(strings.ToLower(a), strings.ToLower(b)) <= 2
+}
+
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
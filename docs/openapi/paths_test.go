@@ -0,0 +1,64 @@
+package openapi
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestEndpointsParsesIndex(t *testing.T) {
+	endpoints := Endpoints()
+	if len(endpoints) == 0 {
+		t.Fatal("expected embedded endpoints")
+	}
+	if !slices.Contains(endpoints, Endpoint{Method: "GET", Path: "/v1/apps"}) {
+		t.Fatal("expected GET /v1/apps in the index")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   string
+		ok     bool
+	}{
+		{method: "get", path: "/v1/apps", want: "GET /v1/apps", ok: true},
+		{method: "GET", path: "/v1/apps/123/builds", want: "GET /v1/apps/{id}/builds", ok: true},
+		{method: "PATCH", path: "/v1/apps/123/", want: "PATCH /v1/apps/{id}", ok: true},
+		{method: "GET", path: "/v1/apps//builds"},
+		{method: "POST", path: "/v1/apps/123"},
+		{method: "GET", path: "/v1/unknown"},
+	}
+	for _, test := range tests {
+		got, ok := Match(test.method, test.path)
+		if ok != test.ok || (ok && got.String() != test.want) {
+			t.Fatalf("Match(%s, %s) = %v, %t; want %s, %t", test.method, test.path, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestMethodsAndSuggest(t *testing.T) {
+	if got := Methods("/v1/apps/123"); !slices.Equal(got, []string{"GET", "PATCH"}) {
+		t.Fatalf("Methods() = %v", got)
+	}
+
+	suggestions := Suggest("GET", "/v1/apps/123/buildz", 5)
+	if len(suggestions) != 1 || suggestions[0].Path != "/v1/apps/{id}/builds" {
+		t.Fatalf("unexpected suggestions: %v", suggestions)
+	}
+	if got := Suggest("GET", "/v9/nothing", 5); len(got) != 0 {
+		t.Fatalf("expected no suggestions for an unrelated path, got %v", got)
+	}
+}
+
+func TestComplete(t *testing.T) {
+	paths := Complete("DELETE", "/v1/betaGroups/")
+	if len(paths) == 0 || !slices.IsSorted(paths) {
+		t.Fatalf("unexpected completions: %v", paths)
+	}
+	for _, path := range paths {
+		if _, ok := Match("DELETE", path); !ok {
+			t.Fatalf("completion %s is not a DELETE endpoint", path)
+		}
+	}
+}
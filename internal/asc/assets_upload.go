@@ -58,6 +58,10 @@ func UploadAssetFromFile(ctx context.Context, file *os.File, fileSize int64, ope
 			return fmt.Errorf("upload operation %d exceeds file size", i)
 		}
 
+		if activeSimulationPlan().interceptUpload(method, op.URL, op.Length) {
+			continue
+		}
+
 		reader := io.NewSectionReader(file, op.Offset, op.Length)
 		req, err := http.NewRequestWithContext(ctx, method, op.URL, reader)
 		if err != nil {
@@ -68,7 +72,7 @@ func UploadAssetFromFile(ctx context.Context, file *os.File, fileSize int64, ope
 			req.Header.Set(header.Name, header.Value)
 		}
 
-		resp, err := client.Do(req)
+		resp, err := sendHTTP(client, req)
 		if err != nil {
 			return fmt.Errorf("upload operation %d failed: %w", i, err)
 		}
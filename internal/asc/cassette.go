@@ -0,0 +1,291 @@
+package asc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// maxCassetteRequestBody caps recorded request bodies; larger bodies (file
+// uploads) are summarized by size.
+const maxCassetteRequestBody = 1 << 20
+
+// CassetteEntry is one recorded request/response pair. Cassettes are JSONL
+// files with one entry per line.
+type CassetteEntry struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is the redacted request side of a cassette entry.
+type CassetteRequest struct {
+	Method  string          `json:"method"`
+	URL     string          `json:"url"`
+	Headers http.Header     `json:"headers,omitempty"`
+	Body    json.RawMessage `json:"body,omitempty"`
+}
+
+// CassetteResponse is the response side of a cassette entry. JSON bodies are
+// stored inline with sensitive fields redacted; other bodies are
+// base64-encoded.
+type CassetteResponse struct {
+	Status     int             `json:"status"`
+	Headers    http.Header     `json:"headers,omitempty"`
+	Body       json.RawMessage `json:"body,omitempty"`
+	BodyBase64 string          `json:"bodyBase64,omitempty"`
+}
+
+// CassetteRecorder appends every HTTP exchange to a cassette file.
+type CassetteRecorder struct {
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+// NewCassetteRecorder creates (or truncates) the cassette at path.
+func NewCassetteRecorder(path string) (*CassetteRecorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cassette: %w", err)
+	}
+	return &CassetteRecorder{file: file, enc: json.NewEncoder(file)}, nil
+}
+
+// Close closes the cassette file.
+func (r *CassetteRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
+
+func (r *CassetteRecorder) record(entry CassetteEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.enc.Encode(entry)
+}
+
+// CassetteReplayer serves responses from a cassette instead of the network.
+// Requests match on method, path, and query; repeated requests are served
+// in recorded order.
+type CassetteReplayer struct {
+	mu      sync.Mutex
+	entries map[string][]CassetteEntry
+}
+
+// LoadCassette reads a cassette written by CassetteRecorder.
+func LoadCassette(path string) (*CassetteReplayer, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cassette: %w", err)
+	}
+	defer file.Close()
+
+	replayer := &CassetteReplayer{entries: map[string][]CassetteEntry{}}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		var entry CassetteEntry
+		if err := json.Unmarshal(text, &entry); err != nil {
+			return nil, fmt.Errorf("invalid cassette entry on line %d: %w", line, err)
+		}
+		key := cassetteKey(entry.Request.Method, entry.Request.URL)
+		replayer.entries[key] = append(replayer.entries[key], entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+	return replayer, nil
+}
+
+func (r *CassetteReplayer) response(req *http.Request) (*http.Response, error) {
+	rawURL := sanitizeURLForLog(req.URL.String())
+	key := cassetteKey(req.Method, rawURL)
+
+	r.mu.Lock()
+	queue := r.entries[key]
+	if len(queue) == 0 {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("replay: no recorded response for %s %s", strings.ToUpper(req.Method), rawURL)
+	}
+	entry := queue[0]
+	r.entries[key] = queue[1:]
+	r.mu.Unlock()
+
+	if req.Body != nil {
+		_, _ = io.Copy(io.Discard, req.Body)
+		_ = req.Body.Close()
+	}
+	body := []byte(entry.Response.Body)
+	if entry.Response.BodyBase64 != "" {
+		decoded, err := base64.StdEncoding.DecodeString(entry.Response.BodyBase64)
+		if err != nil {
+			return nil, fmt.Errorf("replay: invalid body for %s %s: %w", strings.ToUpper(req.Method), rawURL, err)
+		}
+		body = decoded
+	}
+	header := entry.Response.Headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", entry.Response.Status, http.StatusText(entry.Response.Status)),
+		StatusCode:    entry.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// cassetteKey identifies a request by method, path, and canonical query. The
+// host is ignored so cassettes replay against ASC_BASE_URL overrides.
+func cassetteKey(method, rawURL string) string {
+	method = strings.ToUpper(method)
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return method + " " + rawURL
+	}
+	key := method + " " + parsed.EscapedPath()
+	if query := parsed.Query(); len(query) > 0 {
+		key += "?" + query.Encode()
+	}
+	return key
+}
+
+var cassetteState struct {
+	mu       sync.Mutex
+	recorder *CassetteRecorder
+	replayer *CassetteReplayer
+}
+
+// SetCassetteRecorder records every HTTP exchange into recorder. Passing nil
+// disables recording.
+func SetCassetteRecorder(recorder *CassetteRecorder) {
+	cassetteState.mu.Lock()
+	defer cassetteState.mu.Unlock()
+	cassetteState.recorder = recorder
+}
+
+// SetCassetteReplayer serves every HTTP request from replayer instead of the
+// network. Passing nil disables replay.
+func SetCassetteReplayer(replayer *CassetteReplayer) {
+	cassetteState.mu.Lock()
+	defer cassetteState.mu.Unlock()
+	cassetteState.replayer = replayer
+}
+
+func activeCassette() (*CassetteRecorder, *CassetteReplayer) {
+	cassetteState.mu.Lock()
+	defer cassetteState.mu.Unlock()
+	return cassetteState.recorder, cassetteState.replayer
+}
+
+// sendHTTP sends req with client, or serves it from the active cassette.
+// Every request the package makes goes through here.
+func sendHTTP(client *http.Client, req *http.Request) (*http.Response, error) {
+	recorder, replayer := activeCassette()
+	if replayer != nil {
+		return replayer.response(req)
+	}
+	if recorder == nil {
+		return client.Do(req)
+	}
+
+	requestBody := cassetteRequestBody(req)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	entry := CassetteEntry{
+		Request: CassetteRequest{
+			Method:  strings.ToUpper(req.Method),
+			URL:     sanitizeURLForLog(req.URL.String()),
+			Headers: redactCassetteHeaders(req.Header),
+			Body:    requestBody,
+		},
+		Response: CassetteResponse{
+			Status:  resp.StatusCode,
+			Headers: redactCassetteHeaders(resp.Header),
+		},
+	}
+	if json.Valid(body) {
+		entry.Response.Body = redactBody(body)
+	} else if len(body) > 0 {
+		entry.Response.BodyBase64 = base64.StdEncoding.EncodeToString(body)
+	}
+	if err := recorder.record(entry); err != nil {
+		return nil, fmt.Errorf("failed to record cassette entry: %w", err)
+	}
+	return resp, nil
+}
+
+// cassetteRequestBody returns a redacted copy of the request body without
+// consuming it. Bodies that cannot be re-read or are too large are
+// summarized by size.
+func cassetteRequestBody(req *http.Request) json.RawMessage {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil
+	}
+	if req.GetBody == nil || req.ContentLength > maxCassetteRequestBody {
+		return json.RawMessage(fmt.Sprintf("%q", fmt.Sprintf("<%d bytes>", req.ContentLength)))
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil
+	}
+	return redactBody(data)
+}
+
+func redactCassetteHeaders(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	redacted := http.Header{}
+	for name, values := range header {
+		canonical := http.CanonicalHeaderKey(name)
+		switch {
+		case canonical == "Authorization":
+			redacted[canonical] = []string{sanitizeAuthHeader(strings.Join(values, ", "))}
+		case canonical == "Cookie" || canonical == "Set-Cookie" || isSensitiveHeader(canonical):
+			redacted[canonical] = []string{"[REDACTED]"}
+		default:
+			redacted[canonical] = append([]string(nil), values...)
+		}
+	}
+	return redacted
+}
+
+func isSensitiveHeader(name string) bool {
+	name = strings.ToLower(name)
+	return strings.Contains(name, "token") || strings.Contains(name, "secret") ||
+		strings.Contains(name, "signature") || strings.Contains(name, "credential")
+}
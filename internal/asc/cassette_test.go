@@ -0,0 +1,117 @@
+package asc
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	recorder, err := NewCassetteRecorder(path)
+	if err != nil {
+		t.Fatalf("NewCassetteRecorder() error: %v", err)
+	}
+	SetCassetteRecorder(recorder)
+	t.Cleanup(func() { SetCassetteRecorder(nil) })
+
+	calls := 0
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		body := `{"data":[{"id":"` + req.URL.Query().Get("limit") + `","type":"apps"}]}`
+		if req.Method == http.MethodGet && req.URL.Path == "/download" {
+			body = "\x1f\x8bbinary"
+		}
+		if req.Method == http.MethodPost {
+			body = `{"data":{"attributes":{"demoAccountPassword":"hunter3"},"type":"appStoreReviewDetails"}}`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}, "Set-Cookie": []string{"session=abc"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	})}
+
+	send := func(method, rawURL, body string) string {
+		t.Helper()
+		var reader io.Reader
+		if body != "" {
+			reader = strings.NewReader(body)
+		}
+		req, err := http.NewRequest(method, rawURL, reader)
+		if err != nil {
+			t.Fatalf("NewRequest() error: %v", err)
+		}
+		req.Header.Set("Authorization", "Bearer secret-jwt")
+		resp, err := sendHTTP(client, req)
+		if err != nil {
+			t.Fatalf("sendHTTP() error: %v", err)
+		}
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		return string(data)
+	}
+
+	first := send(http.MethodGet, "https://api.appstoreconnect.apple.com/v1/apps?limit=1&sort=name", "")
+	second := send(http.MethodGet, "https://api.appstoreconnect.apple.com/v1/apps?sort=name&limit=1", "")
+	send(http.MethodPost, "https://api.appstoreconnect.apple.com/v1/betaGroups", `{"data":{"attributes":{"password":"hunter2"}}}`)
+	binary := send(http.MethodGet, "https://example.com/download", "")
+	SetCassetteRecorder(nil)
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("Close() error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	if lines := bytes.Count(data, []byte("\n")); lines != 4 {
+		t.Fatalf("expected 4 cassette entries, got %d", lines)
+	}
+	for _, leaked := range []string{"secret-jwt", "hunter2", "hunter3", "session=abc"} {
+		if bytes.Contains(data, []byte(leaked)) {
+			t.Fatalf("cassette leaked %q:\n%s", leaked, data)
+		}
+	}
+
+	replayer, err := LoadCassette(path)
+	if err != nil {
+		t.Fatalf("LoadCassette() error: %v", err)
+	}
+	SetCassetteReplayer(replayer)
+	t.Cleanup(func() { SetCassetteReplayer(nil) })
+	recorded := calls
+
+	// The host is ignored and query order is canonicalized.
+	if got := send(http.MethodGet, "http://127.0.0.1:8080/v1/apps?sort=name&limit=1", ""); got != first {
+		t.Fatalf("expected first recorded response, got %q", got)
+	}
+	if got := send(http.MethodGet, "https://api.appstoreconnect.apple.com/v1/apps?limit=1&sort=name", ""); got != second {
+		t.Fatalf("expected second recorded response, got %q", got)
+	}
+	if got := send(http.MethodGet, "https://example.com/download", ""); got != binary {
+		t.Fatalf("expected binary body to round-trip, got %q", got)
+	}
+	if calls != recorded {
+		t.Fatalf("expected replay not to touch the network, got %d extra calls", calls-recorded)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://api.appstoreconnect.apple.com/v1/apps?limit=1&sort=name", nil)
+	if _, err := sendHTTP(client, req); err == nil || !strings.Contains(err.Error(), "no recorded response for GET") {
+		t.Fatalf("expected exhausted replay error, got %v", err)
+	}
+}
+
+func TestLoadCassetteRejectsInvalidEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bad.jsonl")
+	if err := os.WriteFile(path, []byte("{\"request\":{}}\nnot json\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	if _, err := LoadCassette(path); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Fatalf("expected line 2 error, got %v", err)
+	}
+}
@@ -658,10 +658,15 @@ func (c *Client) GetBetaGroupTesters(ctx context.Context, groupID string, opts .
 
 // CreateBetaGroup creates a beta group for an app.
 func (c *Client) CreateBetaGroup(ctx context.Context, appID, name string) (*BetaGroupResponse, error) {
+	return c.CreateBetaGroupWithAttributes(ctx, appID, BetaGroupAttributes{Name: name})
+}
+
+// CreateBetaGroupWithAttributes creates a beta group for an app with the given attributes.
+func (c *Client) CreateBetaGroupWithAttributes(ctx context.Context, appID string, attrs BetaGroupAttributes) (*BetaGroupResponse, error) {
 	payload := BetaGroupCreateRequest{
 		Data: BetaGroupCreateData{
 			Type:       ResourceTypeBetaGroups,
-			Attributes: BetaGroupAttributes{Name: name},
+			Attributes: attrs,
 			Relationships: &BetaGroupRelationships{
 				App: &Relationship{
 					Data: ResourceData{
@@ -744,3 +744,45 @@ func TestGetAppInfoTerritoryAgeRatings_UsesNextURL(t *testing.T) {
 		t.Fatalf("GetAppInfoTerritoryAgeRatings() error: %v", err)
 	}
 }
+
+func TestReorderAppScreenshots_SendsRequest(t *testing.T) {
+	response := jsonResponse(http.StatusNoContent, `{}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/appScreenshotSets/set-1/relationships/appScreenshots" {
+			t.Fatalf("expected path /v1/appScreenshotSets/set-1/relationships/appScreenshots, got %s", req.URL.Path)
+		}
+
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Fatalf("failed to read body: %v", err)
+		}
+		var payload RelationshipRequest
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		if len(payload.Data) != 2 || payload.Data[0].ID != "shot-2" || payload.Data[1].ID != "shot-1" {
+			t.Fatalf("unexpected screenshot order: %+v", payload.Data)
+		}
+		if payload.Data[0].Type != ResourceTypeAppScreenshots {
+			t.Fatalf("expected appScreenshots type, got %q", payload.Data[0].Type)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if err := client.ReorderAppScreenshots(context.Background(), "set-1", []string{"shot-2", "shot-1"}); err != nil {
+		t.Fatalf("ReorderAppScreenshots() error: %v", err)
+	}
+}
+
+func TestReorderAppScreenshots_ValidationErrors(t *testing.T) {
+	client := newTestClient(t, nil, jsonResponse(http.StatusNoContent, `{}`))
+	if err := client.ReorderAppScreenshots(context.Background(), "", []string{"shot-1"}); err == nil {
+		t.Fatal("expected error for missing set ID")
+	}
+	if err := client.ReorderAppScreenshots(context.Background(), "set-1", nil); err == nil {
+		t.Fatal("expected error for missing screenshot IDs")
+	}
+}
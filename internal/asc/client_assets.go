@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // AppScreenshotSetRelationships describes relationships for screenshot sets.
@@ -184,6 +185,36 @@ func (c *Client) DeleteAppScreenshotSet(ctx context.Context, setID string) error
 	return err
 }
 
+// ReorderAppScreenshots replaces the screenshot order for a set.
+func (c *Client) ReorderAppScreenshots(ctx context.Context, setID string, screenshotIDs []string) error {
+	setID = strings.TrimSpace(setID)
+	if setID == "" {
+		return fmt.Errorf("setID is required")
+	}
+	if len(screenshotIDs) == 0 {
+		return fmt.Errorf("screenshotIDs are required")
+	}
+
+	payload := RelationshipRequest{
+		Data: make([]RelationshipData, 0, len(screenshotIDs)),
+	}
+	for _, id := range screenshotIDs {
+		payload.Data = append(payload.Data, RelationshipData{
+			Type: ResourceTypeAppScreenshots,
+			ID:   strings.TrimSpace(id),
+		})
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/v1/appScreenshotSets/%s/relationships/appScreenshots", setID)
+	_, err = c.do(ctx, "PATCH", path, body)
+	return err
+}
+
 // GetAppScreenshots retrieves screenshots for a set.
 func (c *Client) GetAppScreenshots(ctx context.Context, setID string) (*AppScreenshotsResponse, error) {
 	path := fmt.Sprintf("/v1/appScreenshotSets/%s/appScreenshots", setID)
@@ -195,9 +195,9 @@ func (c *Client) GetBuilds(ctx context.Context, appID string, opts ...BuildsOpti
 		path = query.nextURL
 	} else {
 		values := url.Values{}
-		// Use /v1/builds endpoint when sorting, limiting, or filtering by preReleaseVersion,
-		// since /v1/apps/{id}/builds doesn't support these
-		if query.sort != "" || query.limit > 0 || query.preReleaseVersionID != "" {
+		// Use /v1/builds endpoint when sorting, limiting, or filtering by preReleaseVersion
+		// or build number, since /v1/apps/{id}/builds doesn't support these
+		if query.sort != "" || query.limit > 0 || query.preReleaseVersionID != "" || query.version != "" {
 			path = "/v1/builds"
 			values.Set("filter[app]", appID)
 			if query.sort != "" {
@@ -209,6 +209,9 @@ func (c *Client) GetBuilds(ctx context.Context, appID string, opts ...BuildsOpti
 			if query.preReleaseVersionID != "" {
 				values.Set("filter[preReleaseVersion]", query.preReleaseVersionID)
 			}
+			if query.version != "" {
+				values.Set("filter[version]", query.version)
+			}
 		}
 		if queryString := values.Encode(); queryString != "" {
 			path += "?" + queryString
@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"math/rand"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -365,6 +366,52 @@ func logRetry(delay time.Duration, attempt, maxRetries int, err error) {
 	retryLogger.Info("retrying request", "delay", delay.String(), "attempt", attempt, "maxRetries", maxRetries, "error", err)
 }
 
+// ResolveCacheTTL returns the response cache TTL from env/config.
+// Precedence: ASC_CACHE_TTL > config cache_ttl. Zero means caching is disabled.
+// An invalid ASC_CACHE_TTL returns zero and an error.
+func ResolveCacheTTL() (time.Duration, error) {
+	if override, ok := envValue("ASC_CACHE_TTL"); ok {
+		parsed, err := config.ParseDurationValue(override)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ASC_CACHE_TTL value %q: %w", override, err)
+		}
+		ttl, _ := parsed.Value()
+		return ttl, nil
+	}
+	cfg := loadConfig()
+	if cfg == nil {
+		return 0, nil
+	}
+	ttl, _ := cfg.CacheTTL.Value()
+	return ttl, nil
+}
+
+// ResolveBaseURL returns the App Store Connect API base URL.
+// ASC_BASE_URL overrides BaseURL (e.g. to target `asc dev mock-server`).
+// The override is never silently replaced by production; ValidateBaseURL
+// reports malformed values before any request is made.
+func ResolveBaseURL() string {
+	override, ok := envValue("ASC_BASE_URL")
+	if !ok || override == "" {
+		return BaseURL
+	}
+	return strings.TrimSuffix(override, "/")
+}
+
+// ValidateBaseURL returns an error when ASC_BASE_URL is set but is not an
+// absolute http(s) URL.
+func ValidateBaseURL() error {
+	override, ok := envValue("ASC_BASE_URL")
+	if !ok || override == "" {
+		return nil
+	}
+	parsed, err := url.Parse(override)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		return fmt.Errorf("invalid ASC_BASE_URL value %q: expected an absolute http(s) URL such as http://127.0.0.1:8787", override)
+	}
+	return nil
+}
+
 // ResolveTimeout returns the request timeout, optionally overridden by config/env.
 func ResolveTimeout() time.Duration {
 	return ResolveTimeoutWithDefault(DefaultTimeout)
@@ -428,6 +475,8 @@ type Client struct {
 	issuerID      string
 	privateKey    *ecdsa.PrivateKey
 	notaryBaseURL string // override for testing; empty uses NotaryBaseURL constant
+	cache         *ResponseCache
+	limiter       *RateLimiter
 }
 
 // NewClient creates a new ASC client.
@@ -447,6 +496,9 @@ func NewClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient
 }
 
 func newClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient *http.Client) (*Client, error) {
+	if err := ValidateBaseURL(); err != nil {
+		return nil, err
+	}
 	if err := auth.ValidateKeyFile(privateKeyPath); err != nil {
 		return nil, fmt.Errorf("invalid private key: %w", err)
 	}
@@ -461,5 +513,6 @@ func newClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient
 		keyID:      keyID,
 		issuerID:   issuerID,
 		privateKey: key,
+		limiter:    NewRateLimiter(ResolveRateLimiterOptions(keyID)),
 	}, nil
 }
@@ -27,7 +27,7 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body io.Re
 
 	url := path
 	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
-		url = BaseURL + path
+		url = ResolveBaseURL() + path
 	}
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
@@ -80,6 +80,14 @@ func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([
 		}
 	}
 
+	if response, intercepted := activeSimulationPlan().interceptRequest(method, path, bodyBytes); intercepted {
+		return response, nil
+	}
+
+	if c.cache != nil && strings.EqualFold(method, http.MethodGet) {
+		return c.doCached(ctx, path)
+	}
+
 	request := func() ([]byte, error) {
 		var reader io.Reader
 		if bodyBytes != nil {
@@ -88,21 +96,96 @@ func (c *Client) do(ctx context.Context, method, path string, body io.Reader) ([
 		return c.doOnce(ctx, method, path, reader)
 	}
 
+	var (
+		respBody []byte
+		err      error
+	)
 	if shouldRetryMethod(method) {
 		retryOpts := ResolveRetryOptions()
-		return WithRetry(ctx, request, retryOpts)
+		respBody, err = WithRetry(ctx, request, retryOpts)
+	} else {
+		respBody, err = request()
 	}
 
-	return request()
+	// Invalidate even when the request failed: the write may have been
+	// applied before the error (e.g. a timeout waiting for the response).
+	if c.cache != nil && isMutatingMethod(method) {
+		if cacheErr := c.cache.invalidate(); cacheErr != nil && ResolveDebugEnabled() {
+			debugLogger.Info("response cache invalidation failed", "error", cacheErr.Error())
+		}
+	}
+	return respBody, err
 }
 
+// doCached serves GET requests from the response cache when fresh, and
+// revalidates stale entries with If-None-Match when an ETag is known.
+// Contexts from WithoutResponseCache always revalidate.
+func (c *Client) doCached(ctx context.Context, path string) ([]byte, error) {
+	key := responseCacheKey(c.keyID, http.MethodGet, path)
+	entry, fresh := c.cache.lookup(key)
+	if entry != nil && fresh && !responseCacheBypassed(ctx) {
+		if ResolveDebugEnabled() {
+			debugLogger.Info("response cache hit", "url", sanitizeURLForLog(normalizeCacheURL(path)))
+		}
+		return entry.Body, nil
+	}
+
+	header := http.Header{}
+	if entry != nil && entry.ETag != "" {
+		header.Set("If-None-Match", entry.ETag)
+	}
+
+	type cachedResult struct {
+		body []byte
+		etag string
+	}
+	result, err := WithRetry(ctx, func() (cachedResult, error) {
+		body, respHeader, err := c.doOnceWithHeaders(ctx, http.MethodGet, path, nil, header)
+		if err != nil {
+			return cachedResult{}, err
+		}
+		return cachedResult{body: body, etag: respHeader.Get("ETag")}, nil
+	}, ResolveRetryOptions())
+	if errors.Is(err, errNotModified) && entry != nil {
+		result = cachedResult{body: entry.Body, etag: entry.ETag}
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cacheErr := c.cache.store(key, path, result.etag, result.body); cacheErr != nil && ResolveDebugEnabled() {
+		debugLogger.Info("response cache write failed", "error", cacheErr.Error())
+	}
+	return result.body, nil
+}
+
+// errNotModified is returned by doOnceWithHeaders for 304 responses.
+var errNotModified = errors.New("not modified")
+
 func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	respBody, _, err := c.doOnceWithHeaders(ctx, method, path, body, nil)
+	return respBody, err
+}
+
+func (c *Client) doOnceWithHeaders(ctx context.Context, method, path string, body io.Reader, header http.Header) ([]byte, http.Header, error) {
 	start := time.Now()
 	debugSettings := resolveDebugSettings()
 
 	req, err := c.newRequest(ctx, method, path, body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	for name, values := range header {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	if debugSettings.verboseHTTP {
@@ -114,7 +197,7 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader
 		)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := sendHTTP(c.httpClient, req)
 	elapsed := time.Since(start)
 
 	if err != nil {
@@ -124,10 +207,14 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader
 				"elapsed", elapsed.String(),
 			)
 		}
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
+	if c.limiter != nil {
+		c.limiter.Observe(resp.Header)
+	}
+
 	if debugSettings.verboseHTTP {
 		debugLogger.Info("← HTTP Response",
 			"status", resp.StatusCode,
@@ -137,25 +224,33 @@ func (c *Client) doOnce(ctx context.Context, method, path string, body io.Reader
 		)
 	}
 
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, resp.Header, errNotModified
+	}
+
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		respBody, _ := io.ReadAll(resp.Body)
 
 		// Check for rate limiting (429) or service unavailable (503)
 		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
 			retryAfter := parseRetryAfterHeader(resp.Header.Get("Retry-After"))
-			return nil, &RetryableError{
+			return nil, nil, &RetryableError{
 				Err:        buildRetryableError(resp.StatusCode, retryAfter, respBody),
 				RetryAfter: retryAfter,
 			}
 		}
 
 		if err := ParseErrorWithStatus(respBody, resp.StatusCode); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		return nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
+		return nil, nil, fmt.Errorf("API request failed with status %d", resp.StatusCode)
 	}
 
-	return io.ReadAll(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return respBody, resp.Header, nil
 }
 
 // sanitizeAuthHeader redacts the JWT token from Authorization header for logging.
@@ -258,7 +353,8 @@ func parseRetryAfterHeader(value string) time.Duration {
 }
 
 // validateNextURL validates that a pagination URL is safe to use.
-// It ensures the URL is on the same host as BaseURL and uses HTTPS.
+// It ensures the URL is on the same host as the API base URL and uses HTTPS
+// (plain HTTP is only accepted when the base URL override itself is HTTP).
 func validateNextURL(nextURL string) error {
 	if nextURL == "" {
 		return nil
@@ -275,18 +371,18 @@ func validateNextURL(nextURL string) error {
 		return fmt.Errorf("invalid pagination URL: %w", err)
 	}
 
-	baseURL, err := url.Parse(BaseURL)
+	baseURL, err := url.Parse(ResolveBaseURL())
 	if err != nil {
 		return fmt.Errorf("invalid base URL: %w", err)
 	}
 
-	// Allow URLs on the same host as BaseURL
+	// Allow URLs on the same host as the base URL
 	if parsedURL.Host != baseURL.Host {
 		return fmt.Errorf("rejected pagination URL from untrusted host %q (expected %q)", parsedURL.Host, baseURL.Host)
 	}
 
 	// Require HTTPS for authentication endpoints
-	if parsedURL.Scheme != "https" {
+	if parsedURL.Scheme != "https" && parsedURL.Scheme != baseURL.Scheme {
 		return fmt.Errorf("rejected pagination URL with insecure scheme %q (expected https)", parsedURL.Scheme)
 	}
 
@@ -426,6 +522,22 @@ func validateAnalyticsDownloadURL(downloadURL string) error {
 }
 
 func (c *Client) doStream(ctx context.Context, method, path string, body io.Reader, accept string) (*http.Response, error) {
+	if plan := activeSimulationPlan(); plan != nil && isMutatingMethod(method) {
+		var bodyBytes []byte
+		if body != nil {
+			var err error
+			if bodyBytes, err = io.ReadAll(body); err != nil {
+				return nil, fmt.Errorf("failed to read request body: %w", err)
+			}
+		}
+		response, _ := plan.interceptRequest(method, path, bodyBytes)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(bytes.NewReader(response)),
+		}, nil
+	}
+
 	req, err := c.newRequest(ctx, method, path, body)
 	if err != nil {
 		return nil, err
@@ -434,7 +546,7 @@ func (c *Client) doStream(ctx context.Context, method, path string, body io.Read
 		req.Header.Set("Accept", accept)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := sendHTTP(c.httpClient, req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
@@ -458,7 +570,7 @@ func (c *Client) doStreamNoAuth(ctx context.Context, method, rawURL, accept stri
 		req.Header.Set("Accept", accept)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := sendHTTP(c.httpClient, req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -6040,6 +6041,9 @@ func TestGetProfiles_WithFilter(t *testing.T) {
 		if values.Get("filter[profileType]") != "IOS_APP_DEVELOPMENT,IOS_APP_STORE" {
 			t.Fatalf("expected filter[profileType] to be set, got %q", values.Get("filter[profileType]"))
 		}
+		if values.Get("filter[name]") != "Profile" {
+			t.Fatalf("expected filter[name]=Profile, got %q", values.Get("filter[name]"))
+		}
 		if values.Get("limit") != "5" {
 			t.Fatalf("expected limit=5, got %q", values.Get("limit"))
 		}
@@ -6049,6 +6053,7 @@ func TestGetProfiles_WithFilter(t *testing.T) {
 	if _, err := client.GetProfiles(
 		context.Background(),
 		WithProfilesTypes([]string{"IOS_APP_DEVELOPMENT", "IOS_APP_STORE"}),
+		WithProfilesFilterName(" Profile "),
 		WithProfilesLimit(5),
 	); err != nil {
 		t.Fatalf("GetProfiles() error: %v", err)
@@ -9138,3 +9143,56 @@ func TestListReviewSubmissionsGlobal_UsesNextURL(t *testing.T) {
 		t.Fatalf("ListReviewSubmissions() error: %v", err)
 	}
 }
+
+func TestResolveBaseURL(t *testing.T) {
+	t.Setenv("ASC_BASE_URL", "")
+	if got := ResolveBaseURL(); got != BaseURL {
+		t.Fatalf("expected default base URL, got %q", got)
+	}
+	t.Setenv("ASC_BASE_URL", "http://127.0.0.1:8787/")
+	if got := ResolveBaseURL(); got != "http://127.0.0.1:8787" {
+		t.Fatalf("expected override without trailing slash, got %q", got)
+	}
+}
+
+func TestValidateBaseURL(t *testing.T) {
+	for _, value := range []string{"", "http://127.0.0.1:8787", "https://example.com/"} {
+		t.Setenv("ASC_BASE_URL", value)
+		if err := ValidateBaseURL(); err != nil {
+			t.Fatalf("expected %q to be accepted, got %v", value, err)
+		}
+	}
+	for _, value := range []string{"localhost:8787", "127.0.0.1:8787", "ftp://example.com", "/v1"} {
+		t.Setenv("ASC_BASE_URL", value)
+		err := ValidateBaseURL()
+		if err == nil || !strings.Contains(err.Error(), "invalid ASC_BASE_URL") {
+			t.Fatalf("expected %q to be rejected, got %v", value, err)
+		}
+		if got := ResolveBaseURL(); got == BaseURL {
+			t.Fatalf("expected %q not to fall back to production", value)
+		}
+	}
+}
+
+func TestNewClientRejectsInvalidBaseURL(t *testing.T) {
+	t.Setenv("ASC_BASE_URL", "localhost:8787")
+	_, err := NewClient("KEY", "ISSUER", filepath.Join(t.TempDir(), "missing.p8"))
+	if err == nil || !strings.Contains(err.Error(), "invalid ASC_BASE_URL") {
+		t.Fatalf("expected invalid ASC_BASE_URL error, got %v", err)
+	}
+}
+
+func TestValidateNextURL_BaseURLOverride(t *testing.T) {
+	t.Setenv("ASC_BASE_URL", "http://127.0.0.1:8787")
+	if err := validateNextURL("http://127.0.0.1:8787/v1/apps?cursor=2"); err != nil {
+		t.Fatalf("expected override host to be accepted, got %v", err)
+	}
+	if err := validateNextURL("https://api.appstoreconnect.apple.com/v1/apps?cursor=2"); err == nil {
+		t.Fatal("expected production host to be rejected while overridden")
+	}
+
+	t.Setenv("ASC_BASE_URL", "")
+	if err := validateNextURL("http://api.appstoreconnect.apple.com/v1/apps"); err == nil {
+		t.Fatal("expected insecure scheme to be rejected without an HTTP override")
+	}
+}
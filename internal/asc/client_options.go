@@ -1461,6 +1461,15 @@ func WithBuildsPreReleaseVersion(preReleaseVersionID string) BuildsOption {
 	}
 }
 
+// WithBuildsVersion filters builds by build number (CFBundleVersion).
+func WithBuildsVersion(buildNumber string) BuildsOption {
+	return func(q *buildsQuery) {
+		if strings.TrimSpace(buildNumber) != "" {
+			q.version = strings.TrimSpace(buildNumber)
+		}
+	}
+}
+
 // WithBuildBundlesLimit sets the max number of included build bundles to return.
 func WithBuildBundlesLimit(limit int) BuildBundlesOption {
 	return func(q *buildBundlesQuery) {
@@ -2416,6 +2425,15 @@ func WithProfilesFilterBundleID(bundleID string) ProfilesOption {
 	}
 }
 
+// WithProfilesFilterName filters profiles by name.
+func WithProfilesFilterName(name string) ProfilesOption {
+	return func(q *profilesQuery) {
+		if strings.TrimSpace(name) != "" {
+			q.name = strings.TrimSpace(name)
+		}
+	}
+}
+
 // WithProfilesFilterType filters profiles by profile type (supports CSV).
 func WithProfilesFilterType(profileType string) ProfilesOption {
 	return func(q *profilesQuery) {
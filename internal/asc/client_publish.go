@@ -16,6 +16,11 @@ func (c *Client) WaitForBuildProcessing(ctx context.Context, buildID string, pol
 	if pollInterval <= 0 {
 		pollInterval = 30 * time.Second
 	}
+	if SimulationActive() {
+		// Nothing was uploaded or changed, so there is no processing to wait for.
+		return &BuildResponse{Data: Resource[BuildAttributes]{Type: ResourceTypeBuilds, ID: buildID}}, nil
+	}
+	ctx = WithoutResponseCache(ctx)
 
 	ticker := time.NewTicker(pollInterval)
 	defer ticker.Stop()
@@ -111,6 +111,7 @@ type buildsQuery struct {
 	listQuery
 	sort                string
 	preReleaseVersionID string
+	version             string
 }
 
 type buildUploadsQuery struct {
@@ -450,6 +451,7 @@ type merchantIDCertificatesQuery struct {
 type profilesQuery struct {
 	listQuery
 	bundleID     string
+	name         string
 	profileTypes []string
 	include      []string
 }
@@ -930,6 +932,9 @@ func buildProfilesQuery(query *profilesQuery) string {
 	if strings.TrimSpace(query.bundleID) != "" {
 		values.Set("filter[bundleId]", strings.TrimSpace(query.bundleID))
 	}
+	if strings.TrimSpace(query.name) != "" {
+		values.Set("filter[name]", strings.TrimSpace(query.name))
+	}
 	addCSV(values, "filter[profileType]", query.profileTypes)
 	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
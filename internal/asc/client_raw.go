@@ -0,0 +1,57 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// RawResponse is an untyped JSON:API collection document. Resources are kept
+// as raw JSON so arbitrary endpoints can be paginated with PaginateAll.
+type RawResponse struct {
+	Data     []json.RawMessage `json:"data"`
+	Links    Links             `json:"links"`
+	Included json.RawMessage   `json:"included,omitempty"`
+	Meta     json.RawMessage   `json:"meta,omitempty"`
+}
+
+// GetLinks returns the links field for pagination.
+func (r *RawResponse) GetLinks() *Links {
+	return &r.Links
+}
+
+// GetData returns the data field for aggregation.
+func (r *RawResponse) GetData() any {
+	return r.Data
+}
+
+// Do sends a signed request to an arbitrary API path and returns the raw
+// response body. path is relative to the base URL (e.g. "/v1/apps?limit=5")
+// or an absolute URL on the API host. Requests go through the same cache,
+// retry, and debug logging as typed client methods.
+func (c *Client) Do(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	method = strings.ToUpper(strings.TrimSpace(method))
+	if method == "" {
+		return nil, fmt.Errorf("method is required")
+	}
+	if err := validateNextURL(path); err != nil {
+		return nil, err
+	}
+	return c.do(ctx, method, path, body)
+}
+
+// GetRaw fetches a collection endpoint and decodes it as a RawResponse.
+func (c *Client) GetRaw(ctx context.Context, path string) (*RawResponse, error) {
+	data, err := c.Do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	var response RawResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &response, nil
+}
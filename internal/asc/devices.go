@@ -26,6 +26,8 @@ const (
 	DeviceClassIPod       DeviceClass = "IPOD"
 	DeviceClassAppleTV    DeviceClass = "APPLE_TV"
 	DeviceClassMac        DeviceClass = "MAC"
+
+	DeviceClassAppleVisionPro DeviceClass = "APPLE_VISION_PRO"
 )
 
 // DeviceAttributes describes an App Store Connect device.
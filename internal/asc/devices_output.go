@@ -1,11 +1,51 @@
 package asc
 
+import "fmt"
+
 // DeviceLocalUDIDResult represents CLI output for local device UDID lookup.
 type DeviceLocalUDIDResult struct {
 	UDID     string `json:"udid"`
 	Platform string `json:"platform"`
 }
 
+// Device import row statuses.
+const (
+	DeviceImportStatusRegistered = "registered"
+	DeviceImportStatusExists     = "exists"
+	DeviceImportStatusDuplicate  = "duplicate"
+	DeviceImportStatusInvalid    = "invalid"
+	DeviceImportStatusOverLimit  = "over-limit"
+	DeviceImportStatusFailed     = "failed"
+)
+
+// DeviceImportResult represents CLI output for bulk device registration.
+type DeviceImportResult struct {
+	File       string            `json:"file"`
+	Format     string            `json:"format"`
+	Registered int               `json:"registered"`
+	Skipped    int               `json:"skipped"`
+	Failed     int               `json:"failed"`
+	Rows       []DeviceImportRow `json:"rows"`
+}
+
+// DeviceImportRow is the outcome for one row of a device import file.
+type DeviceImportRow struct {
+	Line     int    `json:"line"`
+	UDID     string `json:"udid"`
+	Name     string `json:"name"`
+	Platform string `json:"platform,omitempty"`
+	Status   string `json:"status"`
+	DeviceID string `json:"deviceId,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// DeviceExportResult represents CLI output for device exports.
+type DeviceExportResult struct {
+	File    string `json:"file"`
+	Format  string `json:"format"`
+	Devices int    `json:"devices"`
+}
+
 func deviceLocalUDIDRows(result *DeviceLocalUDIDResult) ([]string, [][]string) {
 	headers := []string{"UDID", "Platform"}
 	rows := [][]string{{result.UDID, result.Platform}}
@@ -29,3 +69,26 @@ func devicesRows(resp *DevicesResponse) ([]string, [][]string) {
 	}
 	return headers, rows
 }
+
+func deviceImportResultRows(result *DeviceImportResult) ([]string, [][]string) {
+	headers := []string{"Line", "UDID", "Name", "Platform", "Status", "Device ID", "Message"}
+	rows := make([][]string, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		rows = append(rows, []string{
+			fmt.Sprintf("%d", row.Line),
+			compactWhitespace(row.UDID),
+			compactWhitespace(row.Name),
+			row.Platform,
+			row.Status,
+			row.DeviceID,
+			sanitizeTerminal(row.Message),
+		})
+	}
+	return headers, rows
+}
+
+func deviceExportResultRows(result *DeviceExportResult) ([]string, [][]string) {
+	headers := []string{"File", "Format", "Devices"}
+	rows := [][]string{{result.File, result.Format, fmt.Sprintf("%d", result.Devices)}}
+	return headers, rows
+}
@@ -0,0 +1,25 @@
+package asc
+
+import "encoding/json"
+
+// Fan-out run statuses.
+const (
+	FanOutStatusSuccess = "success"
+	FanOutStatusFailure = "failure"
+)
+
+// FanOutReport is the merged JSON result of a command run with --apps or
+// --profiles.
+type FanOutReport struct {
+	Command string         `json:"command"`
+	Results []FanOutResult `json:"results"`
+}
+
+// FanOutResult is the result of the command for one app/profile target.
+type FanOutResult struct {
+	App     string          `json:"app,omitempty"`
+	Profile string          `json:"profile,omitempty"`
+	Status  string          `json:"status"`
+	Error   string          `json:"error,omitempty"`
+	Output  json.RawMessage `json:"output,omitempty"`
+}
@@ -0,0 +1,19 @@
+//go:build !darwin && !linux && !freebsd && !netbsd && !openbsd && !dragonfly
+
+package asc
+
+import (
+	"errors"
+	"os"
+)
+
+var errFileLockUnsupported = errors.New("file locking is not supported on this platform")
+
+// lockFile is unsupported here; callers fall back to in-process state.
+func lockFile(file *os.File) error {
+	return errFileLockUnsupported
+}
+
+func unlockFile(file *os.File) error {
+	return nil
+}
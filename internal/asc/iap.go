@@ -121,6 +121,7 @@ type IAPLocalizationsOption func(*iapLocalizationsQuery)
 
 type inAppPurchasesQuery struct {
 	listQuery
+	productIDs []string
 }
 
 type iapLocalizationsQuery struct {
@@ -145,6 +146,13 @@ func WithIAPNextURL(next string) IAPOption {
 	}
 }
 
+// WithIAPProductIDs filters in-app purchases by product ID(s).
+func WithIAPProductIDs(productIDs []string) IAPOption {
+	return func(q *inAppPurchasesQuery) {
+		q.productIDs = normalizeList(productIDs)
+	}
+}
+
 // WithIAPLocalizationsLimit sets the max number of localizations to return.
 func WithIAPLocalizationsLimit(limit int) IAPLocalizationsOption {
 	return func(q *iapLocalizationsQuery) {
@@ -165,6 +173,7 @@ func WithIAPLocalizationsNextURL(next string) IAPLocalizationsOption {
 
 func buildInAppPurchasesQuery(query *inAppPurchasesQuery) string {
 	values := url.Values{}
+	addCSV(values, "filter[productId]", query.productIDs)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -185,12 +185,24 @@ func (c *Client) newNotaryRequest(ctx context.Context, method, path string, body
 
 // doNotary performs an HTTP request against the Notary API.
 func (c *Client) doNotary(ctx context.Context, method, path string, body io.Reader) ([]byte, error) {
+	if plan := activeSimulationPlan(); plan != nil && isMutatingMethod(method) {
+		var bodyBytes []byte
+		if body != nil {
+			var err error
+			if bodyBytes, err = io.ReadAll(body); err != nil {
+				return nil, fmt.Errorf("failed to read request body: %w", err)
+			}
+		}
+		response, _ := plan.interceptRequest(method, c.resolveNotaryBaseURL()+path, bodyBytes)
+		return response, nil
+	}
+
 	req, err := c.newNotaryRequest(ctx, method, path, body)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := sendHTTP(c.httpClient, req)
 	if err != nil {
 		return nil, fmt.Errorf("notary request failed: %w", err)
 	}
@@ -330,6 +342,9 @@ func UploadToS3(ctx context.Context, creds S3Credentials, data io.Reader, payloa
 	if strings.TrimSpace(contentType) == "" {
 		contentType = "application/octet-stream"
 	}
+	if activeSimulationPlan().interceptUpload(http.MethodPut, "s3://"+creds.Bucket+"/"+creds.Object, contentLength) {
+		return nil
+	}
 
 	if contentLength > notaryS3MaxSingleUploadBytes {
 		return uploadMultipartToS3(ctx, creds, data, contentLength, contentType)
@@ -369,7 +384,7 @@ func uploadSinglePartToS3(ctx context.Context, creds S3Credentials, data io.Read
 		return err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := sendHTTP(http.DefaultClient, req)
 	if err != nil {
 		return fmt.Errorf("S3 upload failed: %w", err)
 	}
@@ -482,7 +497,7 @@ func createMultipartUpload(ctx context.Context, host, encodedPath string, creds
 		return "", err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := sendHTTP(http.DefaultClient, req)
 	if err != nil {
 		return "", fmt.Errorf("create multipart upload failed: %w", err)
 	}
@@ -530,7 +545,7 @@ func uploadMultipartPart(ctx context.Context, host, encodedPath string, creds S3
 		return "", err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := sendHTTP(http.DefaultClient, req)
 	if err != nil {
 		return "", fmt.Errorf("upload part %d failed: %w", partNumber, err)
 	}
@@ -590,7 +605,7 @@ func completeMultipartUpload(ctx context.Context, host, encodedPath string, cred
 		return err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := sendHTTP(http.DefaultClient, req)
 	if err != nil {
 		return fmt.Errorf("complete multipart upload failed: %w", err)
 	}
@@ -626,7 +641,7 @@ func abortMultipartUpload(ctx context.Context, host, encodedPath string, creds S
 		return err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := sendHTTP(http.DefaultClient, req)
 	if err != nil {
 		return fmt.Errorf("abort multipart upload failed: %w", err)
 	}
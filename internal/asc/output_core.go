@@ -27,6 +27,16 @@ func PrintTable(data any) error {
 	return renderByRegistry(data, RenderTable)
 }
 
+// PrintCSV prints data as CSV using the table headers and rows.
+func PrintCSV(data any) error {
+	return renderByRegistryErr(data, RenderCSV)
+}
+
+// PrintNDJSON prints data as newline-delimited JSON, one object per table row.
+func PrintNDJSON(data any) error {
+	return renderByRegistryErr(data, RenderNDJSON)
+}
+
 // PrintJSON prints data as minified JSON (best for AI agents).
 func PrintJSON(data any) error {
 	enc := json.NewEncoder(os.Stdout)
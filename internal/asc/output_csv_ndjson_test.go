@@ -0,0 +1,146 @@
+package asc
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+func TestPrintCSV_Feedback(t *testing.T) {
+	resp := &FeedbackResponse{
+		Data: []Resource[FeedbackAttributes]{
+			{
+				ID: "1",
+				Attributes: FeedbackAttributes{
+					CreatedDate: "2026-01-20T00:00:00Z",
+					Email:       "tester@example.com",
+					Comment:     "Looks good, ship it",
+				},
+			},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintCSV(resp)
+	})
+
+	records, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+	if err != nil {
+		t.Fatalf("parse csv: %v\n%s", err, output)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected header and 1 row, got %d records", len(records))
+	}
+	headers, _ := feedbackRows(resp)
+	if strings.Join(records[0], "|") != strings.Join(headers, "|") {
+		t.Fatalf("expected table headers %v, got %v", headers, records[0])
+	}
+	if !strings.Contains(strings.Join(records[1], "|"), "Looks good, ship it") {
+		t.Fatalf("expected comment with comma to survive quoting, got %v", records[1])
+	}
+}
+
+func TestPrintCSV_MultiTableFlattensToOneHeader(t *testing.T) {
+	report := &validation.Report{
+		AppID:  "APP1",
+		Checks: []validation.CheckResult{{ID: "metadata.length", Severity: validation.SeverityError, Message: "too long"}},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintCSV(report)
+	})
+
+	records, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+	if err != nil {
+		t.Fatalf("parse csv: %v\n%s", err, output)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected one header, a summary row, and a check row, got %d records:\n%s", len(records), output)
+	}
+	header := strings.Join(records[0], "|")
+	if !strings.HasPrefix(header, "App ID|") || !strings.Contains(header, "|Severity|Check ID|") {
+		t.Fatalf("expected the union of both tables' columns, got %v", records[0])
+	}
+	if records[1][0] != "APP1" || records[2][0] != "" || !strings.Contains(strings.Join(records[2], "|"), "metadata.length") {
+		t.Fatalf("unexpected flattened rows: %v", records[1:])
+	}
+}
+
+func TestPrintNDJSON_Feedback(t *testing.T) {
+	resp := &FeedbackResponse{
+		Data: []Resource[FeedbackAttributes]{
+			{ID: "1", Attributes: FeedbackAttributes{Email: "a@example.com"}},
+			{ID: "2", Attributes: FeedbackAttributes{Email: "b@example.com"}},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintNDJSON(resp)
+	})
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %q", len(lines), output)
+	}
+	var first map[string]string
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatalf("parse line: %v", err)
+	}
+	if first["email"] != "a@example.com" {
+		t.Fatalf("expected email field, got %v", first)
+	}
+}
+
+func TestPrintNDJSON_MetadataPushResult(t *testing.T) {
+	result := &MetadataPushResult{
+		Changes: []MetadataChange{
+			{Action: "update", Resource: "versionLocalization", Locale: "en-US", Target: "LOC1", Fields: []string{"description", "keywords"}},
+		},
+	}
+
+	output := captureStdout(t, func() error {
+		return PrintNDJSON(result)
+	})
+
+	want := `{"action":"update","resource":"versionLocalization","locale":"en-US","target":"LOC1","fields":"description, keywords"}` + "\n"
+	if output != want {
+		t.Fatalf("PrintNDJSON() = %q, want %q", output, want)
+	}
+}
+
+func TestRenderNDJSON_PreservesColumnOrder(t *testing.T) {
+	output := captureStdout(t, func() error {
+		return RenderNDJSON([]string{"ID", "Bundle ID", "Name"}, [][]string{{"1", "com.example", `Say "hi"`}})
+	})
+	want := `{"id":"1","bundle_id":"com.example","name":"Say \"hi\""}` + "\n"
+	if output != want {
+		t.Fatalf("RenderNDJSON() = %q, want %q", output, want)
+	}
+}
+
+func TestRenderNDJSON_DeduplicatesKeys(t *testing.T) {
+	output := captureStdout(t, func() error {
+		return RenderNDJSON([]string{"Price", "Price (USD)", "price_usd", "Price"}, [][]string{{"1", "2", "3", "4"}})
+	})
+	want := `{"price":"1","price_usd":"2","price_usd_2":"3","price_2":"4"}` + "\n"
+	if output != want {
+		t.Fatalf("RenderNDJSON() = %q, want %q", output, want)
+	}
+}
+
+func TestNDJSONFieldName(t *testing.T) {
+	tests := map[string]string{
+		"ID":                  "id",
+		"Bundle ID":           "bundle_id",
+		"Current Price (USD)": "current_price_usd",
+		"  ":                  "field",
+	}
+	for header, want := range tests {
+		if got := ndjsonFieldName(header); got != want {
+			t.Fatalf("ndjsonFieldName(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
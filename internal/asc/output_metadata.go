@@ -0,0 +1,52 @@
+package asc
+
+import "strings"
+
+// MetadataPullResult is the result of a metadata pull.
+type MetadataPullResult struct {
+	Dir       string   `json:"dir"`
+	AppID     string   `json:"appId"`
+	AppInfoID string   `json:"appInfoId"`
+	VersionID string   `json:"versionId"`
+	Format    string   `json:"format"`
+	Locales   []string `json:"locales"`
+	Files     []string `json:"files"`
+}
+
+// MetadataChange describes one planned metadata change.
+type MetadataChange struct {
+	Action   string   `json:"action"`
+	Resource string   `json:"resource"`
+	Locale   string   `json:"locale,omitempty"`
+	Target   string   `json:"target,omitempty"`
+	Fields   []string `json:"fields,omitempty"`
+}
+
+// MetadataPushResult is the result of a metadata push.
+type MetadataPushResult struct {
+	Dir       string           `json:"dir"`
+	AppID     string           `json:"appId"`
+	AppInfoID string           `json:"appInfoId"`
+	VersionID string           `json:"versionId"`
+	DryRun    bool             `json:"dryRun"`
+	Changes   []MetadataChange `json:"changes"`
+	Applied   bool             `json:"applied"`
+}
+
+func metadataPullResultRows(result *MetadataPullResult) ([]string, [][]string) {
+	headers := []string{"File"}
+	rows := make([][]string, 0, len(result.Files))
+	for _, file := range result.Files {
+		rows = append(rows, []string{file})
+	}
+	return headers, rows
+}
+
+func metadataPushResultRows(result *MetadataPushResult) ([]string, [][]string) {
+	headers := []string{"Action", "Resource", "Locale", "Target", "Fields"}
+	rows := make([][]string, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		rows = append(rows, []string{change.Action, change.Resource, change.Locale, change.Target, strings.Join(change.Fields, ", ")})
+	}
+	return headers, rows
+}
@@ -0,0 +1,21 @@
+package asc
+
+import "encoding/json"
+
+func rawResponseRows(resp *RawResponse) ([]string, [][]string) {
+	headers := []string{"Type", "ID", "Attributes"}
+	rows := make([][]string, 0, len(resp.Data))
+	for _, item := range resp.Data {
+		var resource struct {
+			Type       string          `json:"type"`
+			ID         string          `json:"id"`
+			Attributes json.RawMessage `json:"attributes"`
+		}
+		if err := json.Unmarshal(item, &resource); err != nil {
+			rows = append(rows, []string{"", "", string(item)})
+			continue
+		}
+		rows = append(rows, []string{resource.Type, resource.ID, string(resource.Attributes)})
+	}
+	return headers, rows
+}
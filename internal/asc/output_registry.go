@@ -85,3 +85,55 @@ func renderByRegistry(data any, render func([]string, [][]string)) error {
 
 	return PrintJSON(data)
 }
+
+// renderByRegistryErr is renderByRegistry for render functions that can fail
+// (e.g. CSV and NDJSON writers). Multi-table types are flattened into a
+// single table over the union of their columns, so the output has one header.
+func renderByRegistryErr(data any, render func([]string, [][]string) error) error {
+	var headers [][]string
+	var rows [][][]string
+	err := renderByRegistry(data, func(h []string, r [][]string) {
+		headers = append(headers, h)
+		rows = append(rows, r)
+	})
+	if err != nil {
+		return err
+	}
+	switch len(headers) {
+	case 0:
+		return nil
+	case 1:
+		return render(headers[0], rows[0])
+	}
+	h, r := flattenTables(headers, rows)
+	return render(h, r)
+}
+
+// flattenTables merges tables into one whose columns are the union of their
+// headers in first-seen order. Each row keeps its values under its own
+// columns and leaves the others empty.
+func flattenTables(headers [][]string, rows [][][]string) ([]string, [][]string) {
+	var merged []string
+	index := map[string]int{}
+	for _, table := range headers {
+		for _, header := range table {
+			if _, ok := index[header]; !ok {
+				index[header] = len(merged)
+				merged = append(merged, header)
+			}
+		}
+	}
+	var out [][]string
+	for i, table := range rows {
+		for _, row := range table {
+			flat := make([]string, len(merged))
+			for j, value := range row {
+				if j < len(headers[i]) {
+					flat[index[headers[i][j]]] = value
+				}
+			}
+			out = append(out, flat)
+		}
+	}
+	return merged, out
+}
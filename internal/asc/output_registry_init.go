@@ -378,6 +378,8 @@ func init() {
 	})
 	registerRows(devicesRows)
 	registerRows(deviceLocalUDIDRows)
+	registerRows(deviceImportResultRows)
+	registerRows(deviceExportResultRows)
 	registerRows(func(v *DeviceResponse) ([]string, [][]string) {
 		return devicesRows(&DevicesResponse{Data: []Resource[DeviceAttributes]{v.Data}})
 	})
@@ -474,6 +476,9 @@ func init() {
 	registerRows(betaAppClipInvocationLocalizationDeleteResultRows)
 	registerRows(testFlightPublishResultRows)
 	registerRows(appStorePublishResultRows)
+	registerRows(testFlightSyncPushResultRows)
+	registerRows(metadataPullResultRows)
+	registerRows(metadataPushResultRows)
 	registerRows(salesReportResultRows)
 	registerRows(financeReportResultRows)
 	registerRows(financeRegionsRows)
@@ -698,11 +703,15 @@ func init() {
 	registerRows(passTypeIDDeleteResultRows)
 	registerRows(bundleIDCapabilityDeleteResultRows)
 	registerRows(certificateRevokeResultRows)
+	registerRows(certificateIdentityResultRows)
 	registerRows(profileDeleteResultRows)
 	registerRows(endUserLicenseAgreementRows)
 	registerRows(endUserLicenseAgreementDeleteResultRows)
 	registerRows(profileDownloadResultRows)
+	registerRows(profileInspectResultRows)
 	registerRows(signingFetchResultRows)
+	registerRows(signingSyncResultRows)
+	registerRows(signingAuditResultRows)
 	registerRows(xcodeCloudRunResultRows)
 	registerRows(xcodeCloudStatusResultRows)
 	registerRows(ciProductsRows)
@@ -816,4 +825,7 @@ func init() {
 	registerRows(notarySubmissionStatusRows)
 	registerRows(notarySubmissionsListRows)
 	registerRows(notarySubmissionLogsRows)
+	registerRows(rawResponseRows)
+	registerRows(simulationReportRows)
+	registerRows(workflowReportRows)
 }
@@ -0,0 +1,19 @@
+package asc
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func simulationReportRows(report *SimulationReport) ([]string, [][]string) {
+	headers := []string{"#", "Method", "Path", "Body"}
+	rows := make([][]string, 0, len(report.Operations))
+	for i, op := range report.Operations {
+		body := string(op.Body)
+		if op.Bytes > 0 {
+			body = fmt.Sprintf("<%d bytes>", op.Bytes)
+		}
+		rows = append(rows, []string{strconv.Itoa(i + 1), op.Method, op.Path, body})
+	}
+	return headers, rows
+}
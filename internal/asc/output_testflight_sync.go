@@ -0,0 +1,43 @@
+package asc
+
+// TestFlightSyncAction describes a single change planned or applied by
+// testflight sync push.
+type TestFlightSyncAction struct {
+	Action   string `json:"action"`
+	Resource string `json:"resource"`
+	Group    string `json:"group"`
+	GroupID  string `json:"groupId,omitempty"`
+	BuildID  string `json:"buildId,omitempty"`
+	Tester   string `json:"tester,omitempty"`
+	TesterID string `json:"testerId,omitempty"`
+	Details  string `json:"details,omitempty"`
+}
+
+// TestFlightSyncPushResult is the result of testflight sync push.
+type TestFlightSyncPushResult struct {
+	File    string                 `json:"file"`
+	AppID   string                 `json:"appId"`
+	DryRun  bool                   `json:"dryRun"`
+	Prune   bool                   `json:"prune"`
+	Actions []TestFlightSyncAction `json:"actions"`
+	Applied int                    `json:"applied"`
+}
+
+func testFlightSyncPushResultRows(result *TestFlightSyncPushResult) ([]string, [][]string) {
+	headers := []string{"Action", "Resource", "Group", "Target", "Details"}
+	rows := make([][]string, 0, len(result.Actions))
+	for _, action := range result.Actions {
+		target := action.BuildID
+		if action.Tester != "" {
+			target = action.Tester
+		}
+		rows = append(rows, []string{
+			action.Action,
+			action.Resource,
+			action.Group,
+			target,
+			action.Details,
+		})
+	}
+	return headers, rows
+}
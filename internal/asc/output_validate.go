@@ -11,7 +11,14 @@ func init() {
 	registerDirect(func(v *validation.Report, render func([]string, [][]string)) error {
 		h, r := validationSummaryRows(v)
 		render(h, r)
-		oh, or := validationCheckRows(v)
+		oh, or := validationCheckRows(v.Checks)
+		render(oh, or)
+		return nil
+	})
+	registerDirect(func(v *validation.ProductReport, render func([]string, [][]string)) error {
+		h, r := productValidationSummaryRows(v)
+		render(h, r)
+		oh, or := validationCheckRows(v.Checks)
 		render(oh, or)
 		return nil
 	})
@@ -33,14 +40,28 @@ func validationSummaryRows(report *validation.Report) ([]string, [][]string) {
 	return headers, rows
 }
 
-func validationCheckRows(report *validation.Report) ([]string, [][]string) {
+func productValidationSummaryRows(report *validation.ProductReport) ([]string, [][]string) {
+	headers := []string{"App ID", "Scope", "Errors", "Warnings", "Infos", "Blocking", "Strict"}
+	rows := [][]string{{
+		report.AppID,
+		report.Scope,
+		fmt.Sprintf("%d", report.Summary.Errors),
+		fmt.Sprintf("%d", report.Summary.Warnings),
+		fmt.Sprintf("%d", report.Summary.Infos),
+		fmt.Sprintf("%d", report.Summary.Blocking),
+		formatBool(report.Strict),
+	}}
+	return headers, rows
+}
+
+func validationCheckRows(checks []validation.CheckResult) ([]string, [][]string) {
 	headers := []string{"Severity", "Check ID", "Locale", "Field", "Resource", "Message", "Remediation"}
-	if report == nil || len(report.Checks) == 0 {
+	if len(checks) == 0 {
 		return headers, [][]string{{"info", "validation.ok", "", "", "", "No issues found", ""}}
 	}
 
-	rows := make([][]string, 0, len(report.Checks))
-	for _, check := range report.Checks {
+	rows := make([][]string, 0, len(checks))
+	for _, check := range checks {
 		rows = append(rows, []string{
 			string(check.Severity),
 			check.ID,
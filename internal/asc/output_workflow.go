@@ -0,0 +1,16 @@
+package asc
+
+import "strconv"
+
+func workflowReportRows(report *WorkflowReport) ([]string, [][]string) {
+	headers := []string{"Step", "Name", "Status", "Attempts", "Duration", "Error"}
+	rows := make([][]string, 0, len(report.Steps))
+	for _, step := range report.Steps {
+		attempts := ""
+		if step.Attempts > 0 {
+			attempts = strconv.Itoa(step.Attempts)
+		}
+		rows = append(rows, []string{step.ID, step.Name, step.Status, attempts, step.Duration, step.Error})
+	}
+	return headers, rows
+}
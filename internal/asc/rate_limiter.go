@@ -0,0 +1,289 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+const (
+	// DefaultHourlyRateLimit is App Store Connect's documented per-key hourly request budget.
+	DefaultHourlyRateLimit = 3600
+
+	rateLimitDirName = "ratelimit"
+	rateLimitHeader  = "X-Rate-Limit"
+)
+
+// RateLimiter is a token bucket refilled at the hourly limit. Apple's
+// X-Rate-Limit response header keeps the bucket in sync with the server's
+// remaining budget. With a state path, processes sharing the path (one per
+// key ID) share one bucket, coordinated through a lock file.
+type RateLimiter struct {
+	mu        sync.Mutex
+	state     rateLimitState
+	statePath string
+	now       func() time.Time
+	sleep     func(context.Context, time.Duration) error
+}
+
+// RateLimiterOptions configures a RateLimiter.
+//   - HourlyLimit: Requests per hour before the server reports its own limit.
+//   - StatePath: Shared state file; empty keeps the bucket in-process.
+type RateLimiterOptions struct {
+	HourlyLimit int
+	StatePath   string
+}
+
+type rateLimitState struct {
+	Limit     float64   `json:"limit"`
+	Tokens    float64   `json:"tokens"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// NewRateLimiter creates a rate limiter. It returns nil when HourlyLimit is not positive.
+func NewRateLimiter(opts RateLimiterOptions) *RateLimiter {
+	if opts.HourlyLimit <= 0 {
+		return nil
+	}
+	limit := float64(opts.HourlyLimit)
+	return &RateLimiter{
+		state:     rateLimitState{Limit: limit, Tokens: limit},
+		statePath: strings.TrimSpace(opts.StatePath),
+		now:       time.Now,
+		sleep:     sleepContext,
+	}
+}
+
+// DefaultRateLimitStatePath returns the shared state file for keyID.
+func DefaultRateLimitStatePath(keyID string) (string, error) {
+	path, err := config.GlobalPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(path), rateLimitDirName, sanitizeCacheNamespace(keyID)+".json"), nil
+}
+
+// ResolveRateLimiterOptions returns rate limiter options from env/config.
+// ASC_RATE_LIMIT (or rate_limit) sets the hourly budget; "0" or "off" disables
+// limiting. ASC_RATE_LIMIT_SHARED (or rate_limit_shared) shares the budget
+// across processes using the same key ID.
+func ResolveRateLimiterOptions(keyID string) RateLimiterOptions {
+	opts := RateLimiterOptions{HourlyLimit: DefaultHourlyRateLimit}
+	cfg := loadConfig()
+
+	limitValue, ok := envValue("ASC_RATE_LIMIT")
+	if !ok && cfg != nil {
+		limitValue = strings.TrimSpace(cfg.RateLimit)
+	}
+	if limitValue != "" {
+		if strings.EqualFold(limitValue, "off") {
+			opts.HourlyLimit = 0
+		} else if parsed, err := strconv.Atoi(limitValue); err == nil && parsed >= 0 {
+			opts.HourlyLimit = parsed
+		}
+	}
+
+	sharedValue, ok := envValue("ASC_RATE_LIMIT_SHARED")
+	if !ok && cfg != nil {
+		sharedValue = strings.TrimSpace(cfg.RateLimitShared)
+	}
+	if isTruthy(sharedValue) {
+		if path, err := DefaultRateLimitStatePath(keyID); err == nil {
+			opts.StatePath = path
+		}
+	}
+	return opts
+}
+
+// SetRateLimiter replaces the client's rate limiter. Passing nil disables limiting.
+func (c *Client) SetRateLimiter(limiter *RateLimiter) {
+	c.limiter = limiter
+}
+
+// Wait blocks until a request may be sent or ctx is done.
+func (rl *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay, err := rl.take()
+		if err != nil {
+			return err
+		}
+		if delay <= 0 {
+			return nil
+		}
+		if ResolveDebugEnabled() {
+			debugLogger.Info("rate limit budget exhausted; waiting", "delay", delay.String())
+		}
+		if err := rl.sleep(ctx, delay); err != nil {
+			return fmt.Errorf("rate limit wait cancelled: %w", err)
+		}
+	}
+}
+
+// Observe updates the bucket from an X-Rate-Limit response header.
+func (rl *RateLimiter) Observe(header http.Header) {
+	limit, remaining, ok := parseRateLimitHeader(header.Get(rateLimitHeader))
+	if !ok {
+		return
+	}
+	_ = rl.update(func(state *rateLimitState) {
+		state.Limit = float64(limit)
+		state.Tokens = math.Min(float64(remaining), state.Limit)
+	})
+}
+
+// take consumes a token, or returns how long to wait for the next one.
+func (rl *RateLimiter) take() (time.Duration, error) {
+	var delay time.Duration
+	err := rl.update(func(state *rateLimitState) {
+		if state.Tokens >= 1 {
+			state.Tokens--
+			return
+		}
+		perSecond := state.Limit / time.Hour.Seconds()
+		delay = time.Duration((1 - state.Tokens) / perSecond * float64(time.Second))
+	})
+	return delay, err
+}
+
+// update refills the bucket and applies fn, under the shared lock when configured.
+func (rl *RateLimiter) update(fn func(*rateLimitState)) error {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if rl.statePath == "" {
+		rl.refill(&rl.state)
+		fn(&rl.state)
+		return nil
+	}
+
+	unlock, err := lockRateLimitState(rl.statePath)
+	if err != nil {
+		// Fall back to the in-process bucket rather than failing requests.
+		rl.refill(&rl.state)
+		fn(&rl.state)
+		return nil
+	}
+	defer unlock()
+
+	state := rl.state
+	if data, err := os.ReadFile(rl.statePath); err == nil {
+		var shared rateLimitState
+		if json.Unmarshal(data, &shared) == nil && shared.Limit > 0 {
+			state = shared
+		}
+	}
+	rl.refill(&state)
+	fn(&state)
+	rl.state = state
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return writeRateLimitState(rl.statePath, data)
+}
+
+func (rl *RateLimiter) refill(state *rateLimitState) {
+	now := rl.now()
+	if !state.UpdatedAt.IsZero() {
+		elapsed := now.Sub(state.UpdatedAt)
+		if elapsed > 0 {
+			state.Tokens += elapsed.Seconds() * state.Limit / time.Hour.Seconds()
+		}
+	}
+	state.Tokens = math.Min(state.Tokens, state.Limit)
+	state.UpdatedAt = now
+}
+
+func writeRateLimitState(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, ".state-*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+func lockRateLimitState(statePath string) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(statePath), 0o700); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(statePath+".lock", os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	if err := lockFile(file); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return func() {
+		_ = unlockFile(file)
+		file.Close()
+	}, nil
+}
+
+// parseRateLimitHeader parses Apple's X-Rate-Limit header, e.g.
+// "user-hour-lim:3600;user-hour-rem:3545;".
+func parseRateLimitHeader(value string) (limit, remaining int, ok bool) {
+	var haveLimit, haveRemaining bool
+	for _, part := range strings.Split(value, ";") {
+		name, raw, found := strings.Cut(strings.TrimSpace(part), ":")
+		if !found {
+			continue
+		}
+		parsed, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || parsed < 0 {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "user-hour-lim":
+			limit, haveLimit = parsed, true
+		case "user-hour-rem":
+			remaining, haveRemaining = parsed, true
+		}
+	}
+	if !haveLimit || !haveRemaining || limit == 0 {
+		return 0, 0, false
+	}
+	return limit, remaining, true
+}
+
+func isTruthy(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "1", "t", "true", "yes", "y", "on":
+		return true
+	default:
+		return false
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,136 @@
+package asc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestRateLimiter(opts RateLimiterOptions, now *time.Time, slept *[]time.Duration) *RateLimiter {
+	limiter := NewRateLimiter(opts)
+	limiter.now = func() time.Time { return *now }
+	limiter.sleep = func(_ context.Context, d time.Duration) error {
+		*slept = append(*slept, d)
+		*now = now.Add(d)
+		return nil
+	}
+	return limiter
+}
+
+func TestParseRateLimitHeader(t *testing.T) {
+	tests := []struct {
+		value         string
+		wantLimit     int
+		wantRemaining int
+		wantOK        bool
+	}{
+		{value: "user-hour-lim:3600;user-hour-rem:3545;", wantLimit: 3600, wantRemaining: 3545, wantOK: true},
+		{value: " user-hour-rem : 0 ; user-hour-lim : 3500 ", wantLimit: 3500, wantRemaining: 0, wantOK: true},
+		{value: "user-hour-lim:3600;", wantOK: false},
+		{value: "user-hour-lim:abc;user-hour-rem:1", wantOK: false},
+		{value: "", wantOK: false},
+	}
+	for _, test := range tests {
+		limit, remaining, ok := parseRateLimitHeader(test.value)
+		if ok != test.wantOK || limit != test.wantLimit || remaining != test.wantRemaining {
+			t.Fatalf("parseRateLimitHeader(%q) = %d, %d, %v", test.value, limit, remaining, ok)
+		}
+	}
+}
+
+func TestRateLimiter_WaitsWhenBudgetExhausted(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var slept []time.Duration
+	limiter := newTestRateLimiter(RateLimiterOptions{HourlyLimit: 3600}, &now, &slept)
+
+	limiter.Observe(http.Header{"X-Rate-Limit": []string{"user-hour-lim:3600;user-hour-rem:1;"}})
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() error: %v", err)
+	}
+	if len(slept) != 0 {
+		t.Fatalf("expected first request to proceed immediately, slept %v", slept)
+	}
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() error: %v", err)
+	}
+	if len(slept) != 1 || slept[0] != time.Second {
+		t.Fatalf("expected a 1s wait at 3600 requests/hour, got %v", slept)
+	}
+}
+
+func TestRateLimiter_WaitHonorsContext(t *testing.T) {
+	limiter := NewRateLimiter(RateLimiterOptions{HourlyLimit: 1})
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := limiter.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestRateLimiter_SharedStateAcrossLimiters(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "ratelimit", "KEY.json")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var slept []time.Duration
+
+	first := newTestRateLimiter(RateLimiterOptions{HourlyLimit: 3600, StatePath: statePath}, &now, &slept)
+	second := newTestRateLimiter(RateLimiterOptions{HourlyLimit: 3600, StatePath: statePath}, &now, &slept)
+
+	first.Observe(http.Header{"X-Rate-Limit": []string{"user-hour-lim:3600;user-hour-rem:1;"}})
+	if err := first.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() error: %v", err)
+	}
+	if err := second.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() error: %v", err)
+	}
+	if len(slept) != 1 {
+		t.Fatalf("expected second limiter to wait on the shared budget, slept %v", slept)
+	}
+}
+
+func TestClient_RateLimiterObservesResponses(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	response.Header.Set("X-Rate-Limit", "user-hour-lim:3600;user-hour-rem:42;")
+	client := newTestClient(t, nil, response)
+	client.SetRateLimiter(NewRateLimiter(RateLimiterOptions{HourlyLimit: DefaultHourlyRateLimit}))
+
+	if _, err := client.GetApps(context.Background()); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	// One token is consumed by the request, then the header resets the budget.
+	if got := client.limiter.state.Tokens; got != 42 {
+		t.Fatalf("expected 42 tokens after observing header, got %v", got)
+	}
+}
+
+func TestResolveRateLimiterOptions(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(home, "missing.json"))
+
+	t.Setenv("ASC_RATE_LIMIT", "")
+	t.Setenv("ASC_RATE_LIMIT_SHARED", "")
+	opts := ResolveRateLimiterOptions("KEY123")
+	if opts.HourlyLimit != DefaultHourlyRateLimit || opts.StatePath != "" {
+		t.Fatalf("unexpected defaults: %+v", opts)
+	}
+
+	t.Setenv("ASC_RATE_LIMIT", "off")
+	if opts := ResolveRateLimiterOptions("KEY123"); opts.HourlyLimit != 0 {
+		t.Fatalf("expected limiter disabled, got %+v", opts)
+	}
+
+	t.Setenv("ASC_RATE_LIMIT", "1200")
+	t.Setenv("ASC_RATE_LIMIT_SHARED", "true")
+	opts = ResolveRateLimiterOptions("KEY123")
+	want := filepath.Join(home, ".asc", "ratelimit", "KEY123.json")
+	if opts.HourlyLimit != 1200 || opts.StatePath != want {
+		t.Fatalf("unexpected options: %+v (want state path %s)", opts, want)
+	}
+}
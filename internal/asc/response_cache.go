@@ -0,0 +1,253 @@
+package asc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+const (
+	responseCacheDirName   = "cache"
+	responseCacheNamespace = "default"
+)
+
+// ResponseCache stores GET responses on disk so repeated reads can skip the
+// network. Entries are fresh for TTL; stale entries with an ETag are
+// revalidated with If-None-Match. Mutating requests invalidate every entry,
+// since a write can change resources of other types (e.g. a submission
+// changes its version's state).
+type ResponseCache struct {
+	dir     string
+	ttl     time.Duration
+	refresh bool
+	now     func() time.Time
+	mu      sync.Mutex
+}
+
+// ResponseCacheOptions configures a ResponseCache.
+//   - Dir: Root cache directory (DefaultResponseCacheDir when empty).
+//   - Namespace: Subdirectory isolating entries, typically the profile name.
+//   - TTL: How long an entry is served without contacting the API.
+//   - Refresh: Skip cached entries on read but still store fresh responses.
+type ResponseCacheOptions struct {
+	Dir       string
+	Namespace string
+	TTL       time.Duration
+	Refresh   bool
+}
+
+type responseCacheEntry struct {
+	Key      string    `json:"key"`
+	URL      string    `json:"url"`
+	ETag     string    `json:"etag,omitempty"`
+	StoredAt time.Time `json:"storedAt"`
+	Body     []byte    `json:"body"`
+}
+
+type responseCacheBypassKey struct{}
+
+// WithoutResponseCache returns a context whose GET requests always reach the
+// API, even when a fresh cache entry exists. Responses are still stored so
+// later reads see the latest state. Polling loops use it to observe changes.
+func WithoutResponseCache(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, responseCacheBypassKey{}, true)
+}
+
+func responseCacheBypassed(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	bypass, _ := ctx.Value(responseCacheBypassKey{}).(bool)
+	return bypass
+}
+
+// DefaultResponseCacheDir returns the directory used for cached responses.
+func DefaultResponseCacheDir() (string, error) {
+	path, err := config.GlobalPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(path), responseCacheDirName), nil
+}
+
+// NewResponseCache creates a response cache. It returns nil when TTL is not positive.
+func NewResponseCache(opts ResponseCacheOptions) (*ResponseCache, error) {
+	if opts.TTL <= 0 {
+		return nil, nil
+	}
+	dir := strings.TrimSpace(opts.Dir)
+	if dir == "" {
+		var err error
+		dir, err = DefaultResponseCacheDir()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &ResponseCache{
+		dir:     filepath.Join(dir, sanitizeCacheNamespace(opts.Namespace)),
+		ttl:     opts.TTL,
+		refresh: opts.Refresh,
+		now:     time.Now,
+	}, nil
+}
+
+// SetResponseCache enables caching of GET responses for this client.
+// Passing nil disables caching.
+func (c *Client) SetResponseCache(cache *ResponseCache) {
+	c.cache = cache
+}
+
+// lookup returns the entry for key and whether it is still fresh.
+func (rc *ResponseCache) lookup(key string) (*responseCacheEntry, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	data, err := os.ReadFile(rc.entryPath(key))
+	if err != nil {
+		return nil, false
+	}
+	var entry responseCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Key != key {
+		return nil, false
+	}
+	fresh := !rc.refresh && rc.now().Sub(entry.StoredAt) < rc.ttl
+	return &entry, fresh
+}
+
+func (rc *ResponseCache) store(key, rawURL, etag string, body []byte) error {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry := responseCacheEntry{
+		Key:      key,
+		URL:      rawURL,
+		ETag:     etag,
+		StoredAt: rc.now().UTC(),
+		Body:     body,
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(rc.dir, 0o700); err != nil {
+		return fmt.Errorf("create response cache directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(rc.dir, ".entry-*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, rc.entryPath(key)); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+// invalidate removes every cached entry.
+func (rc *ResponseCache) invalidate() error {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entries, err := os.ReadDir(rc.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	var errs []error
+	for _, dirEntry := range entries {
+		if dirEntry.IsDir() || filepath.Ext(dirEntry.Name()) != ".json" {
+			continue
+		}
+		errs = append(errs, removeCacheFile(filepath.Join(rc.dir, dirEntry.Name())))
+	}
+	return errors.Join(errs...)
+}
+
+func (rc *ResponseCache) entryPath(key string) string {
+	return filepath.Join(rc.dir, key+".json")
+}
+
+func removeCacheFile(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// responseCacheKey hashes the identity, method, and normalized URL of a request.
+func responseCacheKey(identity, method, rawURL string) string {
+	sum := sha256.Sum256([]byte(identity + "\n" + strings.ToUpper(method) + "\n" + normalizeCacheURL(rawURL)))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeCacheURL resolves relative paths and sorts query parameters so
+// equivalent requests share a cache entry.
+func normalizeCacheURL(rawURL string) string {
+	if !strings.HasPrefix(rawURL, "http://") && !strings.HasPrefix(rawURL, "https://") {
+		rawURL = ResolveBaseURL() + rawURL
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	parsed.RawQuery = parsed.Query().Encode()
+	parsed.Fragment = ""
+	return parsed.String()
+}
+
+func sanitizeCacheNamespace(value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return responseCacheNamespace
+	}
+	var b strings.Builder
+	for _, r := range value {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	sanitized := b.String()
+	if sanitized == "." || sanitized == ".." {
+		return responseCacheNamespace
+	}
+	return sanitized
+}
+
+func isMutatingMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,266 @@
+package asc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newCachingTestClient(t *testing.T, opts ResponseCacheOptions, handler func(*http.Request) *http.Response) *Client {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	if opts.Dir == "" {
+		opts.Dir = t.TempDir()
+	}
+	cache, err := NewResponseCache(opts)
+	if err != nil {
+		t.Fatalf("NewResponseCache() error: %v", err)
+	}
+	return &Client{
+		httpClient: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return handler(req), nil
+		})},
+		keyID:      "KEY123",
+		issuerID:   "ISS456",
+		privateKey: key,
+		cache:      cache,
+	}
+}
+
+func TestResponseCache_ServesFreshEntries(t *testing.T) {
+	calls := 0
+	client := newCachingTestClient(t, ResponseCacheOptions{TTL: time.Minute}, func(req *http.Request) *http.Response {
+		calls++
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"1"}]}`)
+	})
+
+	for range 2 {
+		resp, err := client.GetApps(context.Background())
+		if err != nil {
+			t.Fatalf("GetApps() error: %v", err)
+		}
+		if len(resp.Data) != 1 || resp.Data[0].ID != "1" {
+			t.Fatalf("unexpected response: %+v", resp.Data)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 network call, got %d", calls)
+	}
+}
+
+func TestResponseCache_RevalidatesStaleEntriesWithETag(t *testing.T) {
+	calls := 0
+	client := newCachingTestClient(t, ResponseCacheOptions{TTL: time.Minute}, func(req *http.Request) *http.Response {
+		calls++
+		if calls == 1 {
+			resp := jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"1"}]}`)
+			resp.Header.Set("ETag", `"v1"`)
+			return resp
+		}
+		if got := req.Header.Get("If-None-Match"); got != `"v1"` {
+			t.Fatalf("expected If-None-Match \"v1\", got %q", got)
+		}
+		return &http.Response{StatusCode: http.StatusNotModified, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}
+	})
+	now := time.Now()
+	client.cache.now = func() time.Time { return now }
+
+	if _, err := client.GetApps(context.Background()); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	now = now.Add(2 * time.Minute)
+	resp, err := client.GetApps(context.Background())
+	if err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 network calls, got %d", calls)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].ID != "1" {
+		t.Fatalf("expected cached body after 304, got %+v", resp.Data)
+	}
+
+	// The 304 refreshes the entry, so the next read is served locally.
+	if _, err := client.GetApps(context.Background()); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected refreshed entry to be served from cache, got %d calls", calls)
+	}
+}
+
+func TestResponseCache_RefreshBypassesReads(t *testing.T) {
+	dir := t.TempDir()
+	calls := 0
+	handler := func(req *http.Request) *http.Response {
+		calls++
+		return jsonResponse(http.StatusOK, `{"data":[]}`)
+	}
+
+	client := newCachingTestClient(t, ResponseCacheOptions{Dir: dir, TTL: time.Minute}, handler)
+	if _, err := client.GetApps(context.Background()); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	refreshing := newCachingTestClient(t, ResponseCacheOptions{Dir: dir, TTL: time.Minute, Refresh: true}, handler)
+	if _, err := refreshing.GetApps(context.Background()); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected refresh to hit the network, got %d calls", calls)
+	}
+}
+
+func TestResponseCache_NamespacesAreIsolated(t *testing.T) {
+	dir := t.TempDir()
+	calls := 0
+	handler := func(req *http.Request) *http.Response {
+		calls++
+		return jsonResponse(http.StatusOK, `{"data":[]}`)
+	}
+
+	for _, namespace := range []string{"work", "personal"} {
+		client := newCachingTestClient(t, ResponseCacheOptions{Dir: dir, Namespace: namespace, TTL: time.Minute}, handler)
+		if _, err := client.GetApps(context.Background()); err != nil {
+			t.Fatalf("GetApps() error: %v", err)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("expected one network call per profile, got %d", calls)
+	}
+}
+
+func TestResponseCache_MutationsInvalidateEntries(t *testing.T) {
+	t.Setenv("ASC_MAX_RETRIES", "0")
+	calls := map[string]int{}
+	client := newCachingTestClient(t, ResponseCacheOptions{TTL: time.Hour}, func(req *http.Request) *http.Response {
+		calls[req.Method+" "+req.URL.Path]++
+		switch req.Method {
+		case http.MethodPatch:
+			return jsonResponse(http.StatusOK, `{"data":{"type":"builds","id":"B1"}}`)
+		case http.MethodDelete:
+			return jsonResponse(http.StatusInternalServerError, `{"errors":[{"status":"500","title":"Server Error"}]}`)
+		}
+		return jsonResponse(http.StatusOK, `{"data":[]}`)
+	})
+
+	ctx := context.Background()
+	get := func(path string) {
+		t.Helper()
+		if _, err := client.do(ctx, http.MethodGet, path, nil); err != nil {
+			t.Fatalf("GET %s error: %v", path, err)
+		}
+	}
+	get("/v1/apps/A1/builds")
+	get("/v1/apps")
+	get("/v1/apps")
+	if _, err := client.do(ctx, http.MethodPatch, "/v1/builds/B1", strings.NewReader(`{}`)); err != nil {
+		t.Fatalf("PATCH error: %v", err)
+	}
+	get("/v1/apps/A1/builds")
+	get("/v1/apps")
+	if _, err := client.do(ctx, http.MethodDelete, "/v1/betaGroups/G1", nil); err == nil {
+		t.Fatal("expected DELETE to fail")
+	}
+	get("/v1/apps")
+
+	if calls["GET /v1/apps/A1/builds"] != 2 {
+		t.Fatalf("expected builds list to be refetched after mutation, got %d", calls["GET /v1/apps/A1/builds"])
+	}
+	if calls["GET /v1/apps"] != 3 {
+		t.Fatalf("expected apps list to be refetched after each mutation, got %d", calls["GET /v1/apps"])
+	}
+}
+
+func TestResponseCache_WithoutResponseCacheSkipsFreshEntries(t *testing.T) {
+	state := "PROCESSING"
+	calls := 0
+	client := newCachingTestClient(t, ResponseCacheOptions{TTL: time.Hour}, func(req *http.Request) *http.Response {
+		calls++
+		return jsonResponse(http.StatusOK, `{"data":{"type":"builds","id":"B1","attributes":{"processingState":"`+state+`"}}}`)
+	})
+
+	ctx := context.Background()
+	if _, err := client.GetBuild(ctx, "B1"); err != nil {
+		t.Fatalf("GetBuild() error: %v", err)
+	}
+	state = "VALID"
+	build, err := client.WaitForBuildProcessing(ctx, "B1", time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForBuildProcessing() error: %v", err)
+	}
+	if build.Data.Attributes.ProcessingState != "VALID" || calls != 2 {
+		t.Fatalf("expected polling to bypass the cache, got state %q after %d calls", build.Data.Attributes.ProcessingState, calls)
+	}
+
+	cached, err := client.GetBuild(ctx, "B1")
+	if err != nil {
+		t.Fatalf("GetBuild() error: %v", err)
+	}
+	if cached.Data.Attributes.ProcessingState != "VALID" || calls != 2 {
+		t.Fatalf("expected the polled response to refresh the cache, got %q after %d calls", cached.Data.Attributes.ProcessingState, calls)
+	}
+}
+
+func TestResponseCache_ErrorsAreNotCached(t *testing.T) {
+	t.Setenv("ASC_MAX_RETRIES", "0")
+	calls := 0
+	client := newCachingTestClient(t, ResponseCacheOptions{TTL: time.Minute}, func(req *http.Request) *http.Response {
+		calls++
+		if calls == 1 {
+			return jsonResponse(http.StatusNotFound, `{"errors":[{"status":"404","title":"Not Found"}]}`)
+		}
+		return jsonResponse(http.StatusOK, `{"data":[]}`)
+	})
+
+	if _, err := client.GetApps(context.Background()); err == nil {
+		t.Fatal("expected first request to fail")
+	}
+	if _, err := client.GetApps(context.Background()); err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 network calls, got %d", calls)
+	}
+}
+
+func TestResponseCacheKey_NormalizesQueryOrder(t *testing.T) {
+	a := responseCacheKey("KEY", http.MethodGet, "/v1/apps?limit=10&filter[name]=x")
+	b := responseCacheKey("KEY", http.MethodGet, BaseURL+"/v1/apps?filter[name]=x&limit=10")
+	if a != b {
+		t.Fatal("expected equivalent URLs to share a cache key")
+	}
+	if a == responseCacheKey("OTHER", http.MethodGet, "/v1/apps?limit=10&filter[name]=x") {
+		t.Fatal("expected different identities to use different cache keys")
+	}
+}
+
+func TestResolveCacheTTL(t *testing.T) {
+	t.Setenv("ASC_CONFIG_PATH", t.TempDir()+"/missing.json")
+
+	t.Setenv("ASC_CACHE_TTL", "90s")
+	if got, err := ResolveCacheTTL(); err != nil || got != 90*time.Second {
+		t.Fatalf("expected 90s, got %s (%v)", got, err)
+	}
+	t.Setenv("ASC_CACHE_TTL", "120")
+	if got, err := ResolveCacheTTL(); err != nil || got != 2*time.Minute {
+		t.Fatalf("expected 2m, got %s (%v)", got, err)
+	}
+	t.Setenv("ASC_CACHE_TTL", "bogus")
+	got, err := ResolveCacheTTL()
+	if got != 0 {
+		t.Fatalf("expected invalid TTL to disable caching, got %s", got)
+	}
+	if err == nil || !strings.Contains(err.Error(), `invalid ASC_CACHE_TTL value "bogus"`) {
+		t.Fatalf("expected invalid TTL error, got %v", err)
+	}
+}
@@ -0,0 +1,34 @@
+package asc
+
+// Signing audit finding severities.
+const (
+	SigningAuditSeverityError   = "error"
+	SigningAuditSeverityWarning = "warning"
+)
+
+// SigningAuditResult represents CLI output for signing audit.
+type SigningAuditResult struct {
+	ExpiringWithinDays int                   `json:"expiringWithinDays"`
+	Summary            SigningAuditSummary   `json:"summary"`
+	Findings           []SigningAuditFinding `json:"findings"`
+}
+
+// SigningAuditSummary counts the audited resources and findings.
+type SigningAuditSummary struct {
+	Certificates int `json:"certificates"`
+	Profiles     int `json:"profiles"`
+	BundleIDs    int `json:"bundleIds"`
+	Devices      int `json:"enabledDevices"`
+	Errors       int `json:"errors"`
+	Warnings     int `json:"warnings"`
+}
+
+// SigningAuditFinding is one signing risk found by the audit.
+type SigningAuditFinding struct {
+	Check        string `json:"check"`
+	Severity     string `json:"severity"`
+	ResourceType string `json:"resourceType"`
+	ResourceID   string `json:"resourceId"`
+	Name         string `json:"name,omitempty"`
+	Message      string `json:"message"`
+}
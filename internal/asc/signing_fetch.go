@@ -12,3 +12,36 @@ type SigningFetchResult struct {
 	OutputPath       string   `json:"outputPath"`
 	Created          bool     `json:"created,omitempty"`
 }
+
+// Signing sync statuses for certificates and profiles.
+const (
+	SigningSyncStatusCurrent = "current"
+	SigningSyncStatusCreated = "created"
+	SigningSyncStatusRenewed = "renewed"
+)
+
+// SigningSyncResult represents CLI output for signing sync.
+type SigningSyncResult struct {
+	Repo                      string               `json:"repo"`
+	Readonly                  bool                 `json:"readonly"`
+	CertificateID             string               `json:"certificateId"`
+	CertificateType           string               `json:"certificateType"`
+	CertificateExpirationDate string               `json:"certificateExpirationDate"`
+	CertificateStatus         string               `json:"certificateStatus"`
+	CertificateFile           string               `json:"certificateFile"`
+	P12File                   string               `json:"p12File"`
+	Profiles                  []SigningSyncProfile `json:"profiles"`
+	Committed                 bool                 `json:"committed"`
+}
+
+// SigningSyncProfile is one synced provisioning profile.
+type SigningSyncProfile struct {
+	BundleID       string `json:"bundleId"`
+	ProfileType    string `json:"profileType"`
+	ProfileID      string `json:"profileId,omitempty"`
+	Name           string `json:"name"`
+	UUID           string `json:"uuid"`
+	ExpirationDate string `json:"expirationDate"`
+	Status         string `json:"status"`
+	ProfileFile    string `json:"profileFile"`
+}
@@ -3,6 +3,7 @@ package asc
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -24,6 +25,23 @@ type CertificateRevokeResult struct {
 	Revoked bool   `json:"revoked"`
 }
 
+// CertificateIdentityResult represents CLI output for certificates created
+// from a locally generated key.
+type CertificateIdentityResult struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name,omitempty"`
+	CertificateType    string `json:"certificateType"`
+	SerialNumber       string `json:"serialNumber,omitempty"`
+	ExpirationDate     string `json:"expirationDate,omitempty"`
+	KeyType            string `json:"keyType"`
+	KeyStore           string `json:"keyStore"`
+	CertificateFile    string `json:"certificateFile"`
+	CertificatePEMFile string `json:"certificatePemFile"`
+	PrivateKeyFile     string `json:"privateKeyFile,omitempty"`
+	KeychainItem       string `json:"keychainItem,omitempty"`
+	P12File            string `json:"p12File"`
+}
+
 // ProfileDeleteResult represents CLI output for profile deletions.
 type ProfileDeleteResult struct {
 	ID      string `json:"id"`
@@ -37,6 +55,55 @@ type ProfileDownloadResult struct {
 	OutputPath string `json:"outputPath"`
 }
 
+// ProfileInspectResult represents CLI output for a decoded local provisioning
+// profile.
+type ProfileInspectResult struct {
+	File                  string                      `json:"file"`
+	Name                  string                      `json:"name"`
+	UUID                  string                      `json:"uuid"`
+	TeamID                string                      `json:"teamId,omitempty"`
+	TeamName              string                      `json:"teamName,omitempty"`
+	AppIDName             string                      `json:"appIdName,omitempty"`
+	ApplicationIdentifier string                      `json:"applicationIdentifier,omitempty"`
+	BundleID              string                      `json:"bundleId,omitempty"`
+	Platforms             []string                    `json:"platforms,omitempty"`
+	CreationDate          string                      `json:"creationDate,omitempty"`
+	ExpirationDate        string                      `json:"expirationDate,omitempty"`
+	Expired               bool                        `json:"expired"`
+	ProvisionsAllDevices  bool                        `json:"provisionsAllDevices,omitempty"`
+	Devices               []string                    `json:"devices"`
+	Certificates          []ProfileInspectCertificate `json:"certificates"`
+	Entitlements          map[string]any              `json:"entitlements,omitempty"`
+	Compare               *ProfileCompareResult       `json:"compare,omitempty"`
+}
+
+// ProfileInspectCertificate describes a developer certificate embedded in a
+// provisioning profile.
+type ProfileInspectCertificate struct {
+	CommonName     string `json:"commonName,omitempty"`
+	SerialNumber   string `json:"serialNumber,omitempty"`
+	ExpirationDate string `json:"expirationDate,omitempty"`
+	SHA1           string `json:"sha1"`
+	SHA256         string `json:"sha256"`
+}
+
+// ProfileCompareResult reports drift between a local provisioning profile and
+// its live App Store Connect counterpart.
+type ProfileCompareResult struct {
+	ProfileID    string              `json:"profileId"`
+	ProfileState string              `json:"profileState,omitempty"`
+	InSync       bool                `json:"inSync"`
+	Differences  []ProfileDifference `json:"differences"`
+}
+
+// ProfileDifference is one field that differs between the local and live
+// profile. For devices and certificates, the side missing the item is empty.
+type ProfileDifference struct {
+	Field string `json:"field"`
+	Local string `json:"local,omitempty"`
+	Live  string `json:"live,omitempty"`
+}
+
 func bundleIDsRows(resp *BundleIDsResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Name", "Identifier", "Platform", "Seed ID"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -98,6 +165,25 @@ func certificateRevokeResultRows(result *CertificateRevokeResult) ([]string, [][
 	return headers, rows
 }
 
+func certificateIdentityResultRows(result *CertificateIdentityResult) ([]string, [][]string) {
+	headers := []string{"ID", "Type", "Serial", "Expiration", "Key Type", "Key", "Certificate File", "P12 File"}
+	key := result.PrivateKeyFile
+	if key == "" {
+		key = result.KeychainItem
+	}
+	rows := [][]string{{
+		result.ID,
+		result.CertificateType,
+		result.SerialNumber,
+		result.ExpirationDate,
+		result.KeyType,
+		key,
+		result.CertificateFile,
+		result.P12File,
+	}}
+	return headers, rows
+}
+
 func profilesRows(resp *ProfilesResponse) ([]string, [][]string) {
 	headers := []string{"ID", "Name", "Type", "State", "Expiration"}
 	rows := make([][]string, 0, len(resp.Data))
@@ -129,6 +215,68 @@ func profileDownloadResultRows(result *ProfileDownloadResult) ([]string, [][]str
 	return headers, rows
 }
 
+func profileInspectResultRows(result *ProfileInspectResult) ([]string, [][]string) {
+	headers := []string{"Field", "Value"}
+	rows := [][]string{
+		{"File", result.File},
+		{"Name", compactWhitespace(result.Name)},
+		{"UUID", result.UUID},
+		{"Team", strings.TrimSpace(result.TeamID + " " + compactWhitespace(result.TeamName))},
+		{"App ID", result.ApplicationIdentifier},
+		{"Platforms", joinSigningList(result.Platforms)},
+		{"Created", result.CreationDate},
+		{"Expires", result.ExpirationDate},
+		{"Expired", fmt.Sprintf("%t", result.Expired)},
+	}
+	if result.ProvisionsAllDevices {
+		rows = append(rows, []string{"Devices", "all"})
+	} else {
+		rows = append(rows, []string{"Devices", fmt.Sprintf("%d", len(result.Devices))})
+	}
+	for _, device := range result.Devices {
+		rows = append(rows, []string{"Device", device})
+	}
+	for _, cert := range result.Certificates {
+		parts := []string{"SHA-1 " + cert.SHA1}
+		if cert.CommonName != "" {
+			parts = append([]string{compactWhitespace(cert.CommonName)}, parts...)
+		}
+		if cert.ExpirationDate != "" {
+			parts = append(parts, "expires "+cert.ExpirationDate)
+		}
+		rows = append(rows, []string{"Certificate", strings.Join(parts, ", ")})
+	}
+	keys := make([]string, 0, len(result.Entitlements))
+	for key := range result.Entitlements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		rows = append(rows, []string{"Entitlement " + key, formatEntitlementValue(result.Entitlements[key])})
+	}
+	if compare := result.Compare; compare != nil {
+		rows = append(rows,
+			[]string{"Live Profile", strings.TrimSpace(compare.ProfileID + " " + compare.ProfileState)},
+			[]string{"In Sync", fmt.Sprintf("%t", compare.InSync)},
+		)
+		for _, diff := range compare.Differences {
+			rows = append(rows, []string{"Drift " + diff.Field, fmt.Sprintf("local %s, live %s", fallbackValue(diff.Local), fallbackValue(diff.Live))})
+		}
+	}
+	return headers, rows
+}
+
+func formatEntitlementValue(value any) string {
+	if text, ok := value.(string); ok {
+		return sanitizeTerminal(text)
+	}
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return sanitizeTerminal(string(payload))
+}
+
 func joinSigningList(values []string) string {
 	if len(values) == 0 {
 		return ""
@@ -151,6 +299,40 @@ func signingFetchResultRows(result *SigningFetchResult) ([]string, [][]string) {
 	return headers, rows
 }
 
+func signingSyncResultRows(result *SigningSyncResult) ([]string, [][]string) {
+	headers := []string{"Bundle ID", "Profile Type", "Profile UUID", "Profile Expiration", "Profile Status", "Certificate ID", "Certificate Status", "Profile File"}
+	rows := make([][]string, 0, len(result.Profiles))
+	for _, profile := range result.Profiles {
+		rows = append(rows, []string{
+			profile.BundleID,
+			profile.ProfileType,
+			profile.UUID,
+			profile.ExpirationDate,
+			profile.Status,
+			result.CertificateID,
+			result.CertificateStatus,
+			profile.ProfileFile,
+		})
+	}
+	return headers, rows
+}
+
+func signingAuditResultRows(result *SigningAuditResult) ([]string, [][]string) {
+	headers := []string{"Severity", "Check", "Resource Type", "Resource ID", "Name", "Message"}
+	rows := make([][]string, 0, len(result.Findings))
+	for _, finding := range result.Findings {
+		rows = append(rows, []string{
+			finding.Severity,
+			finding.Check,
+			finding.ResourceType,
+			finding.ResourceID,
+			compactWhitespace(finding.Name),
+			sanitizeTerminal(finding.Message),
+		})
+	}
+	return headers, rows
+}
+
 func formatCapabilitySettings(settings []CapabilitySetting) string {
 	if len(settings) == 0 {
 		return ""
@@ -0,0 +1,230 @@
+package asc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const simulationRedacted = "[REDACTED]"
+
+// sensitiveBodyKeys are request body attributes redacted from simulation plans
+// (matched case-insensitively as substrings of the key).
+var sensitiveBodyKeys = []string{"password", "secret", "token", "privatekey", "apikey"}
+
+// SimulatedOperation is a mutating request that was planned but not sent.
+type SimulatedOperation struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	Bytes  int64           `json:"bytes,omitempty"`
+}
+
+// SimulationPlan records mutating requests intercepted while simulation is
+// active. GET requests are still sent so commands can resolve IDs.
+type SimulationPlan struct {
+	mu         sync.Mutex
+	operations []SimulatedOperation
+}
+
+// SimulationReport is the printable form of a plan.
+type SimulationReport struct {
+	Simulated  bool                 `json:"simulated"`
+	Operations []SimulatedOperation `json:"operations"`
+}
+
+var simulationState struct {
+	mu   sync.Mutex
+	plan *SimulationPlan
+}
+
+// SetSimulationPlan enables simulation, recording into plan. Passing nil
+// disables it.
+func SetSimulationPlan(plan *SimulationPlan) {
+	simulationState.mu.Lock()
+	defer simulationState.mu.Unlock()
+	simulationState.plan = plan
+}
+
+// SimulationActive reports whether mutating requests are currently being planned
+// instead of sent.
+func SimulationActive() bool {
+	return activeSimulationPlan() != nil
+}
+
+// SimulateLocalChange records a local file change (WRITE or REMOVE) in the
+// active plan and reports whether the caller should skip it. It returns false
+// when simulation is not active.
+func SimulateLocalChange(action, path string) bool {
+	plan := activeSimulationPlan()
+	if plan == nil {
+		return false
+	}
+	plan.mu.Lock()
+	defer plan.mu.Unlock()
+	plan.operations = append(plan.operations, SimulatedOperation{
+		Method: strings.ToUpper(action),
+		Path:   path,
+	})
+	return true
+}
+
+func activeSimulationPlan() *SimulationPlan {
+	simulationState.mu.Lock()
+	defer simulationState.mu.Unlock()
+	return simulationState.plan
+}
+
+// Operations returns the recorded operations in order.
+func (p *SimulationPlan) Operations() []SimulatedOperation {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]SimulatedOperation(nil), p.operations...)
+}
+
+// Report returns the plan in printable form.
+func (p *SimulationPlan) Report() *SimulationReport {
+	operations := p.Operations()
+	if operations == nil {
+		operations = []SimulatedOperation{}
+	}
+	return &SimulationReport{Simulated: true, Operations: operations}
+}
+
+// interceptRequest records a mutating API request and returns the response
+// the caller should see instead. ok is false when the request should be sent.
+func (p *SimulationPlan) interceptRequest(method, path string, body []byte) (response []byte, ok bool) {
+	if p == nil || !isMutatingMethod(method) {
+		return nil, false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.operations = append(p.operations, SimulatedOperation{
+		Method: strings.ToUpper(method),
+		Path:   simulatedPath(path),
+		Body:   redactBody(body),
+	})
+	return simulatedResponse(method, body, len(p.operations)), true
+}
+
+// interceptUpload records an upload operation that should not be sent.
+func (p *SimulationPlan) interceptUpload(method, rawURL string, length int64) bool {
+	if p == nil {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.operations = append(p.operations, SimulatedOperation{
+		Method: strings.ToUpper(method),
+		Path:   sanitizeURLForLog(rawURL),
+		Bytes:  length,
+	})
+	return true
+}
+
+func simulatedPath(path string) string {
+	if base := ResolveBaseURL(); strings.HasPrefix(path, base) {
+		return strings.TrimPrefix(path, base)
+	}
+	return sanitizeURLForLog(path)
+}
+
+// simulatedResponse echoes a JSON:API request body back as the created or
+// updated resource so follow-up steps can proceed. Created resources get a
+// placeholder ID, and upload reservations get a single placeholder upload
+// operation covering the whole file.
+func simulatedResponse(method string, body []byte, sequence int) []byte {
+	if strings.EqualFold(method, http.MethodDelete) || len(body) == 0 {
+		return nil
+	}
+	var document struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil
+	}
+	var resource map[string]any
+	if err := json.Unmarshal(document.Data, &resource); err != nil {
+		// Relationship linkage bodies ({"data": [...]}) have no response.
+		return nil
+	}
+	id, _ := resource["id"].(string)
+	if id == "" {
+		id = fmt.Sprintf("simulated-%d", sequence)
+		resource["id"] = id
+	}
+	if attributes, ok := resource["attributes"].(map[string]any); ok {
+		addSimulatedUploadOperations(attributes, id)
+	}
+	response, err := json.Marshal(map[string]any{"data": resource})
+	if err != nil {
+		return nil
+	}
+	return response
+}
+
+// addSimulatedUploadOperations fills in uploadOperations for reservation bodies
+// (those declaring a fileSize) so upload steps run against the plan.
+func addSimulatedUploadOperations(attributes map[string]any, id string) {
+	if _, ok := attributes["uploadOperations"]; ok {
+		return
+	}
+	size, ok := attributes["fileSize"].(float64)
+	if !ok || size <= 0 {
+		return
+	}
+	attributes["uploadOperations"] = []map[string]any{{
+		"method": http.MethodPut,
+		"url":    "https://simulated.invalid/uploads/" + id,
+		"length": int64(size),
+		"offset": 0,
+	}}
+}
+
+func redactBody(body []byte) json.RawMessage {
+	if len(body) == 0 {
+		return nil
+	}
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return json.RawMessage(fmt.Sprintf("%q", fmt.Sprintf("<%d bytes>", len(body))))
+	}
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return nil
+	}
+	return redacted
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if isSensitiveBodyKey(key) {
+				v[key] = simulationRedacted
+				continue
+			}
+			v[key] = redactValue(item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	default:
+		return value
+	}
+}
+
+func isSensitiveBodyKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveBodyKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
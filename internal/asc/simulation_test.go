@@ -0,0 +1,117 @@
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSimulationPlanInterceptsMutatingRequests(t *testing.T) {
+	plan := &SimulationPlan{}
+
+	if _, ok := plan.interceptRequest(http.MethodGet, "/v1/apps", nil); ok {
+		t.Fatal("expected GET to pass through")
+	}
+
+	response, ok := plan.interceptRequest(http.MethodPost, "/v1/betaGroups", []byte(`{"data":{"type":"betaGroups","attributes":{"name":"Beta"}}}`))
+	if !ok {
+		t.Fatal("expected POST to be intercepted")
+	}
+	var created struct {
+		Data struct {
+			Type       string            `json:"type"`
+			ID         string            `json:"id"`
+			Attributes map[string]string `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(response, &created); err != nil {
+		t.Fatalf("invalid synthesized response %q: %v", response, err)
+	}
+	if created.Data.Type != "betaGroups" || created.Data.ID != "simulated-1" || created.Data.Attributes["name"] != "Beta" {
+		t.Fatalf("unexpected synthesized response: %+v", created.Data)
+	}
+
+	response, _ = plan.interceptRequest(http.MethodPatch, ResolveBaseURL()+"/v1/apps/1", []byte(`{"data":{"type":"apps","id":"1"}}`))
+	if !strings.Contains(string(response), `"id":"1"`) {
+		t.Fatalf("expected PATCH to keep the resource ID, got %s", response)
+	}
+	if response, _ := plan.interceptRequest(http.MethodDelete, "/v1/betaGroups/1", nil); response != nil {
+		t.Fatalf("expected empty DELETE response, got %s", response)
+	}
+
+	operations := plan.Operations()
+	if len(operations) != 3 || operations[1].Path != "/v1/apps/1" || operations[2].Method != http.MethodDelete {
+		t.Fatalf("unexpected operations: %+v", operations)
+	}
+}
+
+func TestSimulationPlanReturnsPlaceholderUploadOperations(t *testing.T) {
+	plan := &SimulationPlan{}
+
+	response, _ := plan.interceptRequest(http.MethodPost, "/v1/buildUploadFiles", []byte(`{"data":{"type":"buildUploadFiles","attributes":{"fileName":"app.ipa","fileSize":42}}}`))
+	var reserved BuildUploadFileResponse
+	if err := json.Unmarshal(response, &reserved); err != nil {
+		t.Fatalf("invalid synthesized response %q: %v", response, err)
+	}
+	operations := reserved.Data.Attributes.UploadOperations
+	if len(operations) != 1 || operations[0].Method != http.MethodPut || operations[0].Length != 42 || operations[0].Offset != 0 {
+		t.Fatalf("unexpected placeholder upload operations: %+v", operations)
+	}
+
+	response, _ = plan.interceptRequest(http.MethodPost, "/v1/betaGroups", []byte(`{"data":{"type":"betaGroups","attributes":{"name":"Beta"}}}`))
+	if strings.Contains(string(response), "uploadOperations") {
+		t.Fatalf("expected no upload operations for non-reservations, got %s", response)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	body := redactBody([]byte(`{"data":{"attributes":{"demoAccountPassword":"p","secret":"s","items":[{"apiKey":"k","name":"n"}]}}}`))
+	for _, leaked := range []string{`"p"`, `"s"`, `"k"`} {
+		if strings.Contains(string(body), leaked) {
+			t.Fatalf("expected %s to be redacted, got %s", leaked, body)
+		}
+	}
+	if !strings.Contains(string(body), `"name":"n"`) {
+		t.Fatalf("expected non-sensitive fields to be kept, got %s", body)
+	}
+	if got := string(redactBody([]byte("binary"))); got != `"<6 bytes>"` {
+		t.Fatalf("expected non-JSON body summary, got %s", got)
+	}
+}
+
+func TestSimulationSkipsUploadOperations(t *testing.T) {
+	plan := &SimulationPlan{}
+	SetSimulationPlan(plan)
+	t.Cleanup(func() { SetSimulationPlan(nil) })
+
+	path := filepath.Join(t.TempDir(), "file.bin")
+	if err := os.WriteFile(path, []byte("0123456789"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	operations := []UploadOperation{
+		{Method: "PUT", URL: "https://upload.example.com/part1?X-Amz-Signature=secret", Offset: 0, Length: 5},
+		{Method: "PUT", URL: "https://upload.example.com/part2", Offset: 5, Length: 5},
+	}
+	client := &http.Client{Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+		t.Fatal("upload request should not be sent while simulating")
+		return nil, nil
+	})}
+	if err := ExecuteUploadOperations(context.Background(), path, operations, WithUploadHTTPClient(client)); err != nil {
+		t.Fatalf("ExecuteUploadOperations() error: %v", err)
+	}
+	if err := UploadAsset(context.Background(), path, operations[:1]); err != nil {
+		t.Fatalf("UploadAsset() error: %v", err)
+	}
+
+	recorded := plan.Operations()
+	if len(recorded) != 3 || recorded[1].Bytes != 5 {
+		t.Fatalf("unexpected recorded uploads: %+v", recorded)
+	}
+	if strings.Contains(recorded[0].Path, "secret") {
+		t.Fatalf("expected signed query to be redacted, got %s", recorded[0].Path)
+	}
+}
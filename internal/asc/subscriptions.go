@@ -201,6 +201,7 @@ type subscriptionGroupsQuery struct {
 
 type subscriptionsQuery struct {
 	listQuery
+	productIDs []string
 }
 
 type subscriptionAvailabilityTerritoriesQuery struct {
@@ -234,6 +235,13 @@ func WithSubscriptionsLimit(limit int) SubscriptionsOption {
 	}
 }
 
+// WithSubscriptionsProductIDs filters subscriptions by product ID(s).
+func WithSubscriptionsProductIDs(productIDs []string) SubscriptionsOption {
+	return func(q *subscriptionsQuery) {
+		q.productIDs = normalizeList(productIDs)
+	}
+}
+
 // WithSubscriptionsNextURL uses a next page URL directly.
 func WithSubscriptionsNextURL(next string) SubscriptionsOption {
 	return func(q *subscriptionsQuery) {
@@ -269,6 +277,7 @@ func buildSubscriptionGroupsQuery(query *subscriptionGroupsQuery) string {
 
 func buildSubscriptionsQuery(query *subscriptionsQuery) string {
 	values := url.Values{}
+	addCSV(values, "filter[productId]", query.productIDs)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -1,7 +1,13 @@
 package asc
 
 import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
 	"os"
+	"strings"
+	"unicode"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/olekukonko/tablewriter/renderer"
@@ -52,3 +58,83 @@ func RenderMarkdown(headers []string, rows [][]string) {
 	_ = table.Bulk(rows)
 	_ = table.Render()
 }
+
+// RenderCSV writes headers and rows as RFC 4180 CSV to stdout.
+func RenderCSV(headers []string, rows [][]string) error {
+	writer := csv.NewWriter(os.Stdout)
+	if err := writer.Write(headers); err != nil {
+		return err
+	}
+	return writer.WriteAll(rows)
+}
+
+// RenderNDJSON writes one JSON object per row to stdout. Keys are the
+// headers in snake_case ("Bundle ID" becomes "bundle_id") and keep column
+// order. Headers that normalize to the same key get a numeric suffix.
+func RenderNDJSON(headers []string, rows [][]string) error {
+	names := ndjsonFieldNames(headers)
+	keys := make([][]byte, len(names))
+	for i, name := range names {
+		keys[i], _ = json.Marshal(name)
+	}
+
+	var buf bytes.Buffer
+	for _, row := range rows {
+		buf.Reset()
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			value := ""
+			if i < len(row) {
+				value = row[i]
+			}
+			encoded, _ := json.Marshal(value)
+			buf.Write(key)
+			buf.WriteByte(':')
+			buf.Write(encoded)
+		}
+		buf.WriteString("}\n")
+		if _, err := os.Stdout.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ndjsonFieldNames returns a unique NDJSON key for each header.
+func ndjsonFieldNames(headers []string) []string {
+	names := make([]string, len(headers))
+	used := make(map[string]bool, len(headers))
+	for i, header := range headers {
+		base := ndjsonFieldName(header)
+		name := base
+		for n := 2; used[name]; n++ {
+			name = fmt.Sprintf("%s_%d", base, n)
+		}
+		used[name] = true
+		names[i] = name
+	}
+	return names
+}
+
+func ndjsonFieldName(header string) string {
+	var b strings.Builder
+	pendingSeparator := false
+	for _, r := range strings.TrimSpace(header) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if pendingSeparator && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+			pendingSeparator = false
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		pendingSeparator = true
+	}
+	if b.Len() == 0 {
+		return "field"
+	}
+	return b.String()
+}
@@ -20,6 +20,7 @@ type UploadOptions struct {
 	Concurrency int
 	Client      *http.Client
 	RetryOpts   RetryOptions
+	Journal     *UploadJournal
 }
 
 // UploadOption configures upload options.
@@ -44,6 +45,14 @@ func WithUploadHTTPClient(client *http.Client) UploadOption {
 	}
 }
 
+// WithUploadJournal records completed operations in journal and skips
+// operations the journal already marks as completed.
+func WithUploadJournal(journal *UploadJournal) UploadOption {
+	return func(opts *UploadOptions) {
+		opts.Journal = journal
+	}
+}
+
 // newUploadClient creates a dedicated HTTP client for upload operations
 // with appropriate timeouts and a cloned transport when possible to avoid
 // sharing the connection pool with http.DefaultClient.
@@ -81,8 +90,16 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 	if uploadOpts.Client == nil {
 		uploadOpts.Client = newUploadClient()
 	}
-	if uploadOpts.Concurrency > len(operations) {
-		uploadOpts.Concurrency = len(operations)
+
+	pending := make([]uploadTask, 0, len(operations))
+	for i, op := range operations {
+		if uploadOpts.Journal != nil && uploadOpts.Journal.IsCompleted(i) {
+			continue
+		}
+		pending = append(pending, uploadTask{index: i, op: op})
+	}
+	if uploadOpts.Concurrency > len(pending) {
+		uploadOpts.Concurrency = len(pending)
 	}
 
 	file, err := openUploadSourceFile(filePath)
@@ -115,6 +132,20 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 		}
 	}
 
+	if len(pending) == 0 {
+		return nil
+	}
+	if plan := activeSimulationPlan(); plan != nil {
+		for _, task := range pending {
+			method := strings.ToUpper(strings.TrimSpace(task.op.Method))
+			if method == "" {
+				method = http.MethodPut
+			}
+			plan.interceptUpload(method, task.op.URL, task.op.Length)
+		}
+		return nil
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -140,6 +171,12 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 				setErr(err)
 				return
 			}
+			if uploadOpts.Journal != nil {
+				if err := uploadOpts.Journal.MarkCompleted(task.index); err != nil {
+					setErr(fmt.Errorf("update upload journal: %w", err))
+					return
+				}
+			}
 		}
 	}
 
@@ -149,11 +186,11 @@ func ExecuteUploadOperations(ctx context.Context, filePath string, operations []
 	}
 
 sendLoop:
-	for i, op := range operations {
+	for _, task := range pending {
 		select {
 		case <-ctx.Done():
 			break sendLoop
-		case jobs <- uploadTask{index: i, op: op}:
+		case jobs <- task:
 		}
 	}
 	close(jobs)
@@ -204,7 +241,7 @@ func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask,
 			req.Header.Set(header.Name, header.Value)
 		}
 
-		resp, err := uploadOpts.Client.Do(req)
+		resp, err := sendHTTP(uploadOpts.Client, req)
 		if err != nil {
 			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 				return struct{}{}, err
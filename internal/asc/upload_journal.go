@@ -0,0 +1,219 @@
+package asc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+const uploadJournalDirName = "uploads"
+
+// UploadJournalKey identifies the upload a journal belongs to.
+// A journal is only reused when every field matches.
+type UploadJournalKey struct {
+	AppID        string   `json:"appId"`
+	Version      string   `json:"version"`
+	BuildNumber  string   `json:"buildNumber"`
+	Platform     Platform `json:"platform"`
+	FileChecksum string   `json:"fileChecksum"`
+}
+
+// UploadJournal records which upload operations have completed so an
+// interrupted upload can resume without re-sending finished parts.
+type UploadJournal struct {
+	UploadJournalKey
+	UploadID   string            `json:"uploadId"`
+	FileID     string            `json:"fileId"`
+	FileName   string            `json:"fileName"`
+	FileSize   int64             `json:"fileSize"`
+	Operations []UploadOperation `json:"operations"`
+	Completed  []int             `json:"completed"`
+	UpdatedAt  time.Time         `json:"updatedAt"`
+
+	SourceFileChecksums *Checksums `json:"sourceFileChecksums,omitempty"`
+
+	mu   sync.Mutex
+	path string
+}
+
+// DefaultUploadJournalDir returns the directory used for upload journals.
+func DefaultUploadJournalDir() (string, error) {
+	path, err := config.GlobalPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(path), uploadJournalDirName), nil
+}
+
+// CreateUploadJournal writes a new journal for an upload to dir.
+func CreateUploadJournal(dir string, journal *UploadJournal) error {
+	if journal == nil {
+		return errors.New("upload journal is required")
+	}
+	uploadID := strings.TrimSpace(journal.UploadID)
+	if uploadID == "" || strings.ContainsAny(uploadID, `/\`) || uploadID == "." || uploadID == ".." {
+		return fmt.Errorf("invalid upload ID %q for journal", journal.UploadID)
+	}
+	if strings.TrimSpace(journal.FileChecksum) == "" {
+		return errors.New("upload journal requires a file checksum")
+	}
+	journal.path = filepath.Join(dir, uploadID+".json")
+	return journal.save()
+}
+
+// FindUploadJournal returns the journal in dir matching key, or nil when none exists.
+func FindUploadJournal(dir string, key UploadJournalKey) (*UploadJournal, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read upload journals: %w", err)
+	}
+
+	var latest *UploadJournal
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		journal, err := loadUploadJournal(path)
+		if err != nil {
+			continue
+		}
+		if !strings.EqualFold(journal.FileChecksum, key.FileChecksum) ||
+			journal.AppID != key.AppID ||
+			journal.Version != key.Version ||
+			journal.BuildNumber != key.BuildNumber ||
+			journal.Platform != key.Platform {
+			continue
+		}
+		if latest == nil || journal.UpdatedAt.After(latest.UpdatedAt) {
+			latest = journal
+		}
+	}
+	return latest, nil
+}
+
+func loadUploadJournal(path string) (*UploadJournal, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var journal UploadJournal
+	if err := json.Unmarshal(data, &journal); err != nil {
+		return nil, err
+	}
+	journal.path = path
+	return &journal, nil
+}
+
+// IsCompleted reports whether the operation at index has already been uploaded.
+func (j *UploadJournal) IsCompleted(index int) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return slices.Contains(j.Completed, index)
+}
+
+// CompletedCount returns the number of completed operations.
+func (j *UploadJournal) CompletedCount() int {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return len(j.Completed)
+}
+
+// Expired reports whether any pending operation's presigned URL has expired.
+func (j *UploadJournal) Expired(now time.Time) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for i, op := range j.Operations {
+		if slices.Contains(j.Completed, i) || op.Expiration == nil {
+			continue
+		}
+		expiresAt, err := time.Parse(time.RFC3339, strings.TrimSpace(*op.Expiration))
+		if err != nil {
+			continue
+		}
+		if !now.Before(expiresAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// MarkCompleted records the operation at index as uploaded and persists the journal.
+func (j *UploadJournal) MarkCompleted(index int) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if slices.Contains(j.Completed, index) {
+		return nil
+	}
+	j.Completed = append(j.Completed, index)
+	slices.Sort(j.Completed)
+	return j.saveLocked()
+}
+
+// Remove deletes the journal file once the upload is committed.
+func (j *UploadJournal) Remove() error {
+	if j == nil || j.path == "" {
+		return nil
+	}
+	if err := os.Remove(j.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (j *UploadJournal) save() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.saveLocked()
+}
+
+func (j *UploadJournal) saveLocked() error {
+	if j.path == "" {
+		return errors.New("upload journal path is not set")
+	}
+	if j.Completed == nil {
+		j.Completed = []int{}
+	}
+	j.UpdatedAt = time.Now().UTC()
+
+	dir := filepath.Dir(j.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create upload journal directory: %w", err)
+	}
+	data, err := json.Marshal(j)
+	if err != nil {
+		return fmt.Errorf("encode upload journal: %w", err)
+	}
+
+	// Journals hold presigned upload URLs, so keep them private and write atomically.
+	tempFile, err := os.CreateTemp(dir, ".upload-journal-*")
+	if err != nil {
+		return fmt.Errorf("write upload journal: %w", err)
+	}
+	tempName := tempFile.Name()
+	if _, err := tempFile.Write(data); err != nil {
+		_ = tempFile.Close()
+		_ = os.Remove(tempName)
+		return fmt.Errorf("write upload journal: %w", err)
+	}
+	if err := tempFile.Close(); err != nil {
+		_ = os.Remove(tempName)
+		return fmt.Errorf("write upload journal: %w", err)
+	}
+	if err := os.Rename(tempName, j.path); err != nil {
+		_ = os.Remove(tempName)
+		return fmt.Errorf("write upload journal: %w", err)
+	}
+	return nil
+}
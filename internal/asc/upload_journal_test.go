@@ -0,0 +1,174 @@
+package asc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestUploadJournal_CreateFindAndRemove(t *testing.T) {
+	dir := t.TempDir()
+	key := UploadJournalKey{
+		AppID:        "app-1",
+		Version:      "1.0.0",
+		BuildNumber:  "42",
+		Platform:     PlatformIOS,
+		FileChecksum: "ABC123",
+	}
+	journal := &UploadJournal{
+		UploadJournalKey: key,
+		UploadID:         "upload-1",
+		FileID:           "file-1",
+		FileName:         "app.ipa",
+		FileSize:         26,
+		Operations: []UploadOperation{
+			{Method: "PUT", URL: "https://example.com/0", Length: 13, Offset: 0},
+			{Method: "PUT", URL: "https://example.com/1", Length: 13, Offset: 13},
+		},
+	}
+	if err := CreateUploadJournal(dir, journal); err != nil {
+		t.Fatalf("CreateUploadJournal() error: %v", err)
+	}
+	if err := journal.MarkCompleted(1); err != nil {
+		t.Fatalf("MarkCompleted() error: %v", err)
+	}
+
+	info, err := os.Stat(filepath.Join(dir, "upload-1.json"))
+	if err != nil {
+		t.Fatalf("stat journal: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm&0o077 != 0 {
+		t.Fatalf("expected private journal permissions, got %v", perm)
+	}
+
+	lookup := key
+	lookup.FileChecksum = "abc123"
+	found, err := FindUploadJournal(dir, lookup)
+	if err != nil {
+		t.Fatalf("FindUploadJournal() error: %v", err)
+	}
+	if found == nil {
+		t.Fatal("expected journal to be found")
+	}
+	if found.UploadID != "upload-1" || found.FileID != "file-1" || len(found.Operations) != 2 {
+		t.Fatalf("unexpected journal: %+v", found)
+	}
+	if found.IsCompleted(0) || !found.IsCompleted(1) {
+		t.Fatalf("expected only operation 1 completed, got %v", found.Completed)
+	}
+
+	other := key
+	other.BuildNumber = "43"
+	missing, err := FindUploadJournal(dir, other)
+	if err != nil {
+		t.Fatalf("FindUploadJournal() error: %v", err)
+	}
+	if missing != nil {
+		t.Fatalf("expected no journal for different build number, got %+v", missing)
+	}
+
+	if err := found.Remove(); err != nil {
+		t.Fatalf("Remove() error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "upload-1.json")); !os.IsNotExist(err) {
+		t.Fatalf("expected journal file removed, got %v", err)
+	}
+}
+
+func TestFindUploadJournal_MissingDir(t *testing.T) {
+	journal, err := FindUploadJournal(filepath.Join(t.TempDir(), "missing"), UploadJournalKey{FileChecksum: "abc"})
+	if err != nil {
+		t.Fatalf("FindUploadJournal() error: %v", err)
+	}
+	if journal != nil {
+		t.Fatalf("expected nil journal, got %+v", journal)
+	}
+}
+
+func TestCreateUploadJournal_RejectsInvalidUploadID(t *testing.T) {
+	for _, id := range []string{"", "..", "a/b"} {
+		err := CreateUploadJournal(t.TempDir(), &UploadJournal{
+			UploadJournalKey: UploadJournalKey{FileChecksum: "abc"},
+			UploadID:         id,
+		})
+		if err == nil {
+			t.Fatalf("expected error for upload ID %q", id)
+		}
+	}
+}
+
+func TestExecuteUploadOperations_ResumesFromJournal(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "app.ipa")
+	if err := os.WriteFile(filePath, []byte("abcdefghij"), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	var mu sync.Mutex
+	received := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		received[r.URL.Path] = string(body)
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ops := []UploadOperation{
+		{Method: "PUT", URL: server.URL + "/op0", Length: 5, Offset: 0},
+		{Method: "PUT", URL: server.URL + "/op1", Length: 5, Offset: 5},
+	}
+	journal := &UploadJournal{
+		UploadJournalKey: UploadJournalKey{FileChecksum: "abc"},
+		UploadID:         "upload-1",
+		Operations:       ops,
+	}
+	if err := CreateUploadJournal(filepath.Join(dir, "journals"), journal); err != nil {
+		t.Fatalf("CreateUploadJournal() error: %v", err)
+	}
+	if err := journal.MarkCompleted(0); err != nil {
+		t.Fatalf("MarkCompleted() error: %v", err)
+	}
+
+	err := ExecuteUploadOperations(context.Background(), filePath, ops,
+		WithUploadHTTPClient(server.Client()),
+		WithUploadJournal(journal),
+	)
+	if err != nil {
+		t.Fatalf("ExecuteUploadOperations() error: %v", err)
+	}
+
+	mu.Lock()
+	if _, ok := received["/op0"]; ok {
+		t.Fatal("expected completed operation 0 to be skipped")
+	}
+	if received["/op1"] != "fghij" {
+		t.Fatalf("expected /op1 body=fghij, got %q", received["/op1"])
+	}
+	if journal.CompletedCount() != 2 {
+		t.Fatalf("expected 2 completed operations, got %v", journal.Completed)
+	}
+
+	// A fully completed journal needs no further requests.
+	received = map[string]string{}
+	mu.Unlock()
+
+	err = ExecuteUploadOperations(context.Background(), filePath, ops,
+		WithUploadHTTPClient(server.Client()),
+		WithUploadJournal(journal),
+	)
+	if err != nil {
+		t.Fatalf("ExecuteUploadOperations() error: %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(received) != 0 {
+		t.Fatalf("expected no requests, got %v", received)
+	}
+}
@@ -0,0 +1,232 @@
+package asc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// WebhookSignatureHeader carries the HMAC-SHA256 of the delivery body, keyed
+// with the webhook secret, as "hmacsha256=<hex>".
+const WebhookSignatureHeader = "X-Apple-Signature"
+
+// WebhookEventPing is sent by "asc webhooks ping".
+const WebhookEventPing WebhookEventType = "WEBHOOK_PING_CREATED"
+
+// ErrInvalidWebhookSignature is returned when a delivery signature is missing
+// or does not match the secret.
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
+// VerifyWebhookSignature checks a delivery body against its signature header.
+func VerifyWebhookSignature(secret string, body []byte, signature string) error {
+	signature = strings.TrimSpace(signature)
+	if algorithm, value, ok := strings.Cut(signature, "="); ok {
+		if !strings.EqualFold(algorithm, "hmacsha256") {
+			return ErrInvalidWebhookSignature
+		}
+		signature = value
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil || len(got) == 0 {
+		return ErrInvalidWebhookSignature
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidWebhookSignature
+	}
+	return nil
+}
+
+// SignWebhookPayload returns the signature header value for body.
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "hmacsha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookEvent is a decoded webhook delivery. The typed payload matching the
+// event type (BuildUpload, AppStoreVersion, or Feedback) is set when one is
+// defined for it.
+type WebhookEvent struct {
+	ID          string           `json:"id"`
+	Type        WebhookEventType `json:"type"`
+	PayloadType string           `json:"payloadType"`
+	Version     int              `json:"version,omitempty"`
+	Timestamp   string           `json:"timestamp,omitempty"`
+	// OldValue and NewValue are set for state change events, e.g. a build
+	// upload moving to COMPLETE or a version moving to READY_FOR_SALE.
+	OldValue   string          `json:"oldValue,omitempty"`
+	NewValue   string          `json:"newValue,omitempty"`
+	Instance   *ResourceData   `json:"instance,omitempty"`
+	Attributes json.RawMessage `json:"attributes,omitempty"`
+
+	BuildUpload     *WebhookBuildUploadEvent     `json:"buildUpload,omitempty"`
+	AppStoreVersion *WebhookAppStoreVersionEvent `json:"appStoreVersion,omitempty"`
+	Feedback        *WebhookFeedbackEvent        `json:"feedback,omitempty"`
+}
+
+// WebhookBuildUploadEvent is the payload of BUILD_UPLOAD_STATE_UPDATED.
+type WebhookBuildUploadEvent struct {
+	BuildUploadID string `json:"buildUploadId"`
+	OldState      string `json:"oldState,omitempty"`
+	NewState      string `json:"newState"`
+}
+
+// Processed reports whether the upload finished processing into a build.
+func (e *WebhookBuildUploadEvent) Processed() bool {
+	return e.NewState == "COMPLETE"
+}
+
+// WebhookAppStoreVersionEvent is the payload of
+// APP_STORE_VERSION_APP_VERSION_STATE_UPDATED.
+type WebhookAppStoreVersionEvent struct {
+	VersionID string `json:"versionId"`
+	OldState  string `json:"oldState,omitempty"`
+	NewState  string `json:"newState"`
+}
+
+// WebhookFeedbackEvent is the payload of the BETA_FEEDBACK_*_SUBMISSION_CREATED
+// events. Kind is "screenshot" or "crash".
+type WebhookFeedbackEvent struct {
+	Kind         string `json:"kind"`
+	SubmissionID string `json:"submissionId"`
+}
+
+// IsStateChange reports whether the event describes a state transition.
+func (e *WebhookEvent) IsStateChange() bool {
+	return strings.HasSuffix(string(e.Type), "_STATE_UPDATED")
+}
+
+// IsFeedback reports whether the event is a TestFlight feedback submission.
+func (e *WebhookEvent) IsFeedback() bool {
+	return strings.HasPrefix(string(e.Type), "BETA_FEEDBACK_")
+}
+
+type webhookPayload struct {
+	Data struct {
+		Type          string          `json:"type"`
+		ID            string          `json:"id"`
+		Version       int             `json:"version"`
+		Attributes    json.RawMessage `json:"attributes"`
+		Relationships struct {
+			Instance *struct {
+				Data *ResourceData `json:"data"`
+			} `json:"instance"`
+		} `json:"relationships"`
+	} `json:"data"`
+}
+
+type webhookPayloadAttributes struct {
+	Timestamp string          `json:"timestamp"`
+	OldValue  json.RawMessage `json:"oldValue"`
+	NewValue  json.RawMessage `json:"newValue"`
+}
+
+// ParseWebhookEvent decodes a webhook delivery body.
+func ParseWebhookEvent(body []byte) (*WebhookEvent, error) {
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload: %w", err)
+	}
+	if strings.TrimSpace(payload.Data.Type) == "" {
+		return nil, fmt.Errorf("invalid webhook payload: missing data.type")
+	}
+
+	event := &WebhookEvent{
+		ID:          payload.Data.ID,
+		Type:        WebhookEventTypeFromPayload(payload.Data.Type),
+		PayloadType: payload.Data.Type,
+		Version:     payload.Data.Version,
+	}
+	if instance := payload.Data.Relationships.Instance; instance != nil && instance.Data != nil {
+		event.Instance = instance.Data
+	}
+	if len(payload.Data.Attributes) > 0 && string(payload.Data.Attributes) != "null" {
+		event.Attributes = payload.Data.Attributes
+		var attrs webhookPayloadAttributes
+		if err := json.Unmarshal(payload.Data.Attributes, &attrs); err != nil {
+			return nil, fmt.Errorf("invalid webhook payload attributes: %w", err)
+		}
+		event.Timestamp = attrs.Timestamp
+		event.OldValue = webhookStateValue(attrs.OldValue)
+		event.NewValue = webhookStateValue(attrs.NewValue)
+	}
+	decodeWebhookEventPayload(event)
+	return event, nil
+}
+
+// decodeWebhookEventPayload sets the typed payload for event's type.
+func decodeWebhookEventPayload(event *WebhookEvent) {
+	instanceID := ""
+	if event.Instance != nil {
+		instanceID = event.Instance.ID
+	}
+	switch event.Type {
+	case WebhookEventBuildUploadStateUpdated:
+		event.BuildUpload = &WebhookBuildUploadEvent{
+			BuildUploadID: instanceID,
+			OldState:      event.OldValue,
+			NewState:      event.NewValue,
+		}
+	case WebhookEventAppStoreVersionStateUpdated:
+		event.AppStoreVersion = &WebhookAppStoreVersionEvent{
+			VersionID: instanceID,
+			OldState:  event.OldValue,
+			NewState:  event.NewValue,
+		}
+	case WebhookEventBetaFeedbackScreenshotSubmissionCreated:
+		event.Feedback = &WebhookFeedbackEvent{Kind: "screenshot", SubmissionID: instanceID}
+	case WebhookEventBetaFeedbackCrashSubmissionCreated:
+		event.Feedback = &WebhookFeedbackEvent{Kind: "crash", SubmissionID: instanceID}
+	}
+}
+
+// webhookStateValue reads a state value that is either a string or an object
+// with a single state field. Objects are read in a fixed key order ("state"
+// first, then alphabetically) so the result never depends on map order.
+func webhookStateValue(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return value
+	}
+	var object map[string]any
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return ""
+	}
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		if key != "state" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range append([]string{"state"}, keys...) {
+		if text, ok := object[key].(string); ok {
+			return text
+		}
+	}
+	return ""
+}
+
+// WebhookEventTypeFromPayload converts a payload type such as
+// "buildUploadStateUpdated" to its event type (BUILD_UPLOAD_STATE_UPDATED).
+func WebhookEventTypeFromPayload(payloadType string) WebhookEventType {
+	var b strings.Builder
+	for i, r := range strings.TrimSpace(payloadType) {
+		if unicode.IsUpper(r) && i > 0 {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return WebhookEventType(b.String())
+}
@@ -0,0 +1,89 @@
+package asc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	body := []byte(`{"data":{"type":"webhookPingCreated","id":"1"}}`)
+	signature := SignWebhookPayload("secret", body)
+
+	if err := VerifyWebhookSignature("secret", body, signature); err != nil {
+		t.Fatalf("expected valid signature, got %v", err)
+	}
+	if err := VerifyWebhookSignature("secret", body, signature[len("hmacsha256="):]); err != nil {
+		t.Fatalf("expected bare hex signature to be accepted, got %v", err)
+	}
+	for name, value := range map[string]string{
+		"missing":      "",
+		"wrong secret": SignWebhookPayload("other", body),
+		"not hex":      "hmacsha256=zz",
+		"algorithm":    "sha1=" + signature[len("hmacsha256="):],
+	} {
+		if err := VerifyWebhookSignature("secret", body, value); !errors.Is(err, ErrInvalidWebhookSignature) {
+			t.Fatalf("%s: expected ErrInvalidWebhookSignature, got %v", name, err)
+		}
+	}
+}
+
+func TestParseWebhookEvent(t *testing.T) {
+	event, err := ParseWebhookEvent([]byte(`{"data":{"type":"buildUploadStateUpdated","id":"evt-1","version":1,
+		"attributes":{"oldValue":"PROCESSING","newValue":"COMPLETE","timestamp":"2026-01-02T03:04:05Z"},
+		"relationships":{"instance":{"data":{"type":"buildUploads","id":"upload-1"}}}}}`))
+	if err != nil {
+		t.Fatalf("ParseWebhookEvent() error: %v", err)
+	}
+	if event.Type != WebhookEventBuildUploadStateUpdated || event.ID != "evt-1" || event.Version != 1 {
+		t.Fatalf("unexpected event: %+v", event)
+	}
+	if event.OldValue != "PROCESSING" || event.NewValue != "COMPLETE" || event.Timestamp != "2026-01-02T03:04:05Z" {
+		t.Fatalf("unexpected state change: %+v", event)
+	}
+	if event.Instance == nil || event.Instance.ID != "upload-1" || !event.IsStateChange() || event.IsFeedback() {
+		t.Fatalf("unexpected instance or classification: %+v", event)
+	}
+	if upload := event.BuildUpload; upload == nil || upload.BuildUploadID != "upload-1" || !upload.Processed() || event.AppStoreVersion != nil || event.Feedback != nil {
+		t.Fatalf("unexpected typed payload: %+v", event.BuildUpload)
+	}
+
+	version, err := ParseWebhookEvent([]byte(`{"data":{"type":"appStoreVersionAppVersionStateUpdated","id":"evt-3",
+		"attributes":{"oldValue":{"state":"IN_REVIEW","appVersionState":"X"},"newValue":"READY_FOR_SALE"},
+		"relationships":{"instance":{"data":{"type":"appStoreVersions","id":"version-1"}}}}}`))
+	if err != nil {
+		t.Fatalf("ParseWebhookEvent() error: %v", err)
+	}
+	if got := version.AppStoreVersion; got == nil || *got != (WebhookAppStoreVersionEvent{VersionID: "version-1", OldState: "IN_REVIEW", NewState: "READY_FOR_SALE"}) {
+		t.Fatalf("unexpected version payload: %+v", got)
+	}
+
+	feedback, err := ParseWebhookEvent([]byte(`{"data":{"type":"betaFeedbackScreenshotSubmissionCreated","id":"evt-2","attributes":{"timestamp":"t"}}}`))
+	if err != nil {
+		t.Fatalf("ParseWebhookEvent() error: %v", err)
+	}
+	if feedback.Type != WebhookEventBetaFeedbackScreenshotSubmissionCreated || !feedback.IsFeedback() {
+		t.Fatalf("unexpected feedback event: %+v", feedback)
+	}
+	if feedback.Feedback == nil || feedback.Feedback.Kind != "screenshot" {
+		t.Fatalf("unexpected feedback payload: %+v", feedback.Feedback)
+	}
+
+	for _, body := range []string{`not json`, `{"data":{}}`} {
+		if _, err := ParseWebhookEvent([]byte(body)); err == nil {
+			t.Fatalf("expected error for %s", body)
+		}
+	}
+}
+
+func TestWebhookEventTypeFromPayload(t *testing.T) {
+	tests := map[string]WebhookEventType{
+		"appStoreVersionAppVersionStateUpdated":    WebhookEventAppStoreVersionStateUpdated,
+		"buildBetaDetailExternalBuildStateUpdated": WebhookEventBuildBetaDetailExternalBuildStateUpdated,
+		"webhookPingCreated":                       WebhookEventPing,
+	}
+	for payloadType, want := range tests {
+		if got := WebhookEventTypeFromPayload(payloadType); got != want {
+			t.Fatalf("WebhookEventTypeFromPayload(%q) = %q, want %q", payloadType, got, want)
+		}
+	}
+}
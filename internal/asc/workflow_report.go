@@ -0,0 +1,34 @@
+package asc
+
+import "encoding/json"
+
+// Workflow run and step statuses.
+const (
+	WorkflowStatusSuccess = "success"
+	WorkflowStatusFailure = "failure"
+	WorkflowStatusSkipped = "skipped"
+)
+
+// WorkflowReport is the combined result of a workflow run.
+type WorkflowReport struct {
+	Workflow  string               `json:"workflow"`
+	File      string               `json:"file"`
+	Status    string               `json:"status"`
+	StartedAt string               `json:"startedAt"`
+	Duration  string               `json:"duration"`
+	Steps     []WorkflowStepResult `json:"steps"`
+}
+
+// WorkflowStepResult is the result of one workflow step.
+type WorkflowStepResult struct {
+	ID              string            `json:"id"`
+	Name            string            `json:"name,omitempty"`
+	Command         []string          `json:"command,omitempty"`
+	Status          string            `json:"status"`
+	Attempts        int               `json:"attempts,omitempty"`
+	Duration        string            `json:"duration,omitempty"`
+	Error           string            `json:"error,omitempty"`
+	ContinueOnError bool              `json:"continueOnError,omitempty"`
+	Outputs         map[string]string `json:"outputs,omitempty"`
+	Output          json.RawMessage   `json:"output,omitempty"`
+}
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/keyring"
+)
+
+const signingKeyItemPrefix = "asc:signing-key:"
+
+// ErrKeychainUnavailable reports that no keychain backend can be used, either
+// because none exists or because ASC_BYPASS_KEYCHAIN is set.
+var ErrKeychainUnavailable = errors.New("no keychain backend is available")
+
+// SigningKeyKeychainItem returns the keychain item name for a signing key.
+func SigningKeyKeychainItem(name string) string {
+	return signingKeyItemPrefix + strings.TrimSpace(name)
+}
+
+// StoreSigningKey saves a PEM-encoded certificate private key in the system
+// keychain under SigningKeyKeychainItem(name).
+func StoreSigningKey(name string, keyPEM []byte) error {
+	if shouldBypassKeychain() {
+		return ErrKeychainUnavailable
+	}
+	kr, err := keyringOpener()
+	if err != nil {
+		if isKeyringUnavailable(err) {
+			return ErrKeychainUnavailable
+		}
+		return err
+	}
+	return kr.Set(keyring.Item{
+		Key:   SigningKeyKeychainItem(name),
+		Data:  keyPEM,
+		Label: fmt.Sprintf("ASC Signing Key (%s)", name),
+	})
+}
+
+// GetSigningKey returns a PEM-encoded private key saved by StoreSigningKey.
+func GetSigningKey(name string) ([]byte, error) {
+	if shouldBypassKeychain() {
+		return nil, ErrKeychainUnavailable
+	}
+	kr, err := keyringOpener()
+	if err != nil {
+		if isKeyringUnavailable(err) {
+			return nil, ErrKeychainUnavailable
+		}
+		return nil, err
+	}
+	item, err := kr.Get(SigningKeyKeychainItem(name))
+	if err != nil {
+		return nil, err
+	}
+	return item.Data, nil
+}
@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/99designs/keyring"
+)
+
+func TestStoreSigningKeyRoundTrip(t *testing.T) {
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "0")
+	kr := keyring.NewArrayKeyring([]keyring.Item{})
+	previous := keyringOpener
+	keyringOpener = func() (keyring.Keyring, error) {
+		return kr, nil
+	}
+	t.Cleanup(func() {
+		keyringOpener = previous
+	})
+
+	if err := StoreSigningKey("CERT_ID", []byte("PEM")); err != nil {
+		t.Fatalf("StoreSigningKey() error: %v", err)
+	}
+	data, err := GetSigningKey("CERT_ID")
+	if err != nil || string(data) != "PEM" {
+		t.Fatalf("GetSigningKey() = %q, %v", data, err)
+	}
+
+	credentials, err := listFromKeyring(kr)
+	if err != nil || len(credentials) != 0 {
+		t.Fatalf("signing keys must not be listed as credentials, got %+v, %v", credentials, err)
+	}
+}
+
+func TestStoreSigningKeyBypass(t *testing.T) {
+	t.Setenv("ASC_BYPASS_KEYCHAIN", "1")
+
+	if err := StoreSigningKey("CERT_ID", []byte("PEM")); !errors.Is(err, ErrKeychainUnavailable) {
+		t.Fatalf("expected ErrKeychainUnavailable, got %v", err)
+	}
+}
@@ -56,8 +56,9 @@ func AccessibilityListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
 
 	return &ffcli.Command{
 		Name:       "list",
@@ -150,7 +151,7 @@ func AccessibilityGetCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Accessibility declaration ID (required)")
 	fields := fs.String("fields", "", "Fields to include: "+strings.Join(accessibilityDeclarationFieldList(), ", "))
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -209,8 +210,9 @@ func AccessibilityCreateCommand() *ffcli.Command {
 	supportsSufficientContrast := fs.String("supports-sufficient-contrast", "", "Supports sufficient contrast (true/false)")
 	supportsVoiceControl := fs.String("supports-voice-control", "", "Supports voice control (true/false)")
 	supportsVoiceover := fs.String("supports-voiceover", "", "Supports voiceover (true/false)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
 
 	return &ffcli.Command{
 		Name:       "create",
@@ -289,7 +291,7 @@ func AccessibilityUpdateCommand() *ffcli.Command {
 	supportsSufficientContrast := fs.String("supports-sufficient-contrast", "", "Supports sufficient contrast (true/false)")
 	supportsVoiceControl := fs.String("supports-voice-control", "", "Supports voice control (true/false)")
 	supportsVoiceover := fs.String("supports-voiceover", "", "Supports voiceover (true/false)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -354,7 +356,7 @@ func AccessibilityDeleteCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Accessibility declaration ID (required)")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -86,8 +86,10 @@ func AgeRatingGetCommand() *ffcli.Command {
 	appID := fs.String("app", os.Getenv("ASC_APP_ID"), "App ID (required unless --app-info-id or --version-id is provided)")
 	appInfoID := fs.String("app-info-id", "", "App info ID (optional)")
 	versionID := fs.String("version-id", "", "App Store version ID (optional)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+	shared.ResolveNames(fs, shared.ResourceVersion, "version-id")
 
 	return &ffcli.Command{
 		Name:       "get",
@@ -140,6 +142,8 @@ func AgeRatingSetCommand() *ffcli.Command {
 	appID := fs.String("app", os.Getenv("ASC_APP_ID"), "App ID (required unless --id, --app-info-id, or --version-id is provided)")
 	appInfoID := fs.String("app-info-id", "", "App info ID (optional)")
 	versionID := fs.String("version-id", "", "App Store version ID (optional)")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+	shared.ResolveNames(fs, shared.ResourceVersion, "version-id")
 
 	// Boolean content descriptors
 	advertising := fs.String("advertising", "", "Contains advertising (true/false)")
@@ -174,7 +178,7 @@ func AgeRatingSetCommand() *ffcli.Command {
 	koreaAgeRatingOverride := fs.String("korea-age-rating-override", "", "Korea age rating override: NONE, FIFTEEN_PLUS, NINETEEN_PLUS")
 	developerAgeRatingInfoURL := fs.String("developer-age-rating-info-url", "", "Developer age rating information URL")
 
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
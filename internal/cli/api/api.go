@@ -0,0 +1,379 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/docs/openapi"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+var supportedMethods = map[string]bool{
+	http.MethodGet:    true,
+	http.MethodPost:   true,
+	http.MethodPatch:  true,
+	http.MethodPut:    true,
+	http.MethodDelete: true,
+}
+
+// fieldFlag collects repeatable key=value flags.
+type fieldFlag []string
+
+func (f *fieldFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *fieldFlag) Set(value string) error {
+	if !strings.Contains(value, "=") {
+		return fmt.Errorf("expected key=value, got %q", value)
+	}
+	*f = append(*f, value)
+	return nil
+}
+
+type field struct {
+	key   string
+	value any
+}
+
+// APICommand returns the api command.
+func APICommand() *ffcli.Command {
+	fs := flag.NewFlagSet("api", flag.ExitOnError)
+
+	var stringFields, typedFields fieldFlag
+	fs.Var(&stringFields, "f", "Add a string field key=value (repeatable; query parameter for GET/DELETE, body field otherwise)")
+	fs.Var(&stringFields, "field", "Alias for -f")
+	fs.Var(&typedFields, "F", "Add a JSON-typed field key=value: true, false, null, numbers, arrays, and objects are decoded (repeatable)")
+	fs.Var(&typedFields, "typed-field", "Alias for -F")
+	input := fs.String("input", "", "Read the request body from a JSON file (- for stdin)")
+	paginate := fs.Bool("paginate", false, "Automatically fetch all pages of a GET collection (aggregate results)")
+	skipValidation := fs.Bool("skip-validation", false, "Send the request even if the path is not in the OpenAPI index")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "api",
+		ShortUsage: "asc api <METHOD> <path> [flags]",
+		ShortHelp:  "Make an authenticated request to any App Store Connect API endpoint.",
+		LongHelp: `Make an authenticated request to any App Store Connect API endpoint.
+
+The request is signed with the resolved credentials (--profile, environment,
+config, or keychain) and uses the same retry, timeout, cache, and --debug
+behavior as every other command.
+
+Paths are checked against the bundled OpenAPI index before sending; use
+--skip-validation for endpoints newer than the index. List indexed endpoints
+with "asc api endpoints".
+
+Fields (-f for strings, -F for JSON-typed values) become query parameters for
+GET and DELETE. For other methods they build the JSON body, with dots in the
+key creating nested objects. --input sends a JSON file as the body instead;
+fields are then added to the query string.
+
+Examples:
+  asc api GET /v1/apps
+  asc api GET /v1/apps -f "filter[bundleId]=com.example.app" -f limit=5
+  asc api GET /v1/apps/123456789/builds --paginate --output table
+  asc api PATCH /v1/betaGroups/GROUP_ID -f data.type=betaGroups -f data.id=GROUP_ID -F data.attributes.publicLinkEnabled=true
+  asc api POST /v1/betaGroups --input group.json
+  asc api DELETE /v1/betaGroups/GROUP_ID`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			EndpointsCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			// Allow flags after the positional arguments (asc api GET /v1/apps --paginate).
+			positional, err := shared.ParseInterspersed(fs, args)
+			if err != nil {
+				return err
+			}
+			if len(positional) != 2 {
+				return shared.UsageError("expected <METHOD> <path>")
+			}
+
+			method := strings.ToUpper(strings.TrimSpace(positional[0]))
+			if !supportedMethods[method] {
+				return shared.UsageErrorf("unsupported method %q (use GET, POST, PATCH, PUT, or DELETE)", positional[0])
+			}
+			path, err := normalizePath(positional[1])
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			if !*skipValidation {
+				if err := validateEndpoint(method, path); err != nil {
+					return shared.UsageError(err.Error())
+				}
+			}
+			if *paginate && method != http.MethodGet {
+				return shared.UsageError("--paginate is only supported for GET requests")
+			}
+
+			fields, err := parseFields(stringFields, typedFields)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			var body []byte
+			queryFields := fields
+			switch {
+			case strings.TrimSpace(*input) != "":
+				if method == http.MethodGet || method == http.MethodDelete {
+					return shared.UsageErrorf("--input is not supported for %s requests", method)
+				}
+				body, err = readInput(*input)
+				if err != nil {
+					return fmt.Errorf("api: %w", err)
+				}
+			case method != http.MethodGet && method != http.MethodDelete:
+				queryFields = nil
+				if len(fields) > 0 {
+					body, err = buildBody(fields)
+					if err != nil {
+						return shared.UsageError(err.Error())
+					}
+				}
+			}
+			if len(queryFields) > 0 {
+				path = appendQuery(path, queryFields)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("api: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			if *paginate {
+				firstPage, err := client.GetRaw(requestCtx, path)
+				if err != nil {
+					return fmt.Errorf("api: %w", err)
+				}
+				all, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetRaw(ctx, nextURL)
+				})
+				if err != nil {
+					return fmt.Errorf("api: %w", err)
+				}
+				return shared.PrintOutput(all, *output, *pretty)
+			}
+
+			var reader io.Reader
+			if body != nil {
+				reader = bytes.NewReader(body)
+			}
+			respBody, err := client.Do(requestCtx, method, path, reader)
+			if err != nil {
+				return fmt.Errorf("api: %w", err)
+			}
+			return printResponse(respBody, *output, *pretty)
+		},
+	}
+}
+
+// normalizePath accepts a relative API path or an absolute URL on the API
+// host and returns it relative to the base URL.
+func normalizePath(raw string) (string, error) {
+	path := strings.TrimSpace(raw)
+	if path == "" {
+		return "", fmt.Errorf("path is required")
+	}
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		parsed, err := url.Parse(path)
+		if err != nil {
+			return "", fmt.Errorf("invalid URL: %w", err)
+		}
+		base, err := url.Parse(asc.ResolveBaseURL())
+		if err != nil {
+			return "", fmt.Errorf("invalid base URL: %w", err)
+		}
+		if parsed.Host != base.Host || (parsed.Scheme != "https" && parsed.Scheme != base.Scheme) {
+			return "", fmt.Errorf("URL must be on the App Store Connect API host (%s)", base.Host)
+		}
+		path = parsed.RequestURI()
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return path, nil
+}
+
+// validateEndpoint checks method and path against the OpenAPI index.
+func validateEndpoint(method, path string) error {
+	route := path
+	if idx := strings.IndexByte(route, '?'); idx >= 0 {
+		route = route[:idx]
+	}
+	if _, ok := openapi.Match(method, route); ok {
+		return nil
+	}
+	if methods := openapi.Methods(route); len(methods) > 0 {
+		return fmt.Errorf("%s is not supported for %s (supported: %s); use --skip-validation to send it anyway", method, route, strings.Join(methods, ", "))
+	}
+	message := fmt.Sprintf("unknown endpoint %s %s; use --skip-validation to send it anyway", method, route)
+	if suggestions := openapi.Suggest(method, route, 5); len(suggestions) > 0 {
+		lines := make([]string, 0, len(suggestions))
+		for _, suggestion := range suggestions {
+			lines = append(lines, "  "+suggestion.String())
+		}
+		message += "\nDid you mean:\n" + strings.Join(lines, "\n")
+	}
+	return fmt.Errorf("%s", message)
+}
+
+func parseFields(stringFields, typedFields []string) ([]field, error) {
+	fields := make([]field, 0, len(stringFields)+len(typedFields))
+	for _, entry := range stringFields {
+		key, value, _ := strings.Cut(entry, "=")
+		if strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("field %q has an empty key", entry)
+		}
+		fields = append(fields, field{key: strings.TrimSpace(key), value: value})
+	}
+	for _, entry := range typedFields {
+		key, raw, _ := strings.Cut(entry, "=")
+		if strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("field %q has an empty key", entry)
+		}
+		var value any = raw
+		if json.Valid([]byte(raw)) {
+			var decoded any
+			if err := json.Unmarshal([]byte(raw), &decoded); err == nil {
+				value = decoded
+			}
+		}
+		fields = append(fields, field{key: strings.TrimSpace(key), value: value})
+	}
+	return fields, nil
+}
+
+// buildBody nests dotted keys into a JSON object:
+// data.attributes.name=x -> {"data":{"attributes":{"name":"x"}}}.
+func buildBody(fields []field) ([]byte, error) {
+	root := map[string]any{}
+	for _, f := range fields {
+		parts := strings.Split(f.key, ".")
+		current := root
+		for i, part := range parts {
+			if part == "" {
+				return nil, fmt.Errorf("field %q has an empty path segment", f.key)
+			}
+			if i == len(parts)-1 {
+				if _, exists := current[part]; exists {
+					return nil, fmt.Errorf("field %q is set more than once", f.key)
+				}
+				current[part] = f.value
+				break
+			}
+			next, exists := current[part]
+			if !exists {
+				child := map[string]any{}
+				current[part] = child
+				current = child
+				continue
+			}
+			child, ok := next.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("field %q conflicts with %q", f.key, strings.Join(parts[:i+1], "."))
+			}
+			current = child
+		}
+	}
+	return json.Marshal(root)
+}
+
+func appendQuery(path string, fields []field) string {
+	values := url.Values{}
+	for _, f := range fields {
+		switch v := f.value.(type) {
+		case string:
+			values.Add(f.key, v)
+		default:
+			encoded, _ := json.Marshal(v)
+			values.Add(f.key, string(encoded))
+		}
+	}
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+	return path + separator + values.Encode()
+}
+
+func readInput(path string) ([]byte, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read --input: %w", err)
+	}
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("--input %s is not valid JSON", path)
+	}
+	return data, nil
+}
+
+// printResponse prints a response body. JSON output preserves the document
+// as returned; other formats render JSON:API resources as rows.
+func printResponse(body []byte, output string, pretty bool) error {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil
+	}
+	if !json.Valid(body) {
+		_, err := fmt.Fprintln(os.Stdout, string(body))
+		return err
+	}
+	if strings.ToLower(output) != "json" {
+		if collection := asRawResponse(body); collection != nil {
+			return shared.PrintOutput(collection, output, pretty)
+		}
+	}
+	return shared.PrintOutput(json.RawMessage(body), output, pretty)
+}
+
+// asRawResponse wraps a JSON:API document as a collection, or returns nil
+// when the body has no resource data.
+func asRawResponse(body []byte) *asc.RawResponse {
+	var document struct {
+		Data     json.RawMessage `json:"data"`
+		Links    asc.Links       `json:"links"`
+		Included json.RawMessage `json:"included"`
+		Meta     json.RawMessage `json:"meta"`
+	}
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil
+	}
+	response := &asc.RawResponse{Links: document.Links, Included: document.Included, Meta: document.Meta}
+	data := bytes.TrimSpace(document.Data)
+	switch {
+	case bytes.HasPrefix(data, []byte("[")):
+		if err := json.Unmarshal(data, &response.Data); err != nil {
+			return nil
+		}
+	case bytes.HasPrefix(data, []byte("{")):
+		response.Data = []json.RawMessage{data}
+	default:
+		return nil
+	}
+	return response
+}
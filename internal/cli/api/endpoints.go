@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/docs/openapi"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type endpointItem struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+}
+
+// EndpointsCommand returns the api endpoints subcommand.
+func EndpointsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("api endpoints", flag.ExitOnError)
+
+	method := fs.String("method", "", "Only list endpoints for this HTTP method")
+	pathsOnly := fs.Bool("paths", false, "Print unique paths one per line (for shell completion)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "endpoints",
+		ShortUsage: "asc api endpoints [flags] [path-prefix]",
+		ShortHelp:  "List endpoints in the bundled OpenAPI index.",
+		LongHelp: `List endpoints in the bundled OpenAPI index.
+
+Examples:
+  asc api endpoints /v1/apps --output table
+  asc api endpoints --method DELETE /v1/betaGroups
+  asc api endpoints --method GET --paths /v1/builds`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			args, err := shared.ParseInterspersed(fs, args)
+			if err != nil {
+				return err
+			}
+			if len(args) > 1 {
+				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args[1:], " "))
+			}
+			prefix := ""
+			if len(args) == 1 {
+				prefix = strings.TrimSpace(args[0])
+			}
+			methodFilter := strings.ToUpper(strings.TrimSpace(*method))
+
+			if *pathsOnly {
+				for _, path := range openapi.Complete(methodFilter, prefix) {
+					fmt.Fprintln(os.Stdout, path)
+				}
+				return nil
+			}
+
+			items := make([]endpointItem, 0)
+			for _, endpoint := range openapi.Endpoints() {
+				if methodFilter != "" && endpoint.Method != methodFilter {
+					continue
+				}
+				if !strings.HasPrefix(endpoint.Path, prefix) {
+					continue
+				}
+				items = append(items, endpointItem{Method: endpoint.Method, Path: endpoint.Path})
+			}
+			return printEndpoints(items, *output, *pretty)
+		},
+	}
+}
+
+func printEndpoints(items []endpointItem, output string, pretty bool) error {
+	format := strings.ToLower(output)
+	switch format {
+	case "table", "markdown", "md", "csv":
+	default:
+		return shared.PrintOutput(map[string]any{"data": items}, output, pretty)
+	}
+	if pretty {
+		return fmt.Errorf("--pretty is only valid with JSON output")
+	}
+	headers := []string{"Method", "Path"}
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		rows = append(rows, []string{item.Method, item.Path})
+	}
+	switch format {
+	case "table":
+		asc.RenderTable(headers, rows)
+	case "csv":
+		return asc.RenderCSV(headers, rows)
+	default:
+		asc.RenderMarkdown(headers, rows)
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 const appEventAssetPollInterval = 2 * time.Second
 
 func waitForAppEventScreenshotDelivery(ctx context.Context, client *asc.Client, screenshotID string) (*asc.AppEventScreenshotResponse, error) {
+	ctx = asc.WithoutResponseCache(ctx)
 	ticker := time.NewTicker(appEventAssetPollInterval)
 	defer ticker.Stop()
 
@@ -42,6 +43,7 @@ func waitForAppEventScreenshotDelivery(ctx context.Context, client *asc.Client,
 }
 
 func waitForAppEventVideoClipDelivery(ctx context.Context, client *asc.Client, clipID string) (*asc.AppEventVideoClipResponse, error) {
+	ctx = asc.WithoutResponseCache(ctx)
 	ticker := time.NewTicker(appEventAssetPollInterval)
 	defer ticker.Stop()
 
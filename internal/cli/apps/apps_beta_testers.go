@@ -19,8 +19,10 @@ func AppsRemoveBetaTestersCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	testers := fs.String("tester", "", "Comma-separated beta tester IDs")
 	confirm := fs.Bool("confirm", false, "Confirm removal")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+	shared.ResolveNameLists(fs, shared.ResourceBetaTester, "tester")
 
 	return &ffcli.Command{
 		Name:       "remove-beta-testers",
@@ -69,6 +69,7 @@ func collectAssetFiles(path string) ([]string, error) {
 }
 
 func waitForAssetDeliveryState(ctx context.Context, assetID string, fetch func(context.Context) (*asc.AssetDeliveryState, error)) (string, error) {
+	ctx = asc.WithoutResponseCache(ctx)
 	ticker := time.NewTicker(assetPollInterval)
 	defer ticker.Stop()
 
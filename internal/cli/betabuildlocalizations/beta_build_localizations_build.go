@@ -39,7 +39,7 @@ func BetaBuildLocalizationsBuildGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("build get", flag.ExitOnError)
 
 	id := fs.String("id", "", "Beta build localization ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
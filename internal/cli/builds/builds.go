@@ -19,8 +19,10 @@ func BuildsAddGroupsCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	groups := fs.String("group", "", "Comma-separated beta group IDs")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBuild, "build")
+	shared.ResolveNameLists(fs, shared.ResourceBetaGroup, "group")
 
 	return &ffcli.Command{
 		Name:       "add-groups",
@@ -77,8 +79,10 @@ func BuildsRemoveGroupsCommand() *ffcli.Command {
 	buildID := fs.String("build", "", "Build ID")
 	groups := fs.String("group", "", "Comma-separated beta group IDs")
 	confirm := fs.Bool("confirm", false, "Confirm removal")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBuild, "build")
+	shared.ResolveNameLists(fs, shared.ResourceBetaGroup, "group")
 
 	return &ffcli.Command{
 		Name:       "remove-groups",
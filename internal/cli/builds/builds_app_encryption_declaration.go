@@ -39,8 +39,9 @@ func BuildsAppEncryptionDeclarationGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app-encryption-declaration get", flag.ExitOnError)
 
 	buildID := fs.String("id", "", "Build ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBuild, "id")
 
 	return &ffcli.Command{
 		Name:       "get",
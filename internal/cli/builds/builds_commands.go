@@ -27,14 +27,16 @@ func BuildsUploadCommand() *ffcli.Command {
 	buildNumber := fs.String("build-number", "", "CFBundleVersion (e.g., 123, auto-extracted from IPA if not provided)")
 	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (auto-detected for --pkg)")
 	dryRun := fs.Bool("dry-run", false, "Reserve upload operations without uploading the file")
+	resume := fs.Bool("resume", false, "Resume an interrupted upload of the same file, skipping parts already uploaded")
 	concurrency := fs.Int("concurrency", 1, "Upload concurrency (default 1)")
 	verifyChecksum := fs.Bool("checksum", false, "Verify upload checksums if provided by API")
 	testNotes := fs.String("test-notes", "", "What to Test notes (requires build processing)")
 	locale := fs.String("locale", "", "Locale for --test-notes (e.g., en-US)")
 	wait := fs.Bool("wait", false, "Wait for build processing to complete")
 	pollInterval := fs.Duration("poll-interval", shared.PublishDefaultPollInterval, "Polling interval for --wait and --test-notes")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
 
 	return &ffcli.Command{
 		Name:       "upload",
@@ -45,6 +47,10 @@ func BuildsUploadCommand() *ffcli.Command {
 By default, this command uploads the IPA/PKG to the presigned URLs and commits
 the file. Use --dry-run to only reserve the upload operations.
 
+Progress is recorded in a local upload journal (~/.asc/uploads). If an upload
+is interrupted, rerun the same command with --resume to skip the parts that
+were already uploaded and commit the file once the rest finish.
+
 Use --ipa for iOS, tvOS, and visionOS apps. Use --pkg for macOS apps.
 When using --pkg, the platform is automatically set to MAC_OS.
 
@@ -52,6 +58,7 @@ Examples:
   asc builds upload --app "123456789" --ipa "path/to/app.ipa"
   asc builds upload --ipa "app.ipa" --version "1.0.0" --build-number "123"
   asc builds upload --app "123456789" --ipa "app.ipa" --dry-run
+  asc builds upload --app "123456789" --ipa "app.ipa" --resume
   asc builds upload --app "123456789" --ipa "app.ipa" --test-notes "Test flow" --locale "en-US" --wait
   asc builds upload --app "123456789" --pkg "path/to/app.pkg" --version "1.0.0" --build-number "123"`,
 		FlagSet:   fs,
@@ -135,6 +142,9 @@ Examples:
 				if *wait {
 					return fmt.Errorf("builds upload: --wait is not supported with --dry-run")
 				}
+				if *resume {
+					return fmt.Errorf("builds upload: --resume is not supported with --dry-run")
+				}
 			} else if *concurrency < 1 {
 				return fmt.Errorf("builds upload: --concurrency must be at least 1")
 			}
@@ -221,70 +231,106 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeoutDuration(ctx, timeoutValue)
 			defer cancel()
 
-			// Step 1: Create build upload record
-			uploadReq := asc.BuildUploadCreateRequest{
-				Data: asc.BuildUploadCreateData{
-					Type: asc.ResourceTypeBuildUploads,
-					Attributes: asc.BuildUploadAttributes{
-						CFBundleShortVersionString: versionValue,
-						CFBundleVersion:            buildNumberValue,
-						Platform:                   platformValue,
-					},
-					Relationships: &asc.BuildUploadRelationships{
-						App: &asc.Relationship{
-							Data: asc.ResourceData{Type: asc.ResourceTypeApps, ID: resolvedAppID},
-						},
-					},
-				},
+			var journalKey asc.UploadJournalKey
+			var journal *asc.UploadJournal
+			if !*dryRun {
+				journalKey, err = shared.BuildUploadJournalKey(resolvedAppID, versionValue, buildNumberValue, platformValue, filePath)
+				if err != nil {
+					return fmt.Errorf("builds upload: %w", err)
+				}
+				if *resume {
+					journal, err = shared.FindResumableBuildUpload(journalKey)
+					if err != nil {
+						return fmt.Errorf("builds upload: failed to read upload journal: %w", err)
+					}
+				}
 			}
 
-			uploadResp, err := client.CreateBuildUpload(requestCtx, uploadReq)
-			if err != nil {
-				return fmt.Errorf("builds upload: failed to create upload record: %w", err)
-			}
-
-			// Step 2: Create build upload file reservation
-			fileReq := asc.BuildUploadFileCreateRequest{
-				Data: asc.BuildUploadFileCreateData{
-					Type: asc.ResourceTypeBuildUploadFiles,
-					Attributes: asc.BuildUploadFileAttributes{
-						FileName:  fileInfo.Name(),
-						FileSize:  fileInfo.Size(),
-						UTI:       fileUTI,
-						AssetType: asc.AssetTypeAsset,
+			var result *asc.BuildUploadResult
+			var sourceFileChecksums *asc.Checksums
+			if journal != nil {
+				result = &asc.BuildUploadResult{
+					UploadID:   journal.UploadID,
+					FileID:     journal.FileID,
+					FileName:   journal.FileName,
+					FileSize:   journal.FileSize,
+					Operations: journal.Operations,
+				}
+				sourceFileChecksums = journal.SourceFileChecksums
+			} else {
+				// Step 1: Create build upload record
+				uploadReq := asc.BuildUploadCreateRequest{
+					Data: asc.BuildUploadCreateData{
+						Type: asc.ResourceTypeBuildUploads,
+						Attributes: asc.BuildUploadAttributes{
+							CFBundleShortVersionString: versionValue,
+							CFBundleVersion:            buildNumberValue,
+							Platform:                   platformValue,
+						},
+						Relationships: &asc.BuildUploadRelationships{
+							App: &asc.Relationship{
+								Data: asc.ResourceData{Type: asc.ResourceTypeApps, ID: resolvedAppID},
+							},
+						},
 					},
-					Relationships: &asc.BuildUploadFileRelationships{
-						BuildUpload: &asc.Relationship{
-							Data: asc.ResourceData{Type: asc.ResourceTypeBuildUploads, ID: uploadResp.Data.ID},
+				}
+
+				uploadResp, err := client.CreateBuildUpload(requestCtx, uploadReq)
+				if err != nil {
+					return fmt.Errorf("builds upload: failed to create upload record: %w", err)
+				}
+
+				// Step 2: Create build upload file reservation
+				fileReq := asc.BuildUploadFileCreateRequest{
+					Data: asc.BuildUploadFileCreateData{
+						Type: asc.ResourceTypeBuildUploadFiles,
+						Attributes: asc.BuildUploadFileAttributes{
+							FileName:  fileInfo.Name(),
+							FileSize:  fileInfo.Size(),
+							UTI:       fileUTI,
+							AssetType: asc.AssetTypeAsset,
+						},
+						Relationships: &asc.BuildUploadFileRelationships{
+							BuildUpload: &asc.Relationship{
+								Data: asc.ResourceData{Type: asc.ResourceTypeBuildUploads, ID: uploadResp.Data.ID},
+							},
 						},
 					},
-				},
-			}
+				}
 
-			fileResp, err := client.CreateBuildUploadFile(requestCtx, fileReq)
-			if err != nil {
-				return fmt.Errorf("builds upload: failed to create file reservation: %w", err)
-			}
+				fileResp, err := client.CreateBuildUploadFile(requestCtx, fileReq)
+				if err != nil {
+					return fmt.Errorf("builds upload: failed to create file reservation: %w", err)
+				}
+
+				// Return upload info including presigned URL operations
+				result = &asc.BuildUploadResult{
+					UploadID:   uploadResp.Data.ID,
+					FileID:     fileResp.Data.ID,
+					FileName:   fileResp.Data.Attributes.FileName,
+					FileSize:   fileResp.Data.Attributes.FileSize,
+					Operations: fileResp.Data.Attributes.UploadOperations,
+				}
+				sourceFileChecksums = fileResp.Data.Attributes.SourceFileChecksums
 
-			// Return upload info including presigned URL operations
-			result := &asc.BuildUploadResult{
-				UploadID:   uploadResp.Data.ID,
-				FileID:     fileResp.Data.ID,
-				FileName:   fileResp.Data.Attributes.FileName,
-				FileSize:   fileResp.Data.Attributes.FileSize,
-				Operations: fileResp.Data.Attributes.UploadOperations,
+				if !*dryRun && len(result.Operations) > 0 {
+					journal = shared.StartBuildUploadJournal(journalKey, uploadResp.Data.ID, fileResp)
+				}
 			}
 
 			if !*dryRun {
-				if len(fileResp.Data.Attributes.UploadOperations) == 0 {
+				if len(result.Operations) == 0 {
 					return fmt.Errorf("builds upload: no upload operations returned")
 				}
 
 				uploadOpts := []asc.UploadOption{
 					asc.WithUploadConcurrency(*concurrency),
 				}
+				if journal != nil {
+					uploadOpts = append(uploadOpts, asc.WithUploadJournal(journal))
+				}
 				uploadCtx, uploadCancel := shared.ContextWithUploadTimeout(ctx)
-				err = asc.ExecuteUploadOperations(uploadCtx, filePath, fileResp.Data.Attributes.UploadOperations, uploadOpts...)
+				err = asc.ExecuteUploadOperations(uploadCtx, filePath, result.Operations, uploadOpts...)
 				uploadCancel()
 				if err != nil {
 					return fmt.Errorf("builds upload: upload failed: %w", err)
@@ -293,7 +339,7 @@ Examples:
 				var verifiedChecksums *asc.Checksums
 				var checksumVerified *bool
 				if *verifyChecksum {
-					src := fileResp.Data.Attributes.SourceFileChecksums
+					src := sourceFileChecksums
 					if src == nil || (src.File == nil && src.Composite == nil) {
 						fmt.Fprintln(os.Stderr, "Warning: --checksum requested but API provided no checksums to verify; skipping")
 					} else {
@@ -311,7 +357,7 @@ Examples:
 				updateReq := asc.BuildUploadFileUpdateRequest{
 					Data: asc.BuildUploadFileUpdateData{
 						Type: asc.ResourceTypeBuildUploadFiles,
-						ID:   fileResp.Data.ID,
+						ID:   result.FileID,
 						Attributes: &asc.BuildUploadFileUpdateAttributes{
 							Uploaded:            &uploaded,
 							SourceFileChecksums: verifiedChecksums,
@@ -320,11 +366,14 @@ Examples:
 				}
 
 				commitCtx, commitCancel := shared.ContextWithUploadTimeout(ctx)
-				commitResp, err := client.UpdateBuildUploadFile(commitCtx, fileResp.Data.ID, updateReq)
+				commitResp, err := client.UpdateBuildUploadFile(commitCtx, result.FileID, updateReq)
 				commitCancel()
 				if err != nil {
 					return fmt.Errorf("builds upload: failed to commit upload: %w", err)
 				}
+				if journal != nil {
+					shared.FinishBuildUploadJournal(journal)
+				}
 
 				if commitResp != nil && commitResp.Data.Attributes.Uploaded != nil {
 					result.Uploaded = commitResp.Data.Attributes.Uploaded
@@ -431,12 +480,13 @@ func BuildsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	sort := fs.String("sort", "", "Sort by uploadedDate or -uploadedDate")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
 
 	return &ffcli.Command{
 		Name:       "list",
@@ -523,8 +573,9 @@ func BuildsInfoCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("builds info", flag.ExitOnError)
 
 	buildID := fs.String("build", "", "Build ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBuild, "build")
 
 	return &ffcli.Command{
 		Name:       "info",
@@ -568,8 +619,9 @@ func BuildsExpireCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	confirm := fs.Bool("confirm", false, "Confirm expiration")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBuild, "build")
 
 	return &ffcli.Command{
 		Name:       "expire",
@@ -48,8 +48,9 @@ func BuildsIndividualTestersListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBuild, "build")
 
 	return &ffcli.Command{
 		Name:       "list",
@@ -127,8 +128,10 @@ func BuildsIndividualTestersAddCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	testers := fs.String("tester", "", "Comma-separated tester IDs")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBuild, "build")
+	shared.ResolveNameLists(fs, shared.ResourceBetaTester, "tester")
 
 	return &ffcli.Command{
 		Name:       "add",
@@ -184,8 +187,10 @@ func BuildsIndividualTestersRemoveCommand() *ffcli.Command {
 	buildID := fs.String("build", "", "Build ID")
 	testers := fs.String("tester", "", "Comma-separated tester IDs")
 	confirm := fs.Bool("confirm", false, "Confirm removal")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBuild, "build")
+	shared.ResolveNameLists(fs, shared.ResourceBetaTester, "tester")
 
 	return &ffcli.Command{
 		Name:       "remove",
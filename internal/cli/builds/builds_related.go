@@ -42,8 +42,9 @@ func BuildsAppGetCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	aliasID := fs.String("id", "", "Build ID (alias of --build)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBuild, "build", "id")
 
 	return &ffcli.Command{
 		Name:       "get",
@@ -115,8 +116,9 @@ func BuildsPreReleaseVersionGetCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	aliasID := fs.String("id", "", "Build ID (alias of --build)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBuild, "build", "id")
 
 	return &ffcli.Command{
 		Name:       "get",
@@ -191,8 +193,9 @@ func BuildsIconsListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBuild, "build", "id")
 
 	return &ffcli.Command{
 		Name:       "list",
@@ -296,8 +299,9 @@ func BuildsBetaAppReviewSubmissionGetCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	aliasID := fs.String("id", "", "Build ID (alias of --build)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBuild, "build", "id")
 
 	return &ffcli.Command{
 		Name:       "get",
@@ -369,8 +373,9 @@ func BuildsBuildBetaDetailGetCommand() *ffcli.Command {
 
 	buildID := fs.String("build", "", "Build ID")
 	aliasID := fs.String("id", "", "Build ID (alias of --build)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBuild, "build", "id")
 
 	return &ffcli.Command{
 		Name:       "get",
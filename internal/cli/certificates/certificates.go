@@ -31,6 +31,7 @@ Examples:
   asc certificates list --certificate-type IOS_DISTRIBUTION
   asc certificates get --id "CERT_ID" --include passTypeId
   asc certificates create --certificate-type IOS_DISTRIBUTION --csr "./cert.csr"
+  asc certificates create --certificate-type IOS_DISTRIBUTION --generate-key
   asc certificates update --id "CERT_ID" --activated true
   asc certificates update --id "CERT_ID" --activated false
   asc certificates revoke --id "CERT_ID" --confirm
@@ -59,7 +60,7 @@ func CertificatesListCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -133,7 +134,7 @@ func CertificatesGetCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Certificate ID")
 	include := fs.String("include", "", "Include related resources: passTypeId")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -188,17 +189,32 @@ func CertificatesCreateCommand() *ffcli.Command {
 
 	certificateType := fs.String("certificate-type", "", "Certificate type (e.g., IOS_DISTRIBUTION)")
 	csrPath := fs.String("csr", "", "CSR file path")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	generateKey := fs.Bool("generate-key", false, "Generate the private key and CSR locally instead of using --csr")
+	keyType := fs.String("key-type", shared.SigningKeyTypeRSA, "Generated key type: rsa or ec")
+	commonName := fs.String("common-name", defaultCSRCommonName, "Common name for the generated CSR")
+	keyStore := fs.String("key-store", keyStoreFile, "Where to keep the generated private key: file (encrypted PEM) or keychain")
+	outputDir := fs.String("output-dir", "./signing", "Output directory for the generated certificate, key, and .p12 files")
+	p12Password := fs.String("p12-password", "", "Password for the .p12 bundle and encrypted key (or "+p12PasswordEnvVar+")")
+	p12Legacy := fs.Bool("p12-legacy", false, "Encrypt the .p12 with 3DES for keychains older than macOS 14")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
 		Name:       "create",
-		ShortUsage: "asc certificates create --certificate-type TYPE --csr ./cert.csr",
+		ShortUsage: "asc certificates create --certificate-type TYPE (--csr ./cert.csr | --generate-key)",
 		ShortHelp:  "Create a signing certificate.",
 		LongHelp: `Create a signing certificate.
 
+With --generate-key, the private key and CSR are created locally (no openssl
+or Keychain Access needed), the issued certificate is downloaded, and the
+output directory receives the certificate (.cer and .pem), the private key
+(encrypted PEM, or the system keychain with --key-store keychain), and a
+password-protected .p12 bundle.
+
 Examples:
-  asc certificates create --certificate-type IOS_DISTRIBUTION --csr "./cert.csr"`,
+  asc certificates create --certificate-type IOS_DISTRIBUTION --csr "./cert.csr"
+  ASC_P12_PASSWORD="..." asc certificates create --certificate-type IOS_DISTRIBUTION --generate-key
+  asc certificates create --certificate-type DEVELOPMENT --generate-key --key-store keychain --output-dir "./certs" --p12-password "..."`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -208,8 +224,22 @@ Examples:
 				return flag.ErrHelp
 			}
 			csrValue := strings.TrimSpace(*csrPath)
+			if *generateKey {
+				if csrValue != "" {
+					return shared.UsageError("--csr and --generate-key are mutually exclusive")
+				}
+				opts, err := newGenerateKeyOptions(certificateValue, *keyType, *commonName, *keyStore, *outputDir, *p12Password, *p12Legacy)
+				if err != nil {
+					return err
+				}
+				result, err := createCertificateWithGeneratedKey(ctx, opts)
+				if err != nil {
+					return fmt.Errorf("certificates create: %w", err)
+				}
+				return shared.PrintOutput(result, *output, *pretty)
+			}
 			if csrValue == "" {
-				fmt.Fprintln(os.Stderr, "Error: --csr is required")
+				fmt.Fprintln(os.Stderr, "Error: --csr or --generate-key is required")
 				return flag.ErrHelp
 			}
 
@@ -242,7 +272,7 @@ func CertificatesUpdateCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Certificate ID")
 	activated := fs.String("activated", "", "Set activated (true/false)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -298,7 +328,7 @@ func CertificatesRevokeCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Certificate ID")
 	confirm := fs.Bool("confirm", false, "Confirm revocation")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -0,0 +1,269 @@
+package certificates
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	p12PasswordEnvVar    = "ASC_P12_PASSWORD"
+	defaultCSRCommonName = "App Store Connect CLI"
+
+	keyStoreFile     = "file"
+	keyStoreKeychain = "keychain"
+)
+
+// generateKeyOptions configures certificates create --generate-key.
+type generateKeyOptions struct {
+	certificateType string
+	keyType         string
+	commonName      string
+	keyStore        string
+	outputDir       string
+	password        string
+	legacyP12       bool
+}
+
+func newGenerateKeyOptions(certificateType, keyType, commonName, keyStore, outputDir, password string, legacyP12 bool) (generateKeyOptions, error) {
+	opts := generateKeyOptions{
+		certificateType: certificateType,
+		keyType:         strings.ToLower(strings.TrimSpace(keyType)),
+		commonName:      strings.TrimSpace(commonName),
+		keyStore:        strings.ToLower(strings.TrimSpace(keyStore)),
+		outputDir:       strings.TrimSpace(outputDir),
+		password:        password,
+		legacyP12:       legacyP12,
+	}
+	if opts.keyType != shared.SigningKeyTypeRSA && opts.keyType != shared.SigningKeyTypeEC {
+		return opts, shared.UsageErrorf("--key-type must be %s or %s", shared.SigningKeyTypeRSA, shared.SigningKeyTypeEC)
+	}
+	if opts.keyStore != keyStoreFile && opts.keyStore != keyStoreKeychain {
+		return opts, shared.UsageErrorf("--key-store must be %s or %s", keyStoreFile, keyStoreKeychain)
+	}
+	if opts.commonName == "" {
+		return opts, shared.UsageError("--common-name must not be empty")
+	}
+	if opts.outputDir == "" {
+		return opts, shared.UsageError("--output-dir must not be empty")
+	}
+	if opts.password == "" {
+		opts.password = os.Getenv(p12PasswordEnvVar)
+	}
+	if opts.password == "" {
+		return opts, shared.UsageErrorf("--p12-password (or %s) is required with --generate-key", p12PasswordEnvVar)
+	}
+	return opts, nil
+}
+
+// createCertificateWithGeneratedKey generates a key and CSR, submits the CSR,
+// and writes the issued certificate with its key and a .p12 bundle. The
+// encrypted key is written to a pending file in the output directory before
+// the request, so a failure cannot leave an issued certificate without its key.
+func createCertificateWithGeneratedKey(ctx context.Context, opts generateKeyOptions) (*asc.CertificateIdentityResult, error) {
+	if opts.keyStore == keyStoreKeychain {
+		available, err := auth.KeychainAvailable()
+		if err != nil {
+			return nil, err
+		}
+		if !available {
+			return nil, fmt.Errorf("--key-store keychain: %w", auth.ErrKeychainUnavailable)
+		}
+	}
+	if !asc.SimulationActive() {
+		if err := os.MkdirAll(opts.outputDir, 0o755); err != nil {
+			return nil, fmt.Errorf("create output dir: %w", err)
+		}
+	}
+
+	key, err := shared.GenerateSigningKey(opts.keyType)
+	if err != nil {
+		return nil, fmt.Errorf("generate key: %w", err)
+	}
+	csr, err := shared.CreateSigningCSR(key, opts.commonName)
+	if err != nil {
+		return nil, fmt.Errorf("create CSR: %w", err)
+	}
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return nil, err
+	}
+	var pendingKey string
+	if !asc.SimulationActive() {
+		keyPEM, err := shared.EncryptSigningKeyPEM(key, opts.password)
+		if err != nil {
+			return nil, fmt.Errorf("encrypt key: %w", err)
+		}
+		pendingKey, err = writePendingKey(opts.outputDir, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("write key: %w", err)
+		}
+	}
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	resp, err := client.CreateCertificate(requestCtx, base64.StdEncoding.EncodeToString(csr), opts.certificateType)
+	if err != nil {
+		if pendingKey != "" {
+			_ = os.Remove(pendingKey)
+		}
+		return nil, fmt.Errorf("failed to create: %w", err)
+	}
+	if asc.SimulationActive() {
+		return simulatedCertificateIdentity(resp.Data.ID, opts), nil
+	}
+	// The certificate exists from here on; errors name it so it can be revoked.
+	id := resp.Data.ID
+	attrs := resp.Data.Attributes
+	base := filepath.Join(opts.outputDir, id)
+	keyFile := pendingKey
+	if opts.keyStore != keyStoreKeychain {
+		// Link rather than rename so an existing key file is never replaced.
+		if err := os.Link(pendingKey, base+".key.pem"); err != nil {
+			return nil, fmt.Errorf("certificate %s: store key: %w (key kept in %s)", id, err, pendingKey)
+		}
+		_ = os.Remove(pendingKey)
+		keyFile = base + ".key.pem"
+	}
+	der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(attrs.CertificateContent))
+	if err != nil || len(der) == 0 {
+		return nil, fmt.Errorf("certificate %s was created without downloadable content (key kept in %s)", id, keyFile)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("certificate %s: parse: %w (key kept in %s)", id, err, keyFile)
+	}
+	if err := shared.CheckSigningKeyMatchesCertificate(key, cert); err != nil {
+		return nil, fmt.Errorf("certificate %s: %w (key kept in %s)", id, err, keyFile)
+	}
+
+	result := &asc.CertificateIdentityResult{
+		ID:              id,
+		Name:            certificateDisplayName(attrs),
+		CertificateType: attrs.CertificateType,
+		SerialNumber:    attrs.SerialNumber,
+		ExpirationDate:  attrs.ExpirationDate,
+		KeyType:         opts.keyType,
+		KeyStore:        opts.keyStore,
+	}
+	if result.CertificateType == "" {
+		result.CertificateType = opts.certificateType
+	}
+
+	// The keychain copy replaces the pending file only once it is stored.
+	switch opts.keyStore {
+	case keyStoreKeychain:
+		plain, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("certificate %s: encode key: %w (key kept in %s)", id, err, keyFile)
+		}
+		keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: plain})
+		if err := auth.StoreSigningKey(id, keyPEM); err != nil {
+			return nil, fmt.Errorf("certificate %s: store key in keychain: %w (key kept in %s)", id, err, keyFile)
+		}
+		_ = os.Remove(pendingKey)
+		result.KeychainItem = auth.SigningKeyKeychainItem(id)
+	default:
+		result.PrivateKeyFile = keyFile
+	}
+
+	p12, err := shared.EncodeSigningP12(key, cert, opts.password, opts.legacyP12)
+	if err != nil {
+		return nil, fmt.Errorf("certificate %s: encode .p12: %w", id, err)
+	}
+	files := []struct {
+		path *string
+		name string
+		data []byte
+	}{
+		{&result.P12File, base + ".p12", p12},
+		{&result.CertificateFile, base + ".cer", der},
+		{&result.CertificatePEMFile, base + ".pem", shared.EncodeCertificatePEM(der)},
+	}
+	for _, file := range files {
+		if err := writeSigningFile(file.name, file.data); err != nil {
+			return nil, fmt.Errorf("certificate %s: write %s: %w", id, filepath.Base(file.name), err)
+		}
+		*file.path = file.name
+	}
+	return result, nil
+}
+
+// simulatedCertificateIdentity records the files a generated identity would be
+// written to; a simulated certificate has no content to store.
+func simulatedCertificateIdentity(id string, opts generateKeyOptions) *asc.CertificateIdentityResult {
+	base := filepath.Join(opts.outputDir, id)
+	result := &asc.CertificateIdentityResult{
+		ID:              id,
+		CertificateType: opts.certificateType,
+		KeyType:         opts.keyType,
+		KeyStore:        opts.keyStore,
+	}
+	if opts.keyStore != keyStoreKeychain {
+		result.PrivateKeyFile = base + ".key.pem"
+		asc.SimulateLocalChange("WRITE", result.PrivateKeyFile)
+	}
+	result.P12File = base + ".p12"
+	result.CertificateFile = base + ".cer"
+	result.CertificatePEMFile = base + ".pem"
+	for _, name := range []string{result.P12File, result.CertificateFile, result.CertificatePEMFile} {
+		asc.SimulateLocalChange("WRITE", name)
+	}
+	return result
+}
+
+func certificateDisplayName(attrs asc.CertificateAttributes) string {
+	if attrs.DisplayName != "" {
+		return attrs.DisplayName
+	}
+	return attrs.Name
+}
+
+// writePendingKey writes an encrypted key to a new private file in dir, to be
+// moved to its certificate's name once the certificate is issued.
+func writePendingKey(dir string, keyPEM []byte) (string, error) {
+	file, err := os.CreateTemp(dir, ".pending-*.key.pem")
+	if err != nil {
+		return "", err
+	}
+	path := file.Name()
+	_, err = file.Write(keyPEM)
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return "", err
+	}
+	return path, nil
+}
+
+func writeSigningFile(path string, data []byte) error {
+	file, err := shared.OpenNewFileNoFollow(path, 0o600)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("output file already exists: %w", err)
+		}
+		return err
+	}
+	defer file.Close()
+
+	if _, err := file.Write(data); err != nil {
+		return err
+	}
+	return file.Sync()
+}
@@ -101,3 +101,27 @@ func TestCertificatesRelationshipsPassTypeIDCommand_MissingID(t *testing.T) {
 		t.Fatalf("expected flag.ErrHelp when --id is missing, got %v", err)
 	}
 }
+
+func TestCertificatesCreateCommand_GenerateKeyValidation(t *testing.T) {
+	t.Setenv("ASC_P12_PASSWORD", "")
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "csr and generate-key", args: []string{"--certificate-type", "IOS_DISTRIBUTION", "--csr", "./cert.csr", "--generate-key", "--p12-password", "x"}},
+		{name: "missing password", args: []string{"--certificate-type", "IOS_DISTRIBUTION", "--generate-key"}},
+		{name: "bad key type", args: []string{"--certificate-type", "IOS_DISTRIBUTION", "--generate-key", "--key-type", "dsa", "--p12-password", "x"}},
+		{name: "bad key store", args: []string{"--certificate-type", "IOS_DISTRIBUTION", "--generate-key", "--key-store", "disk", "--p12-password", "x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := CertificatesCreateCommand()
+			if err := cmd.FlagSet.Parse(tt.args); err != nil {
+				t.Fatalf("failed to parse flags: %v", err)
+			}
+			if err := cmd.Exec(context.Background(), []string{}); err != flag.ErrHelp {
+				t.Fatalf("expected flag.ErrHelp, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runAPICommand(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	return stdout, stderr, runErr
+}
+
+func TestAPIGetSendsFieldsAsQueryAndPrintsRawJSON(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = originalTransport })
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		if got := req.URL.Query().Get("filter[bundleId]"); got != "com.example.app" {
+			t.Fatalf("expected bundle ID filter, got %q", got)
+		}
+		if !strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ") {
+			t.Fatal("expected signed request")
+		}
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"1","attributes":{"name":"App"}}],"links":{"self":"https://api.appstoreconnect.apple.com/v1/apps"}}`)
+	})
+
+	stdout, _, err := runAPICommand(t, "api", "GET", "/v1/apps", "-f", "filter[bundleId]=com.example.app")
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if strings.TrimSpace(stdout) != `{"data":[{"type":"apps","id":"1","attributes":{"name":"App"}}],"links":{"self":"https://api.appstoreconnect.apple.com/v1/apps"}}` {
+		t.Fatalf("expected response body unchanged, got %q", stdout)
+	}
+}
+
+func TestAPIPatchBuildsNestedBodyFromFields(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = originalTransport })
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/v1/betaGroups/group-1" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.Path)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		data := body["data"].(map[string]any)
+		attributes := data["attributes"].(map[string]any)
+		if data["type"] != "betaGroups" || attributes["publicLinkEnabled"] != true || attributes["publicLinkLimit"] != float64(10) {
+			t.Fatalf("unexpected body: %v", body)
+		}
+		return jsonResponse(http.StatusOK, `{"data":{"type":"betaGroups","id":"group-1","attributes":{"publicLinkEnabled":true}}}`)
+	})
+
+	stdout, _, err := runAPICommand(t, "api", "PATCH", "/v1/betaGroups/group-1",
+		"-f", "data.type=betaGroups", "-f", "data.id=group-1",
+		"-F", "data.attributes.publicLinkEnabled=true", "-F", "data.attributes.publicLinkLimit=10",
+		"--output", "table")
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if !strings.Contains(stdout, "betaGroups") || !strings.Contains(stdout, "group-1") {
+		t.Fatalf("expected table row for the resource, got %q", stdout)
+	}
+}
+
+func TestAPIPaginateAggregatesPages(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() { http.DefaultTransport = originalTransport })
+	calls := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		if req.URL.Query().Get("cursor") == "" {
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"1"}],"links":{"next":"https://api.appstoreconnect.apple.com/v1/apps?cursor=2"}}`)
+		}
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"2"}],"links":{}}`)
+	})
+
+	stdout, _, err := runAPICommand(t, "api", "GET", "/v1/apps", "--paginate")
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	var result struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("invalid JSON output %q: %v", stdout, err)
+	}
+	if calls != 2 || len(result.Data) != 2 || result.Data[1].ID != "2" {
+		t.Fatalf("expected two aggregated pages, got calls=%d data=%v", calls, result.Data)
+	}
+}
+
+func TestAPIValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "missing path", args: []string{"api", "GET"}, wantErr: "expected <METHOD> <path>"},
+		{name: "unsupported method", args: []string{"api", "TRACE", "/v1/apps"}, wantErr: `unsupported method "TRACE"`},
+		{name: "unknown endpoint", args: []string{"api", "GET", "/v1/appz"}, wantErr: "Did you mean:\n  GET /v1/apps"},
+		{name: "method not allowed", args: []string{"api", "POST", "/v1/apps/123"}, wantErr: "supported: GET, PATCH"},
+		{name: "paginate non-GET", args: []string{"api", "DELETE", "/v1/betaGroups/1", "--paginate"}, wantErr: "--paginate is only supported for GET"},
+		{name: "foreign host", args: []string{"api", "GET", "https://example.com/v1/apps"}, wantErr: "API host"},
+		{name: "conflicting fields", args: []string{"api", "POST", "/v1/betaGroups", "-f", "data=x", "-f", "data.type=betaGroups"}, wantErr: "conflicts with"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, stderr, err := runAPICommand(t, test.args...)
+			if !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected flag.ErrHelp, got %v", err)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected stderr to contain %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestAPIEndpointsListsIndexedPaths(t *testing.T) {
+	stdout, _, err := runAPICommand(t, "api", "endpoints", "--method", "get", "--paths", "/v1/apps/{id}/bui")
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if !strings.Contains(stdout, "/v1/apps/{id}/builds\n") {
+		t.Fatalf("expected builds path, got %q", stdout)
+	}
+	if strings.Contains(stdout, "GET") {
+		t.Fatalf("expected paths only, got %q", stdout)
+	}
+}
@@ -0,0 +1,132 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestBuildsUploadResumeRejectsDryRun(t *testing.T) {
+	ipaPath := filepath.Join(t.TempDir(), "app.ipa")
+	if err := os.WriteFile(ipaPath, []byte("ipa"), 0o600); err != nil {
+		t.Fatalf("write ipa: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "upload", "--app", "APP_123", "--ipa", ipaPath, "--version", "1.0.0", "--build-number", "1", "--dry-run", "--resume"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "--resume is not supported with --dry-run") {
+		t.Fatalf("expected --resume/--dry-run error, got %v", runErr)
+	}
+}
+
+func TestBuildsUploadResumeSkipsCompletedParts(t *testing.T) {
+	setupAuth(t)
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(home, "nonexistent.json"))
+
+	ipaPath := filepath.Join(t.TempDir(), "app.ipa")
+	if err := os.WriteFile(ipaPath, []byte("abcdefghij"), 0o600); err != nil {
+		t.Fatalf("write ipa: %v", err)
+	}
+	sum, err := asc.ComputeFileChecksum(ipaPath, asc.ChecksumAlgorithmSHA256)
+	if err != nil {
+		t.Fatalf("checksum: %v", err)
+	}
+
+	journalDir, err := asc.DefaultUploadJournalDir()
+	if err != nil {
+		t.Fatalf("journal dir: %v", err)
+	}
+	journal := &asc.UploadJournal{
+		UploadJournalKey: asc.UploadJournalKey{
+			AppID:        "APP_123",
+			Version:      "1.0.0",
+			BuildNumber:  "7",
+			Platform:     asc.PlatformIOS,
+			FileChecksum: sum.Hash,
+		},
+		UploadID: "UPLOAD_1",
+		FileID:   "FILE_1",
+		FileName: "app.ipa",
+		FileSize: 10,
+		Operations: []asc.UploadOperation{
+			{Method: "PUT", URL: "https://upload.example.com/part0", Length: 5, Offset: 0},
+			{Method: "PUT", URL: "https://upload.example.com/part1", Length: 5, Offset: 5},
+		},
+	}
+	if err := asc.CreateUploadJournal(journalDir, journal); err != nil {
+		t.Fatalf("create journal: %v", err)
+	}
+	if err := journal.MarkCompleted(0); err != nil {
+		t.Fatalf("mark completed: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var requests []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.String())
+		switch {
+		case req.Method == http.MethodPut && req.URL.String() == "https://upload.example.com/part1":
+			body, _ := io.ReadAll(req.Body)
+			if string(body) != "fghij" {
+				t.Fatalf("expected second part body fghij, got %q", body)
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+		case req.Method == http.MethodPatch && req.URL.Path == "/v1/buildUploadFiles/FILE_1":
+			body := `{"data":{"type":"buildUploadFiles","id":"FILE_1","attributes":{"uploaded":true}}}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "upload", "--app", "APP_123", "--ipa", ipaPath, "--version", "1.0.0", "--build-number", "7", "--resume"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %v", requests)
+	}
+	if !strings.Contains(stderr, "Resuming upload UPLOAD_1 (1 of 2 parts already uploaded)") {
+		t.Fatalf("expected resume notice on stderr, got %q", stderr)
+	}
+	if !strings.Contains(stdout, `"uploadId":"UPLOAD_1"`) || !strings.Contains(stdout, `"uploaded":true`) {
+		t.Fatalf("unexpected output: %q", stdout)
+	}
+	if _, err := os.Stat(filepath.Join(journalDir, "UPLOAD_1.json")); !os.IsNotExist(err) {
+		t.Fatalf("expected journal removed after commit, got %v", err)
+	}
+}
@@ -0,0 +1,186 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pkcs12 "software.sslmate.com/src/go-pkcs12"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// issueTestCertificate signs the CSR of a certificates create request with a
+// throwaway CA, the way App Store Connect issues certificates.
+func issueTestCertificate(t *testing.T, req *http.Request) string {
+	t.Helper()
+	var payload struct {
+		Data struct {
+			Attributes asc.CertificateCreateAttributes `json:"attributes"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+		t.Fatalf("decode request: %v", err)
+	}
+	csrDER, err := base64.StdEncoding.DecodeString(payload.Data.Attributes.CSRContent)
+	if err != nil {
+		t.Fatalf("decode CSR: %v", err)
+	}
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	if err != nil || csr.CheckSignature() != nil {
+		t.Fatalf("invalid CSR: %v", err)
+	}
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate CA key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0x5E71A1),
+		Subject:      pkix.Name{CommonName: "Apple Distribution: Example"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(1, 0, 0),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, csr.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("issue certificate: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+func TestCertificatesCreateGenerateKeyWritesIdentity(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_P12_PASSWORD", "secret")
+	outputDir := filepath.Join(t.TempDir(), "certs")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/certificates" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		content := issueTestCertificate(t, req)
+		return jsonResponse(http.StatusCreated, `{"data":{"type":"certificates","id":"CERT123","attributes":{"name":"Apple Distribution","certificateType":"IOS_DISTRIBUTION","serialNumber":"5E71A1","expirationDate":"2027-01-01T00:00:00.000+0000","certificateContent":"`+content+`"}}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"certificates", "create", "--certificate-type", "IOS_DISTRIBUTION", "--generate-key", "--output-dir", outputDir}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result asc.CertificateIdentityResult
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output %q: %v", stdout, err)
+	}
+	if result.ID != "CERT123" || result.KeyType != "rsa" || result.KeyStore != "file" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	for _, path := range []string{result.CertificateFile, result.CertificatePEMFile, result.PrivateKeyFile, result.P12File} {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatalf("expected %s to be written: %v", path, err)
+		}
+		if info.Mode().Perm() != 0o600 {
+			t.Fatalf("expected %s to be private, got %v", path, info.Mode().Perm())
+		}
+	}
+
+	p12Data, _ := os.ReadFile(result.P12File)
+	p12Key, cert, err := pkcs12.Decode(p12Data, "secret")
+	if err != nil || cert.SerialNumber.Int64() != 0x5E71A1 {
+		t.Fatalf("unexpected .p12 contents: %v, %v", cert, err)
+	}
+	keyPEM, _ := os.ReadFile(result.PrivateKeyFile)
+	key, err := shared.DecryptSigningKeyPEM(keyPEM, "secret")
+	if err != nil {
+		t.Fatalf("DecryptSigningKeyPEM() error: %v", err)
+	}
+	if err := shared.CheckSigningKeyMatchesCertificate(key, cert); err != nil {
+		t.Fatalf("key file does not match certificate: %v", err)
+	}
+	if p12Key == nil {
+		t.Fatal("expected private key in .p12")
+	}
+}
+
+func TestCertificatesCreateGenerateKeyKeepsKeyWhenIssuedCertificateIsUnusable(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_P12_PASSWORD", "secret")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	run := func(outputDir string) error {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		var runErr error
+		captureOutput(t, func() {
+			if err := root.Parse([]string{"certificates", "create", "--certificate-type", "IOS_DISTRIBUTION", "--generate-key", "--output-dir", outputDir}); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			runErr = root.Run(context.Background())
+		})
+		return runErr
+	}
+	listDir := func(dir string) []string {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatalf("read output dir: %v", err)
+		}
+		names := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		return names
+	}
+
+	// The certificate is issued without content: its key must survive.
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusCreated, `{"data":{"type":"certificates","id":"CERT123","attributes":{"certificateType":"IOS_DISTRIBUTION"}}}`)
+	})
+	outputDir := filepath.Join(t.TempDir(), "certs")
+	if err := run(outputDir); err == nil {
+		t.Fatal("expected an error for a certificate without content")
+	}
+	if names := listDir(outputDir); len(names) != 1 || names[0] != "CERT123.key.pem" {
+		t.Fatalf("expected only the key file to remain, got %v", names)
+	}
+
+	// The request fails: no certificate exists, so no key is kept.
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(http.StatusConflict, `{"errors":[{"status":"409","code":"ENTITY_ERROR","title":"limit reached"}]}`)
+	})
+	outputDir = filepath.Join(t.TempDir(), "certs")
+	if err := run(outputDir); err == nil {
+		t.Fatal("expected an error for a failed request")
+	}
+	if names := listDir(outputDir); len(names) != 0 {
+		t.Fatalf("expected no files after a failed request, got %v", names)
+	}
+}
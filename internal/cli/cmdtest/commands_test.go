@@ -2921,6 +2921,16 @@ func TestTestFlightSyncValidationErrors(t *testing.T) {
 			args:    []string{"testflight", "sync", "pull", "--app", "APP_ID", "--output", "./testflight.yaml", "--tester", "tester@example.com"},
 			wantErr: "--tester requires --include-testers",
 		},
+		{
+			name:    "testflight sync push missing app",
+			args:    []string{"testflight", "sync", "push", "--file", "./testflight.yaml"},
+			wantErr: "--app is required",
+		},
+		{
+			name:    "testflight sync push missing file",
+			args:    []string{"testflight", "sync", "push", "--app", "APP_ID"},
+			wantErr: "--file is required",
+		},
 	}
 
 	for _, test := range tests {
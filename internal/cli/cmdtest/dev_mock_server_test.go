@@ -0,0 +1,107 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDevMockServerStartsAndStopsWithContext(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"dev", "mock-server", "--addr", "127.0.0.1:0", "--data-dir", t.TempDir()}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(ctx); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if !strings.Contains(stderr, "listening on http://127.0.0.1:") || !strings.Contains(stderr, "export ASC_BASE_URL=") {
+		t.Fatalf("expected startup banner, got %q", stderr)
+	}
+}
+
+func TestDevMockServerValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "unexpected argument",
+			args:    []string{"dev", "mock-server", "extra"},
+			wantErr: "unexpected argument(s): extra",
+		},
+		{
+			name:    "missing seed file",
+			args:    []string{"dev", "mock-server", "--seed", filepath.Join(t.TempDir(), "missing.json")},
+			wantErr: "dev mock-server:",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			var runErr error
+			_, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				runErr = root.Run(context.Background())
+			})
+			if runErr == nil {
+				t.Fatal("expected error")
+			}
+			if errors.Is(runErr, flag.ErrHelp) {
+				if !strings.Contains(stderr, test.wantErr) {
+					t.Fatalf("expected stderr to contain %q, got %q", test.wantErr, stderr)
+				}
+				return
+			}
+			if !strings.Contains(runErr.Error(), test.wantErr) {
+				t.Fatalf("expected error to contain %q, got %v", test.wantErr, runErr)
+			}
+		})
+	}
+}
+
+func TestInvalidBaseURLFailsInsteadOfUsingProduction(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_BASE_URL", "localhost:8787")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"apps", "list"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "invalid ASC_BASE_URL") {
+		t.Fatalf("expected invalid ASC_BASE_URL error, got %v", runErr)
+	}
+}
@@ -0,0 +1,144 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestDevicesImportRegistersNewDevices(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	file := filepath.Join(t.TempDir(), "devices.txt")
+	content := "Device ID\tDevice Name\tDevice Platform\n" +
+		"00008030-001A35E11A68802E\tQA iPhone\tios\n" +
+		"00008030-0000000000000001\tAlready There\tios\n" +
+		"00008030-0000000000000002\tRejected\tios\n" +
+		"not-a-udid\tTypo\tios\n"
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+
+	var created []string
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/devices":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"devices","id":"D0","attributes":{"udid":"00008030-0000000000000001","platform":"IOS","deviceClass":"IPHONE"}}]}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/devices":
+			var payload struct {
+				Data struct {
+					Attributes asc.DeviceCreateAttributes `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode request: %v", err)
+			}
+			created = append(created, payload.Data.Attributes.UDID)
+			if payload.Data.Attributes.Name == "Rejected" {
+				return jsonResponse(http.StatusConflict, `{"errors":[{"status":"409","code":"ENTITY_ERROR","title":"An attribute value is invalid.","detail":"A device with number '00008030-0000000000000002' already exists on this team."}]}`)
+			}
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"devices","id":"D1","attributes":{"name":"QA iPhone","udid":"00008030-001A35E11A68802E","platform":"IOS"}}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"devices", "import", "--file", file}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if _, ok := errors.AsType[ReportedError](runErr); !ok {
+		t.Fatalf("expected ReportedError for failed rows, got %v", runErr)
+	}
+
+	var result asc.DeviceImportResult
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output %q: %v", stdout, err)
+	}
+	if result.Registered != 1 || result.Skipped != 1 || result.Failed != 2 {
+		t.Fatalf("unexpected counts: %+v", result)
+	}
+	var statuses []string
+	for _, row := range result.Rows {
+		statuses = append(statuses, row.Status)
+	}
+	if got := strings.Join(statuses, ","); got != "registered,exists,failed,invalid" {
+		t.Fatalf("unexpected row statuses %q: %+v", got, result.Rows)
+	}
+	if result.Rows[0].DeviceID != "D1" || result.Rows[1].DeviceID != "D0" || !strings.Contains(result.Rows[2].Message, "already exists") {
+		t.Fatalf("unexpected row details: %+v", result.Rows)
+	}
+	if strings.Join(created, ",") != "00008030-001A35E11A68802E,00008030-0000000000000002" {
+		t.Fatalf("expected only valid new devices to be registered, got %v", created)
+	}
+}
+
+func TestDevicesExportWritesAppleFormat(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	file := filepath.Join(t.TempDir(), "devices.txt")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/devices" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		if got := req.URL.Query().Get("filter[status]"); got != "ENABLED" {
+			t.Fatalf("expected filter[status]=ENABLED, got %q", got)
+		}
+		if req.URL.Query().Get("cursor") == "" {
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"devices","id":"D1","attributes":{"name":"QA iPhone","udid":"00008030-001A35E11A68802E","platform":"IOS"}}],"links":{"next":"https://api.appstoreconnect.apple.com/v1/devices?cursor=2&filter%5Bstatus%5D=ENABLED"}}`)
+		}
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"devices","id":"D2","attributes":{"name":"QA Mac","udid":"A5B5CD50-14AB-5AF7-8B78-AB4751AB10A8","platform":"MAC_OS"}}]}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"devices", "export", "--file", file, "--status", "enabled"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var result asc.DeviceExportResult
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output %q: %v", stdout, err)
+	}
+	if result.Devices != 2 || result.Format != "tsv" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("read export: %v", err)
+	}
+	want := "Device ID\tDevice Name\tDevice Platform\n" +
+		"00008030-001A35E11A68802E\tQA iPhone\tios\n" +
+		"A5B5CD50-14AB-5AF7-8B78-AB4751AB10A8\tQA Mac\tmac\n"
+	if string(data) != want {
+		t.Fatalf("unexpected export:\n%q\nwant:\n%q", data, want)
+	}
+}
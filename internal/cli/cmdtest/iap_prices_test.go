@@ -249,26 +249,32 @@ func TestIAPPricesTableOutput(t *testing.T) {
 		}
 	})
 
-	root := RootCommand("1.2.3")
-	root.FlagSet.SetOutput(io.Discard)
+	for _, format := range []string{"table", "csv", "ndjson"} {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
 
-	stdout, stderr := captureOutput(t, func() {
-		if err := root.Parse([]string{"iap", "prices", "--iap-id", "iap-1", "--output", "table"}); err != nil {
-			t.Fatalf("parse error: %v", err)
+		stdout, stderr := captureOutput(t, func() {
+			if err := root.Parse([]string{"iap", "prices", "--iap-id", "iap-1", "--output", format}); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("%s: run error: %v", format, err)
+			}
+		})
+
+		if stderr != "" {
+			t.Fatalf("%s: expected empty stderr, got %q", format, stderr)
 		}
-		if err := root.Run(context.Background()); err != nil {
-			t.Fatalf("run error: %v", err)
+		header := "Current Price"
+		if format == "ndjson" {
+			header = `"current_price":`
+		}
+		if !strings.Contains(stdout, header) {
+			t.Fatalf("%s: expected headers in output, got %q", format, stdout)
+		}
+		if !strings.Contains(stdout, "9.99 USD") {
+			t.Fatalf("%s: expected formatted current price in output, got %q", format, stdout)
 		}
-	})
-
-	if stderr != "" {
-		t.Fatalf("expected empty stderr, got %q", stderr)
-	}
-	if !strings.Contains(stdout, "Current Price") {
-		t.Fatalf("expected table headers in output, got %q", stdout)
-	}
-	if !strings.Contains(stdout, "9.99 USD") {
-		t.Fatalf("expected formatted current price in output, got %q", stdout)
 	}
 }
 
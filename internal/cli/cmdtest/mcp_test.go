@@ -0,0 +1,26 @@
+package cmdtest
+
+import (
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/mcp"
+)
+
+func TestMCPReadOnlyExcludesMutatingCommands(t *testing.T) {
+	root := RootCommand("1.2.3")
+	exposed := make(map[string]bool)
+	for _, tool := range mcp.CollectTools(root.Subcommands, mcp.Filter{ReadOnly: true}) {
+		exposed[tool.Name] = true
+	}
+
+	for _, name := range []string{"apps_list", "builds_info", "signing_audit"} {
+		if !exposed[name] {
+			t.Errorf("expected %s to be exposed with --read-only", name)
+		}
+	}
+	for _, name := range []string{"signing_sync", "signing_fetch", "finance_reports", "analytics_sales", "builds_upload", "apps_create"} {
+		if exposed[name] {
+			t.Errorf("expected %s to be hidden with --read-only", name)
+		}
+	}
+}
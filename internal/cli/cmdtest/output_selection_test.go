@@ -0,0 +1,111 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+)
+
+func setupOutputSelectionTransport(t *testing.T) {
+	t.Helper()
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/apps" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		body := `{
+			"data":[
+				{"type":"apps","id":"app-1","attributes":{"name":"Alpha","bundleId":"com.example.alpha","sku":"A1"}},
+				{"type":"apps","id":"app-2","attributes":{"name":"Beta","bundleId":"com.example.beta","sku":"B2"}}
+			],
+			"links":{"self":"https://api.appstoreconnect.apple.com/v1/apps"}
+		}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+}
+
+func runOutputSelection(t *testing.T, args []string) string {
+	t.Helper()
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+	return stdout
+}
+
+func TestOutputSelectProjectsJSONResources(t *testing.T) {
+	setupOutputSelectionTransport(t)
+
+	stdout := runOutputSelection(t, []string{"--select", "id,attributes.name", "apps", "list"})
+	want := `{"data":[{"id":"app-1","attributes":{"name":"Alpha"}},{"id":"app-2","attributes":{"name":"Beta"}}],"links":{"self":"https://api.appstoreconnect.apple.com/v1/apps"}}`
+	if strings.TrimSpace(stdout) != want {
+		t.Fatalf("unexpected output:\n%s", stdout)
+	}
+}
+
+func TestOutputSelectPicksTableColumns(t *testing.T) {
+	setupOutputSelectionTransport(t)
+
+	stdout := runOutputSelection(t, []string{"--select", "id,attributes.bundleId", "apps", "list", "--output", "csv"})
+	want := "id,attributes.bundleId\napp-1,com.example.alpha\napp-2,com.example.beta\n"
+	if stdout != want {
+		t.Fatalf("unexpected output:\n%s", stdout)
+	}
+}
+
+func TestOutputQueryFiltersAndReshapes(t *testing.T) {
+	setupOutputSelectionTransport(t)
+
+	stdout := runOutputSelection(t, []string{"--query", "data[?attributes.name == 'Beta'].{id: id, sku: attributes.sku}", "apps", "list"})
+	if strings.TrimSpace(stdout) != `[{"id":"app-2","sku":"B2"}]` {
+		t.Fatalf("unexpected output:\n%s", stdout)
+	}
+
+	setupOutputSelectionTransport(t)
+	stdout = runOutputSelection(t, []string{"--query", "data[*].id", "apps", "list", "--output", "markdown"})
+	for _, want := range []string{"value", "app-1", "app-2"} {
+		if !strings.Contains(stdout, want) {
+			t.Fatalf("expected markdown output to contain %q, got:\n%s", want, stdout)
+		}
+	}
+}
+
+func TestOutputQueryRejectsInvalidExpression(t *testing.T) {
+	setupAuth(t)
+
+	_, stderr := captureOutput(t, func() {
+		code := cmd.Run([]string{"--no-update", "--query", "data[", "apps", "list"}, "1.2.3")
+		if code != cmd.ExitUsage {
+			t.Errorf("expected exit code %d, got %d", cmd.ExitUsage, code)
+		}
+	})
+	if !strings.Contains(stderr, "invalid --query") {
+		t.Fatalf("expected invalid --query error, got %q", stderr)
+	}
+}
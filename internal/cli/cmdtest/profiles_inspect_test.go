@@ -0,0 +1,206 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"howett.net/plist"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// writeInspectTestProfile writes a development profile for two devices and
+// returns its path and embedded certificate.
+func writeInspectTestProfile(t *testing.T) (string, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0xC0FFEE),
+		Subject:      pkix.Name{CommonName: "Apple Development: Example"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	data, err := plist.Marshal(map[string]any{
+		"AppIDName":                   "Example",
+		"ApplicationIdentifierPrefix": []string{"TEAM123456"},
+		"CreationDate":                time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		"DeveloperCertificates":       [][]byte{cert},
+		"Entitlements": map[string]any{
+			"application-identifier": "TEAM123456.com.example.app",
+			"get-task-allow":         true,
+			"keychain-access-groups": []string{"TEAM123456.*"},
+		},
+		"ExpirationDate":     time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC),
+		"Name":               "Example Development",
+		"Platform":           []string{"iOS"},
+		"ProvisionedDevices": []string{"UDID-B", "UDID-A"},
+		"TeamIdentifier":     []string{"TEAM123456"},
+		"TeamName":           "Example Team",
+		"UUID":               "1111-2222",
+	}, plist.XMLFormat)
+	if err != nil {
+		t.Fatalf("marshal profile: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "Example.mobileprovision")
+	envelope := append([]byte("0\x80\x06\x09*\x86H\x86\xf7\r\x01\x07\x02"), data...)
+	if err := os.WriteFile(path, append(envelope, 0, 0), 0o600); err != nil {
+		t.Fatalf("write profile: %v", err)
+	}
+	return path, cert
+}
+
+func runProfilesInspect(t *testing.T, args ...string) (asc.ProfileInspectResult, error) {
+	t.Helper()
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(append([]string{"profiles", "inspect"}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	var result asc.ProfileInspectResult
+	if runErr == nil || stdout != "" {
+		if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+			t.Fatalf("failed to parse output %q: %v", stdout, err)
+		}
+	}
+	return result, runErr
+}
+
+func TestProfilesInspectDecodesFileOffline(t *testing.T) {
+	path, cert := writeInspectTestProfile(t)
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("inspect must not call the API: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	result, err := runProfilesInspect(t, path)
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+	if result.UUID != "1111-2222" || result.TeamID != "TEAM123456" || result.TeamName != "Example Team" || result.BundleID != "com.example.app" {
+		t.Fatalf("unexpected profile fields: %+v", result)
+	}
+	if result.ExpirationDate != "2031-01-01T00:00:00Z" || result.Expired {
+		t.Fatalf("unexpected expiry: %q expired=%t", result.ExpirationDate, result.Expired)
+	}
+	if strings.Join(result.Devices, ",") != "UDID-A,UDID-B" {
+		t.Fatalf("expected sorted devices, got %v", result.Devices)
+	}
+	sum := sha256.Sum256(cert)
+	if len(result.Certificates) != 1 || result.Certificates[0].SHA256 != strings.ToUpper(hex.EncodeToString(sum[:])) || result.Certificates[0].CommonName != "Apple Development: Example" {
+		t.Fatalf("unexpected certificates: %+v", result.Certificates)
+	}
+	if result.Entitlements["get-task-allow"] != true {
+		t.Fatalf("expected entitlements, got %+v", result.Entitlements)
+	}
+	if result.Compare != nil {
+		t.Fatalf("expected no comparison without --compare, got %+v", result.Compare)
+	}
+}
+
+func TestProfilesInspectCompareReportsDrift(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	path, cert := writeInspectTestProfile(t)
+	content := base64.StdEncoding.EncodeToString(cert)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/profiles":
+			if got := req.URL.Query().Get("filter[name]"); got != "Example Development" {
+				t.Fatalf("expected name filter, got %q", got)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"profiles","id":"OLD","attributes":{"name":"Example Development","uuid":"0000-0000"}},
+				{"type":"profiles","id":"PROFILE1","attributes":{"name":"Example Development","uuid":"1111-2222"}}
+			]}`)
+		case "/v1/profiles/PROFILE1":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"profiles","id":"PROFILE1","attributes":{"name":"Example Development","profileState":"ACTIVE","uuid":"1111-2222","expirationDate":"2031-01-01T00:00:00.000+0000"}}}`)
+		case "/v1/profiles/PROFILE1/devices":
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"devices","id":"D1","attributes":{"udid":"udid-a"}},
+				{"type":"devices","id":"D3","attributes":{"udid":"UDID-C"}}
+			]}`)
+		case "/v1/profiles/PROFILE1/certificates":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"certificates","id":"CERT1","attributes":{"certificateContent":"`+content+`"}}]}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	result, err := runProfilesInspect(t, path, "--compare")
+	if _, ok := errors.AsType[ReportedError](err); !ok {
+		t.Fatalf("expected ReportedError for drift, got %v", err)
+	}
+	compare := result.Compare
+	if compare == nil || compare.ProfileID != "PROFILE1" || compare.InSync {
+		t.Fatalf("unexpected comparison: %+v", compare)
+	}
+	want := []asc.ProfileDifference{
+		{Field: "device", Local: "UDID-B"},
+		{Field: "device", Live: "UDID-C"},
+	}
+	if len(compare.Differences) != len(want) {
+		t.Fatalf("expected differences %+v, got %+v", want, compare.Differences)
+	}
+	for i := range want {
+		if compare.Differences[i] != want[i] {
+			t.Fatalf("expected differences %+v, got %+v", want, compare.Differences)
+		}
+	}
+}
+
+func TestProfilesInspectRequiresOneFile(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"profiles", "inspect"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected flag.ErrHelp, got %v", err)
+		}
+	})
+	if !strings.Contains(stderr, "exactly one profile file is required") {
+		t.Fatalf("expected usage error, got %q", stderr)
+	}
+}
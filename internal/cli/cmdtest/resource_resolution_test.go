@@ -0,0 +1,202 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func setupResourceResolution(t *testing.T, handler func(req *http.Request) (*http.Response, error)) *[]string {
+	t.Helper()
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	shared.ResetResolvedRefs()
+	t.Cleanup(shared.ResetResolvedRefs)
+
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		requests = append(requests, req.Method+" "+req.URL.Path+"?"+req.URL.RawQuery)
+		mu.Unlock()
+		return handler(req)
+	})
+	return &requests
+}
+
+func TestResourceResolution_BundleIDBuildNumberAndGroupName(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "com.example.app")
+	requests := setupResourceResolution(t, func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps" && query.Get("filter[bundleId]") == "com.example.app":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"111","attributes":{"name":"Example","bundleId":"com.example.app"}}]}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/builds" && query.Get("filter[app]") == "111" && query.Get("filter[version]") == "42":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"builds","id":"build-42","attributes":{"version":"42"}}]}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/111/betaGroups":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"betaGroups","id":"group-1","attributes":{"name":"External Testers"}}]}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/builds/build-42/relationships/betaGroups":
+			return jsonResponse(http.StatusNoContent, "")
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	for i := 0; i < 2; i++ {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse([]string{"builds", "add-groups", "--build", "42", "--group", "External Testers", "--output", "json"}); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("run error: %v", err)
+			}
+		})
+		if !strings.Contains(stdout, `"buildId":"build-42"`) || !strings.Contains(stdout, `"group-1"`) {
+			t.Fatalf("expected resolved IDs in output, got %q", stdout)
+		}
+	}
+
+	// Lookups are cached for the rest of the run: only the second POST repeats.
+	var lookups int
+	for _, request := range *requests {
+		if strings.HasPrefix(request, http.MethodGet) {
+			lookups++
+		}
+	}
+	if lookups != 3 || len(*requests) != 5 {
+		t.Fatalf("expected 3 lookups and 2 updates, got %q", *requests)
+	}
+}
+
+func TestResourceResolution_EachRootInvocationLooksUpAgain(t *testing.T) {
+	requests := setupResourceResolution(t, func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/apps" {
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"111","attributes":{"name":"Example","bundleId":"com.example.app"}}]}`)
+		}
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/apps/111/builds" {
+			return jsonResponse(http.StatusOK, `{"data":[]}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	for i := 0; i < 2; i++ {
+		captureOutput(t, func() {
+			if code := cmd.Run([]string{"--no-update", "builds", "list", "--app", "com.example.app"}, "1.2.3"); code != cmd.ExitSuccess {
+				t.Errorf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+			}
+		})
+	}
+
+	var lookups int
+	for _, request := range *requests {
+		if strings.HasPrefix(request, http.MethodGet+" /v1/apps?") {
+			lookups++
+		}
+	}
+	if lookups != 2 {
+		t.Fatalf("expected a fresh lookup per invocation, got %q", *requests)
+	}
+}
+
+func TestResourceResolution_AmbiguousBuildNumber(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "111")
+	setupResourceResolution(t, func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodGet && req.URL.Path == "/v1/builds" {
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"builds","id":"build-a","attributes":{"version":"7"}},{"type":"builds","id":"build-b","attributes":{"version":"7"}}]}`)
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "add-groups", "--build", "7", "--group", "GROUP_ID"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || errors.Is(runErr, flag.ErrHelp) {
+		t.Fatalf("expected runtime error, got %v", runErr)
+	}
+	want := `--build: multiple builds have build number "7" (build-a, build-b); use the build ID`
+	if runErr.Error() != want {
+		t.Fatalf("error = %q, want %q", runErr.Error(), want)
+	}
+}
+
+func TestResourceResolution_PassesIDsThroughWithoutLookups(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	setupResourceResolution(t, func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodPost && req.URL.Path == "/v1/builds/BUILD_ID/relationships/betaGroups" {
+			return jsonResponse(http.StatusNoContent, "")
+		}
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "add-groups", "--build", "BUILD_ID", "--group", "G1,G2"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+}
+
+func TestResourceResolution_EnvAppResolvesIntoFlagWithoutChangingEnv(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "com.example.app")
+	setupResourceResolution(t, func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps" && req.URL.Query().Get("filter[bundleId]") == "com.example.app":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"apps","id":"111","attributes":{"name":"Example","bundleId":"com.example.app"}}]}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/111/builds":
+			return jsonResponse(http.StatusOK, `{"data":[],"links":{}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"builds", "list"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if got := os.Getenv("ASC_APP_ID"); got != "com.example.app" {
+		t.Fatalf("expected ASC_APP_ID to be left unchanged, got %q", got)
+	}
+}
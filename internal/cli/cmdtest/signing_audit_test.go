@@ -0,0 +1,77 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestSigningAuditFailOnThreshold(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	expiring := time.Now().AddDate(0, 0, 10).UTC().Format("2006-01-02T15:04:05.000-0700")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/certificates":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"certificates","id":"CERT1","attributes":{"name":"Distribution","certificateType":"IOS_DISTRIBUTION","expirationDate":"`+expiring+`"}}]}`)
+		case "/v1/profiles", "/v1/bundleIds":
+			return jsonResponse(http.StatusOK, `{"data":[]}`)
+		case "/v1/devices":
+			if got := req.URL.Query().Get("filter[status]"); got != "ENABLED" {
+				t.Fatalf("expected enabled devices only, got filter[status]=%q", got)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[]}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	run := func(args ...string) (asc.SigningAuditResult, error) {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+
+		var runErr error
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse(append([]string{"signing", "audit"}, args...)); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			runErr = root.Run(context.Background())
+		})
+		var result asc.SigningAuditResult
+		if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+			t.Fatalf("failed to parse output %q: %v", stdout, err)
+		}
+		return result, runErr
+	}
+
+	result, err := run()
+	if err != nil {
+		t.Fatalf("expected a warning not to fail the default threshold, got %v", err)
+	}
+	if len(result.Findings) != 1 || result.Findings[0].Check != "certificate-expiring" || result.Summary.Warnings != 1 {
+		t.Fatalf("unexpected audit result: %+v", result)
+	}
+
+	if _, err := run("--fail-on", "warning"); err == nil {
+		t.Fatal("expected --fail-on warning to fail")
+	} else if _, ok := errors.AsType[ReportedError](err); !ok {
+		t.Fatalf("expected ReportedError, got %v", err)
+	}
+
+	if result, err := run("--days", "5"); err != nil || len(result.Findings) != 0 {
+		t.Fatalf("expected no findings within 5 days, got %+v (%v)", result.Findings, err)
+	}
+}
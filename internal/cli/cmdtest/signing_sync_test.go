@@ -0,0 +1,182 @@
+package cmdtest
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"howett.net/plist"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// testProvisioningProfile returns a profile for bundle that includes cert,
+// wrapped like the CMS envelope of a real .mobileprovision.
+func testProvisioningProfile(t *testing.T, uuid, bundle string, cert []byte) string {
+	t.Helper()
+	data, err := plist.Marshal(map[string]any{
+		"Name":                        "asc " + bundle,
+		"UUID":                        uuid,
+		"TeamIdentifier":              []string{"TEAM123456"},
+		"ApplicationIdentifierPrefix": []string{"TEAM123456"},
+		"ExpirationDate":              time.Now().AddDate(1, 0, 0).UTC().Truncate(time.Second),
+		"DeveloperCertificates":       [][]byte{cert},
+		"Entitlements":                map[string]any{"application-identifier": "TEAM123456." + bundle},
+	}, plist.XMLFormat)
+	if err != nil {
+		t.Fatalf("marshal profile: %v", err)
+	}
+	envelope := append([]byte("0\x80\x06\x09*\x86H\x86\xf7\r\x01\x07\x02"), data...)
+	return base64.StdEncoding.EncodeToString(append(envelope, 0, 0))
+}
+
+func runSigningSync(t *testing.T, args ...string) asc.SigningSyncResult {
+	t.Helper()
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse(append([]string{"signing", "sync"}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	var result asc.SigningSyncResult
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output %q: %v", stdout, err)
+	}
+	return result
+}
+
+func TestSigningSyncCreatesStoresAndReadsBack(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_SIGNING_PASSPHRASE", "secret")
+	for _, key := range []string{"GIT_AUTHOR_NAME", "GIT_COMMITTER_NAME"} {
+		t.Setenv(key, "asc")
+	}
+	for _, key := range []string{"GIT_AUTHOR_EMAIL", "GIT_COMMITTER_EMAIL"} {
+		t.Setenv(key, "asc@example.com")
+	}
+	repo := filepath.Join(t.TempDir(), "certificates")
+	outputDir := filepath.Join(t.TempDir(), "signing")
+
+	var (
+		certID      string
+		certDER     []byte
+		profileJSON string
+		creates     int
+	)
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/certificates":
+			if certID == "" {
+				return jsonResponse(http.StatusOK, `{"data":[]}`)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"certificates","id":"`+certID+`","attributes":{"certificateType":"IOS_DISTRIBUTION"}}]}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/certificates":
+			creates++
+			content := issueTestCertificate(t, req)
+			certID = "CERT1"
+			certDER, _ = base64.StdEncoding.DecodeString(content)
+			return jsonResponse(http.StatusCreated, `{"data":{"type":"certificates","id":"CERT1","attributes":{"certificateType":"IOS_DISTRIBUTION","certificateContent":"`+content+`"}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/profiles":
+			if profileJSON == "" {
+				return jsonResponse(http.StatusOK, `{"data":[]}`)
+			}
+			return jsonResponse(http.StatusOK, `{"data":[`+profileJSON+`]}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/bundleIds":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"bundleIds","id":"BUNDLE1","attributes":{"identifier":"com.example.app"}}]}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/profiles":
+			creates++
+			profileJSON = `{"type":"profiles","id":"PROFILE1","attributes":{"name":"asc","profileType":"IOS_APP_STORE","profileState":"ACTIVE","profileContent":"` + testProvisioningProfile(t, "UUID-1", "com.example.app", certDER) + `"}}`
+			return jsonResponse(http.StatusCreated, `{"data":`+profileJSON+`}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+
+	args := []string{"--repo", repo, "--bundle-id", "com.example.app", "--profile-type", "IOS_APP_STORE", "--output-dir", outputDir}
+	first := runSigningSync(t, args...)
+	if first.CertificateID != "CERT1" || first.CertificateStatus != asc.SigningSyncStatusCreated || !first.Committed {
+		t.Fatalf("unexpected first sync: %+v", first)
+	}
+	if len(first.Profiles) != 1 || first.Profiles[0].Status != asc.SigningSyncStatusCreated || first.Profiles[0].UUID != "UUID-1" {
+		t.Fatalf("unexpected profiles: %+v", first.Profiles)
+	}
+	log, err := exec.Command("git", "-C", repo, "log", "--oneline").Output()
+	if err != nil || !strings.Contains(string(log), "asc signing sync: IOS_APP_STORE com.example.app") {
+		t.Fatalf("expected a store commit, got %q (%v)", log, err)
+	}
+
+	second := runSigningSync(t, args...)
+	if second.CertificateStatus != asc.SigningSyncStatusCurrent || second.Profiles[0].Status != asc.SigningSyncStatusCurrent || second.Committed || creates != 2 {
+		t.Fatalf("expected an unchanged second sync, got %+v after %d creates", second, creates)
+	}
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("read-only sync must not call the API: %s %s", req.Method, req.URL.String())
+		return nil, nil
+	})
+	readonly := runSigningSync(t, append(args, "--readonly")...)
+	if !readonly.Readonly || readonly.CertificateID != "CERT1" || readonly.Profiles[0].UUID != "UUID-1" {
+		t.Fatalf("unexpected read-only sync: %+v", readonly)
+	}
+	exported, err := os.ReadFile(readonly.CertificateFile)
+	if err != nil {
+		t.Fatalf("expected exported certificate: %v", err)
+	}
+	if _, err := x509.ParseCertificate(exported); err != nil {
+		t.Fatalf("exported certificate is invalid: %v", err)
+	}
+	if _, err := os.Stat(readonly.Profiles[0].ProfileFile); err != nil {
+		t.Fatalf("expected exported profile: %v", err)
+	}
+
+	// A revoked certificate stays in the store until its replacement exists.
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/certificates":
+			return jsonResponse(http.StatusOK, `{"data":[]}`)
+		case req.Method == http.MethodPost && req.URL.Path == "/v1/certificates":
+			return jsonResponse(http.StatusConflict, `{"errors":[{"status":"409","code":"ENTITY_ERROR","title":"Certificate limit reached"}]}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse(append([]string{"signing", "sync"}, args...)); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err == nil {
+			t.Fatal("expected the failed renewal to fail the sync")
+		}
+	})
+	for _, name := range []string{"CERT1.cer.enc", "CERT1.key.enc"} {
+		if _, err := os.Stat(filepath.Join(repo, "certs", "IOS_DISTRIBUTION", name)); err != nil {
+			t.Fatalf("expected %s to survive a failed renewal: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/cmd"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// setupSimulatedUploadTransport serves the GET requests issued during a planned
+// upload and fails the test on anything that would change state.
+func setupSimulatedUploadTransport(t *testing.T) string {
+	t.Helper()
+	setupAuth(t)
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(home, "nonexistent.json"))
+
+	ipaPath := filepath.Join(t.TempDir(), "app.ipa")
+	if err := os.WriteFile(ipaPath, []byte("abcdefghij"), 0o600); err != nil {
+		t.Fatalf("write ipa: %v", err)
+	}
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("unexpected mutating request: %s %s", req.Method, req.URL.String())
+		}
+		switch req.URL.Path {
+		case "/v1/apps/123456789/betaGroups":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"betaGroups","id":"GROUP_1","attributes":{"name":"Internal"}}],"links":{}}`)
+		case "/v1/preReleaseVersions":
+			return jsonResponse(http.StatusOK, `{"data":[],"links":{}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+	return ipaPath
+}
+
+func runSimulated(t *testing.T, args []string) asc.SimulationReport {
+	t.Helper()
+	stdout, stderr := captureOutput(t, func() {
+		if code := cmd.Run(append([]string{"--no-update", "--simulate"}, args...), "1.2.3"); code != cmd.ExitSuccess {
+			t.Errorf("expected exit code %d, got %d", cmd.ExitSuccess, code)
+		}
+	})
+	var report asc.SimulationReport
+	if err := json.Unmarshal([]byte(stderr), &report); err != nil {
+		t.Fatalf("expected JSON plan on stderr, got stdout %q stderr %q: %v", stdout, stderr, err)
+	}
+	return report
+}
+
+func simulatedSteps(report asc.SimulationReport) string {
+	steps := make([]string, 0, len(report.Operations))
+	for _, op := range report.Operations {
+		steps = append(steps, op.Method+" "+op.Path)
+	}
+	return strings.Join(steps, "\n")
+}
+
+func TestSimulateBuildsUploadRecordsUploadAndCommit(t *testing.T) {
+	ipaPath := setupSimulatedUploadTransport(t)
+
+	report := runSimulated(t, []string{"builds", "upload", "--app", "123456789", "--ipa", ipaPath, "--version", "1.0.0", "--build-number", "7"})
+	want := strings.Join([]string{
+		"POST /v1/buildUploads",
+		"POST /v1/buildUploadFiles",
+		"PUT https://simulated.invalid/uploads/simulated-2",
+		"PATCH /v1/buildUploadFiles/simulated-2",
+	}, "\n")
+	if got := simulatedSteps(report); got != want {
+		t.Fatalf("unexpected plan:\n%s\nwant:\n%s", got, want)
+	}
+	if report.Operations[2].Bytes != 10 {
+		t.Fatalf("expected planned upload to cover the file, got %+v", report.Operations[2])
+	}
+
+	journalDir, err := asc.DefaultUploadJournalDir()
+	if err != nil {
+		t.Fatalf("journal dir: %v", err)
+	}
+	if entries, _ := os.ReadDir(journalDir); len(entries) != 0 {
+		t.Fatalf("expected no upload journal in plan mode, found %d entries", len(entries))
+	}
+}
+
+func TestSimulatePublishTestFlightRecordsGroupAssignment(t *testing.T) {
+	ipaPath := setupSimulatedUploadTransport(t)
+
+	report := runSimulated(t, []string{"publish", "testflight", "--app", "123456789", "--ipa", ipaPath, "--version", "1.0.0", "--build-number", "7", "--group", "Internal", "--wait"})
+	want := strings.Join([]string{
+		"POST /v1/buildUploads",
+		"POST /v1/buildUploadFiles",
+		"PUT https://simulated.invalid/uploads/simulated-2",
+		"PATCH /v1/buildUploadFiles/simulated-2",
+		"POST /v1/builds/simulated-build/relationships/betaGroups",
+	}, "\n")
+	if got := simulatedSteps(report); got != want {
+		t.Fatalf("unexpected plan:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestSimulateDevicesExportListsFileWithoutWriting(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	file := filepath.Join(t.TempDir(), "export", "devices.txt")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/devices" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		return jsonResponse(http.StatusOK, `{"data":[{"type":"devices","id":"D1","attributes":{"name":"QA iPhone","udid":"00008030-001A35E11A68802E","platform":"IOS"}}]}`)
+	})
+
+	report := runSimulated(t, []string{"devices", "export", "--file", file})
+
+	if got, want := simulatedSteps(report), "WRITE "+file; got != want {
+		t.Fatalf("unexpected plan:\n%s\nwant:\n%s", got, want)
+	}
+	if _, err := os.Stat(filepath.Dir(file)); !os.IsNotExist(err) {
+		t.Fatalf("expected no export directory, stat err: %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func setupTestFlightSyncPush(t *testing.T) (string, *[]string) {
+	t.Helper()
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	file := filepath.Join(t.TempDir(), "testflight.yaml")
+	content := "app:\n  id: APP_ID\ngroups:\n  - id: group-1\n    name: Alpha\n    isInternalGroup: true\n    feedbackEnabled: true\n"
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	var mutations []string
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch {
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/APP_ID":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"apps","id":"APP_ID","attributes":{"name":"Demo"}}}`)
+		case req.Method == http.MethodGet && req.URL.Path == "/v1/apps/APP_ID/betaGroups":
+			return jsonResponse(http.StatusOK, `{"data":[
+				{"type":"betaGroups","id":"group-1","attributes":{"name":"Alpha","isInternalGroup":true,"feedbackEnabled":true}},
+				{"type":"betaGroups","id":"group-2","attributes":{"name":"Internal Testers","isInternalGroup":true}}
+			]}`)
+		case req.Method == http.MethodDelete && req.URL.Path == "/v1/betaGroups/group-2":
+			mutations = append(mutations, req.Method+" "+req.URL.Path)
+			return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}, nil
+		default:
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+			return nil, nil
+		}
+	})
+	return file, &mutations
+}
+
+func TestTestFlightSyncPushPruneRequiresConfirm(t *testing.T) {
+	file, mutations := setupTestFlightSyncPush(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	_, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "sync", "push", "--app", "APP_ID", "--file", file, "--prune"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+			t.Fatalf("expected flag.ErrHelp, got %v", err)
+		}
+	})
+	if !strings.Contains(stderr, "--confirm is required to apply 1 deletion(s) or removal(s)") {
+		t.Fatalf("expected confirm error, got %q", stderr)
+	}
+	if len(*mutations) != 0 {
+		t.Fatalf("expected no changes without --confirm, got %v", *mutations)
+	}
+}
+
+func TestTestFlightSyncPushDryRunPruneDoesNotRequireConfirm(t *testing.T) {
+	file, mutations := setupTestFlightSyncPush(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "sync", "push", "--app", "APP_ID", "--file", file, "--prune", "--dry-run"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	if !strings.Contains(stdout, `"action":"delete"`) || len(*mutations) != 0 {
+		t.Fatalf("expected planned delete without changes, got %q and %v", stdout, *mutations)
+	}
+}
+
+func TestTestFlightSyncPushCSVOutput(t *testing.T) {
+	file, mutations := setupTestFlightSyncPush(t)
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"testflight", "sync", "push", "--app", "APP_ID", "--file", file, "--prune", "--confirm", "--output", "csv"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+	want := "Action,Resource,Group,Target,Details\ndelete,betaGroup,Internal Testers,,\n"
+	if stdout != want {
+		t.Fatalf("unexpected csv output %q, want %q", stdout, want)
+	}
+	if strings.Join(*mutations, ",") != "DELETE /v1/betaGroups/group-2" {
+		t.Fatalf("unexpected changes: %v", *mutations)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -276,3 +277,167 @@ func TestValidateMixedWarningAndError(t *testing.T) {
 		}
 	})
 }
+
+func TestValidateCustomRulesFile(t *testing.T) {
+	fixture := validValidateFixture()
+	client := newValidateTestClient(t, fixture)
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	rulesPath := filepath.Join(t.TempDir(), "validate.yaml")
+	rules := `rules:
+  - id: no-notes
+    type: banned_words
+    severity: warning
+    fields: [whatsNew]
+    words: [notes]
+    remediation: Describe the changes instead
+  - id: iphone-screenshots
+    type: min_screenshots
+    display_types: [APP_IPHONE_65]
+    min: 2
+`
+	if err := os.WriteFile(rulesPath, []byte(rules), 0o600); err != nil {
+		t.Fatalf("write rules: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1", "--rules", rulesPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if _, ok := errors.AsType[ReportedError](runErr); !ok {
+		t.Fatalf("expected ReportedError, got %v", runErr)
+	}
+
+	var report validation.Report
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v", err)
+	}
+	if report.Summary.Errors != 1 || report.Summary.Warnings != 1 {
+		t.Fatalf("expected 1 error and 1 warning, got %+v", report.Summary)
+	}
+	for _, check := range report.Checks {
+		if check.ID == "custom.no-notes" && check.Remediation != "Describe the changes instead" {
+			t.Fatalf("expected custom remediation, got %+v", check)
+		}
+	}
+}
+
+func TestValidateRejectsInvalidRulesFile(t *testing.T) {
+	rulesPath := filepath.Join(t.TempDir(), "validate.yaml")
+	if err := os.WriteFile(rulesPath, []byte("rules:\n  - id: x\n    type: nope\n"), 0o600); err != nil {
+		t.Fatalf("write rules: %v", err)
+	}
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	_, _ = captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "--app", "app-1", "--version-id", "ver-1", "--rules", rulesPath}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), `unknown type "nope"`) {
+		t.Fatalf("expected unknown type error, got %v", runErr)
+	}
+}
+
+func newRoutedValidateClient(t *testing.T, routes map[string]string) *asc.Client {
+	t.Helper()
+
+	keyPath := filepath.Join(t.TempDir(), "key.p8")
+	writeECDSAPEM(t, keyPath)
+
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if body, ok := routes[req.URL.Path]; ok && req.Method == http.MethodGet {
+			return jsonResponse(http.StatusOK, body)
+		}
+		return jsonResponse(http.StatusNotFound, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not Found"}]}`)
+	})
+	client, err := asc.NewClientWithHTTPClient("KEY123", "ISS456", keyPath, &http.Client{Transport: transport})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return client
+}
+
+func TestValidateIAPReportsMissingPieces(t *testing.T) {
+	t.Setenv("ASC_MAX_RETRIES", "0")
+	client := newRoutedValidateClient(t, map[string]string{
+		"/v1/apps/app-1":                                       `{"data":{"type":"apps","id":"app-1","attributes":{"primaryLocale":"en-US"}}}`,
+		"/v1/apps/app-1/inAppPurchasesV2":                      `{"data":[{"type":"inAppPurchases","id":"iap-1","attributes":{"name":"Coins","productId":"com.example.coins","inAppPurchaseType":"CONSUMABLE"}}]}`,
+		"/v2/inAppPurchases/iap-1/inAppPurchaseLocalizations":  `{"data":[{"type":"inAppPurchaseLocalizations","id":"loc-1","attributes":{"locale":"en-US","name":"Coins","description":"Coins"}}]}`,
+		"/v2/inAppPurchases/iap-1/iapPriceSchedule":            `{"data":{"type":"inAppPurchasePriceSchedules","id":"sched-1"}}`,
+		"/v1/inAppPurchasePriceSchedules/sched-1/manualPrices": `{"data":[{"type":"inAppPurchasePrices","id":"price-1"}]}`,
+	})
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "iap", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+	if _, ok := errors.AsType[ReportedError](runErr); !ok {
+		t.Fatalf("expected ReportedError, got %v", runErr)
+	}
+
+	var report validation.ProductReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v\n%s", err, stdout)
+	}
+	ids := map[string]bool{}
+	for _, check := range report.Checks {
+		ids[check.ID] = true
+	}
+	if len(report.Checks) != 2 || !ids["iap.review_screenshot.missing"] || !ids["iap.availability.missing"] {
+		t.Fatalf("unexpected checks %+v", report.Checks)
+	}
+}
+
+func TestValidateSubscriptionsPassesCompleteSubscription(t *testing.T) {
+	client := newRoutedValidateClient(t, map[string]string{
+		"/v1/apps/app-1":                    `{"data":{"type":"apps","id":"app-1","attributes":{"primaryLocale":"en-US"}}}`,
+		"/v1/apps/app-1/subscriptionGroups": `{"data":[{"type":"subscriptionGroups","id":"group-1","attributes":{"referenceName":"Premium"}}]}`,
+		"/v1/subscriptionGroups/group-1/subscriptionGroupLocalizations": `{"data":[{"type":"subscriptionGroupLocalizations","id":"gloc-1","attributes":{"locale":"en-US","name":"Premium"}}]}`,
+		"/v1/subscriptionGroups/group-1/subscriptions":                  `{"data":[{"type":"subscriptions","id":"sub-1","attributes":{"name":"Monthly","productId":"com.example.monthly","subscriptionPeriod":"ONE_MONTH"}}]}`,
+		"/v1/subscriptions/sub-1/subscriptionLocalizations":             `{"data":[{"type":"subscriptionLocalizations","id":"loc-1","attributes":{"locale":"en-US","name":"Monthly","description":"Monthly access"}}]}`,
+		"/v1/subscriptions/sub-1/appStoreReviewScreenshot":              `{"data":{"type":"subscriptionAppStoreReviewScreenshots","id":"shot-1"}}`,
+		"/v1/subscriptions/sub-1/prices":                                `{"data":[{"type":"subscriptionPrices","id":"price-1"}]}`,
+		"/v1/subscriptions/sub-1/subscriptionAvailability":              `{"data":{"type":"subscriptionAvailabilities","id":"avail-1"}}`,
+		"/v1/subscriptionAvailabilities/avail-1/availableTerritories":   `{"data":[{"type":"territories","id":"USA"}]}`,
+	})
+	restore := validate.SetClientFactory(func() (*asc.Client, error) {
+		return client, nil
+	})
+	defer restore()
+
+	root := RootCommand("1.2.3")
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"validate", "subscriptions", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var report validation.ProductReport
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse JSON output: %v\n%s", err, stdout)
+	}
+	if report.Scope != validation.ScopeSubscriptions || len(report.Checks) != 0 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+}
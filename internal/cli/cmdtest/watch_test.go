@@ -0,0 +1,119 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWatchEmitsBuildTransitionsAcrossRuns(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	statePath := filepath.Join(t.TempDir(), "watch.json")
+
+	processingState := "PROCESSING"
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet || req.URL.Path != "/v1/builds" {
+			t.Fatalf("unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		if req.URL.Query().Get("filter[app]") != "app-1" {
+			t.Fatalf("expected filter[app]=app-1, got %q", req.URL.Query().Get("filter[app]"))
+		}
+		body := `{"data":[{"type":"builds","id":"build-1","attributes":{"version":"42","processingState":"` + processingState + `"}}]}`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+		}, nil
+	})
+
+	runWatch := func() string {
+		root := RootCommand("1.2.3")
+		root.FlagSet.SetOutput(io.Discard)
+		stdout, _ := captureOutput(t, func() {
+			if err := root.Parse([]string{"watch", "--app", "app-1", "--track", "builds", "--state-file", statePath}); err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if err := root.Run(context.Background()); err != nil {
+				t.Fatalf("run error: %v", err)
+			}
+		})
+		return stdout
+	}
+
+	if stdout := runWatch(); stdout != "" {
+		t.Fatalf("expected first run to seed state silently, got %q", stdout)
+	}
+	if stdout := runWatch(); stdout != "" {
+		t.Fatalf("expected no events without changes, got %q", stdout)
+	}
+
+	processingState = "VALID"
+	stdout := runWatch()
+	var event struct {
+		AppID string `json:"appId"`
+		Track string `json:"track"`
+		ID    string `json:"id"`
+		Label string `json:"label"`
+		From  string `json:"from"`
+		To    string `json:"to"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &event); err != nil {
+		t.Fatalf("unmarshal event: %v\nstdout: %s", err, stdout)
+	}
+	if event.AppID != "app-1" || event.Track != "builds" || event.ID != "build-1" || event.Label != "build 42" {
+		t.Fatalf("unexpected event identity: %+v", event)
+	}
+	if event.From != "PROCESSING" || event.To != "VALID" {
+		t.Fatalf("expected PROCESSING -> VALID, got %+v", event)
+	}
+}
+
+func TestWatchValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "missing app", args: []string{"watch"}, wantErr: "--app is required"},
+		{name: "unknown track", args: []string{"watch", "--app", "app-1", "--track", "apps"}, wantErr: "--track must be one of"},
+		{name: "limit out of range", args: []string{"watch", "--app", "app-1", "--limit", "0"}, wantErr: "--limit must be between 1 and 200"},
+		{name: "negative interval", args: []string{"watch", "--app", "app-1", "--interval", "-1s"}, wantErr: "--interval must not be negative"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
@@ -94,6 +94,15 @@ _asc_completions() {
     COMPREPLY=( $(compgen -W "%s" -- "$cur") )
     return 0
   fi
+
+  if [[ "${COMP_WORDS[1]}" == "api" ]]; then
+    if [[ $COMP_CWORD -eq 2 ]]; then
+      COMPREPLY=( $(compgen -W "GET POST PATCH PUT DELETE endpoints" -- "$cur") )
+    elif [[ $COMP_CWORD -eq 3 && "${COMP_WORDS[2]}" != "endpoints" ]]; then
+      COMPREPLY=( $(asc api endpoints --method "${COMP_WORDS[2]}" --paths "$cur" 2>/dev/null) )
+    fi
+    return 0
+  fi
 }
 
 complete -F _asc_completions asc
@@ -108,12 +117,26 @@ func zshScript(subcommands []string) string {
 _arguments \
   '1:command:(%s)' \
   '*::arg:->args'
+
+case $state in
+  args)
+    if [[ $words[1] == api ]]; then
+      if (( CURRENT == 2 )); then
+        compadd -- GET POST PATCH PUT DELETE endpoints
+      elif (( CURRENT == 3 )) && [[ $words[2] != endpoints ]]; then
+        compadd -- ${(f)"$(asc api endpoints --method $words[2] --paths $PREFIX 2>/dev/null)"}
+      fi
+    fi
+    ;;
+esac
 `, words)
 }
 
 func fishScript(subcommands []string) string {
 	words := strings.Join(subcommands, " ")
 	return fmt.Sprintf(`# fish completion for asc
-complete -c asc -f -a '%s'
+complete -c asc -f -n '__fish_use_subcommand' -a '%s'
+complete -c asc -f -n '__fish_seen_subcommand_from api; and test (count (commandline -opc)) -eq 2' -a 'GET POST PATCH PUT DELETE endpoints'
+complete -c asc -f -n '__fish_seen_subcommand_from api; and test (count (commandline -opc)) -eq 3' -a '(asc api endpoints --method (commandline -opc)[3] --paths (commandline -ct) 2>/dev/null)'
 `, words)
 }
@@ -0,0 +1,185 @@
+package dev
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/auth"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/mockserver"
+)
+
+const (
+	defaultMockServerAddr   = "127.0.0.1:8787"
+	mockServerShutdownGrace = 5 * time.Second
+)
+
+// DevCommand returns the dev command group.
+func DevCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("dev", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "dev",
+		ShortUsage: "asc dev <subcommand> [flags]",
+		ShortHelp:  "Local development tools.",
+		LongHelp: `Local development tools.
+
+Examples:
+  asc dev mock-server
+  asc dev mock-server --addr 127.0.0.1:9000 --data-dir ./.asc/mock`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			MockServerCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// MockServerCommand returns the dev mock-server subcommand.
+func MockServerCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("dev mock-server", flag.ExitOnError)
+
+	addr := fs.String("addr", defaultMockServerAddr, "Address to listen on (host:port)")
+	dataDir := fs.String("data-dir", "", "Directory for uploaded files (default: temporary directory removed on exit)")
+	seedPath := fs.String("seed", "", "JSON file of resources to preload ({\"data\": [...]}); defaults to a sample app")
+	verifyKey := fs.String("verify-key", "", "Only accept JWTs signed by this .p8 private key")
+
+	return &ffcli.Command{
+		Name:       "mock-server",
+		ShortUsage: "asc dev mock-server [flags]",
+		ShortHelp:  "Serve an offline, in-memory App Store Connect API.",
+		LongHelp: `Serve an offline, in-memory App Store Connect API.
+
+The mock covers apps, builds (including build uploads), beta groups, beta
+testers, app store versions, localizations, and review submissions. State is
+kept in memory and lost on exit. Any well-formed ES256 JWT is accepted unless
+--verify-key is set, so existing credentials work unchanged.
+
+Resources created with a fileSize attribute get upload operations that write
+to --data-dir. Completing a build upload file creates a processed build.
+
+Point the CLI at the mock with ASC_BASE_URL:
+
+Examples:
+  asc dev mock-server
+  ASC_BASE_URL=http://127.0.0.1:8787 asc apps list
+  asc dev mock-server --addr 127.0.0.1:9000 --seed fixtures.json
+  asc dev mock-server --verify-key ./AuthKey_ABC123.p8 --data-dir ./.asc/mock`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
+			}
+			listenAddr := strings.TrimSpace(*addr)
+			if listenAddr == "" {
+				return shared.UsageError("--addr is required")
+			}
+
+			opts := mockserver.Options{Seed: mockserver.DefaultSeed()}
+			if path := strings.TrimSpace(*seedPath); path != "" {
+				seed, err := mockserver.LoadSeedFile(path)
+				if err != nil {
+					return fmt.Errorf("dev mock-server: %w", err)
+				}
+				opts.Seed = seed
+			}
+			if path := strings.TrimSpace(*verifyKey); path != "" {
+				key, err := auth.LoadPrivateKey(path)
+				if err != nil {
+					return fmt.Errorf("dev mock-server: %w", err)
+				}
+				opts.VerifyKey = &key.PublicKey
+			}
+			opts.DataDir = strings.TrimSpace(*dataDir)
+			if opts.DataDir == "" {
+				tempDir, err := os.MkdirTemp("", "asc-mock-server-*")
+				if err != nil {
+					return fmt.Errorf("dev mock-server: create data directory: %w", err)
+				}
+				defer os.RemoveAll(tempDir)
+				opts.DataDir = tempDir
+			}
+
+			server, err := mockserver.New(opts)
+			if err != nil {
+				return fmt.Errorf("dev mock-server: %w", err)
+			}
+
+			listener, err := net.Listen("tcp", listenAddr)
+			if err != nil {
+				return fmt.Errorf("dev mock-server: %w", err)
+			}
+			baseURL := "http://" + listener.Addr().String()
+			fmt.Fprintf(os.Stderr, "Mock App Store Connect API listening on %s\n", baseURL)
+			fmt.Fprintf(os.Stderr, "Uploads are stored in %s\n", server.DataDir())
+			fmt.Fprintf(os.Stderr, "Use it with: export ASC_BASE_URL=%s\n", baseURL)
+
+			return serveMock(ctx, listener, logRequests(server))
+		},
+	}
+}
+
+// serveMock serves until ctx is canceled or the process is interrupted.
+func serveMock(ctx context.Context, listener net.Listener, handler http.Handler) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	httpServer := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- httpServer.Serve(listener)
+	}()
+
+	select {
+	case err := <-errCh:
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("dev mock-server: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), mockServerShutdownGrace)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("dev mock-server: shutdown: %w", err)
+	}
+	return nil
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// logRequests writes one line per request to stderr.
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+		fmt.Fprintf(os.Stderr, "%s %s %d\n", r.Method, r.URL.RequestURI(), recorder.status)
+	})
+}
@@ -0,0 +1,481 @@
+package devices
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Device list file formats. tsv is the multiple-device upload format of the
+// Apple Developer website.
+const (
+	deviceFileFormatTSV = "tsv"
+	deviceFileFormatCSV = "csv"
+)
+
+// annualDeviceLimit is how many devices of each kind (iPhone, iPad, Mac, ...)
+// a team may register per membership year. Disabled devices keep counting
+// until the year renews.
+const annualDeviceLimit = 100
+
+var (
+	legacyUDIDPattern = regexp.MustCompile(`^[0-9A-Fa-f]{40}$`)
+	modernUDIDPattern = regexp.MustCompile(`^[0-9A-Fa-f]{8}-[0-9A-Fa-f]{16}$`)
+	macUUIDPattern    = regexp.MustCompile(`^[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$`)
+)
+
+// deviceFileHeader is the header row Apple's upload format starts with.
+var deviceFileHeader = []string{"Device ID", "Device Name", "Device Platform"}
+
+// platformDeviceClasses lists the device kinds that count toward the annual
+// limits of each platform.
+var platformDeviceClasses = map[string][]asc.DeviceClass{
+	"IOS":       {asc.DeviceClassIPhone, asc.DeviceClassIPad, asc.DeviceClassIPod, asc.DeviceClassAppleWatch},
+	"TV_OS":     {asc.DeviceClassAppleTV},
+	"MAC_OS":    {asc.DeviceClassMac},
+	"VISION_OS": {asc.DeviceClassAppleVisionPro},
+}
+
+// DevicesImportCommand returns the devices import subcommand.
+func DevicesImportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+
+	file := fs.String("file", "", "Device list file (required)")
+	format := fs.String("format", "", "File format: tsv (Apple's upload format), csv (default: from the file extension)")
+	platform := fs.String("platform", "IOS", "Platform for rows without one: "+strings.Join(devicePlatformList(), ", "))
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "import",
+		ShortUsage: "asc devices import --file devices.txt [flags]",
+		ShortHelp:  "Register devices in bulk from a device list file.",
+		LongHelp: `Register devices in bulk from a device list file.
+
+Reads Apple's multiple-device upload format (tab-separated Device ID, Device
+Name, and optional Device Platform columns: ios, mac, tvos, visionos) or the
+same columns as CSV. A header row is optional.
+
+Every row is checked before anything is registered: UDIDs must match their
+platform's format, duplicate rows are skipped, and UDIDs that are already
+registered are reported as exists. Apple allows 100 devices of each kind per
+membership year (disabled devices still count). Rows for a platform with no
+slots left in any of its kinds are reported as over-limit. The file does not
+say whether an ios row is an iPhone, iPad, iPod, or Apple Watch, so those rows
+are only checked against all four; a row of a kind that is full fails when it
+is registered. The command exits non-zero when any row is invalid, over the
+limit, or fails to register.
+
+Examples:
+  asc devices import --file devices.txt
+  asc devices import --file devices.csv --output table
+  asc devices import --file macs.txt --platform MAC_OS`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			path := strings.TrimSpace(*file)
+			if path == "" {
+				return shared.UsageError("--file is required")
+			}
+			formatValue, err := deviceFileFormat(*format, path)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			defaultPlatform, err := normalizeDevicePlatform(*platform)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("devices import: %w", err)
+			}
+			rows, err := parseDeviceFile(data, formatValue, defaultPlatform)
+			if err != nil {
+				return fmt.Errorf("devices import: %s: %w", path, err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("devices import: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			existing, err := listAllDevices(requestCtx, client, nil)
+			cancel()
+			if err != nil {
+				return fmt.Errorf("devices import: %w", err)
+			}
+
+			result := &asc.DeviceImportResult{File: path, Format: formatValue, Rows: rows}
+			planDeviceImport(result.Rows, existing)
+			for i := range result.Rows {
+				row := &result.Rows[i]
+				if row.Status != "" {
+					continue
+				}
+				createCtx, cancel := shared.ContextWithTimeout(ctx)
+				device, err := client.CreateDevice(createCtx, asc.DeviceCreateAttributes{
+					Name:     row.Name,
+					UDID:     row.UDID,
+					Platform: asc.DevicePlatform(row.Platform),
+				})
+				cancel()
+				if err != nil {
+					row.Status = asc.DeviceImportStatusFailed
+					row.Message = err.Error()
+					continue
+				}
+				row.Status = asc.DeviceImportStatusRegistered
+				row.DeviceID = device.Data.ID
+			}
+
+			for _, row := range result.Rows {
+				switch row.Status {
+				case asc.DeviceImportStatusRegistered:
+					result.Registered++
+				case asc.DeviceImportStatusExists, asc.DeviceImportStatusDuplicate:
+					result.Skipped++
+				default:
+					result.Failed++
+				}
+			}
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return shared.NewReportedError(fmt.Errorf("devices import: %d of %d row(s) were not registered", result.Failed, len(result.Rows)))
+			}
+			return nil
+		},
+	}
+}
+
+// DevicesExportCommand returns the devices export subcommand.
+func DevicesExportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+
+	file := fs.String("file", "", "Output file (required; must not exist)")
+	format := fs.String("format", "", "File format: tsv (Apple's upload format), csv (default: from the file extension)")
+	platform := fs.String("platform", "", "Filter by platform(s), comma-separated: "+strings.Join(devicePlatformList(), ", "))
+	status := fs.String("status", "", "Filter by status: ENABLED, DISABLED")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "asc devices export --file devices.txt [flags]",
+		ShortHelp:  "Write registered devices to a device list file.",
+		LongHelp: `Write registered devices to a device list file.
+
+Writes Apple's multiple-device upload format (or CSV with the same columns),
+which asc devices import and the Apple Developer website both accept.
+
+Examples:
+  asc devices export --file devices.txt
+  asc devices export --file devices.csv --status ENABLED
+  asc devices export --file macs.txt --platform MAC_OS`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			path := strings.TrimSpace(*file)
+			if path == "" {
+				return shared.UsageError("--file is required")
+			}
+			formatValue, err := deviceFileFormat(*format, path)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			platformValues, err := normalizeDevicePlatforms(shared.SplitCSV(*platform))
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			statusValue, err := normalizeDeviceStatus(*status)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("devices export: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			var opts []asc.DevicesOption
+			if len(platformValues) > 0 {
+				opts = append(opts, asc.WithDevicesPlatforms(platformValues))
+			}
+			if statusValue != "" {
+				opts = append(opts, asc.WithDevicesStatus(statusValue))
+			}
+			devices, err := listAllDevices(requestCtx, client, opts)
+			if err != nil {
+				return fmt.Errorf("devices export: %w", err)
+			}
+
+			data, err := formatDeviceFile(devices, formatValue)
+			if err != nil {
+				return fmt.Errorf("devices export: %w", err)
+			}
+			if err := writeDeviceFile(path, data); err != nil {
+				return fmt.Errorf("devices export: %w", err)
+			}
+
+			return shared.PrintOutput(&asc.DeviceExportResult{File: path, Format: formatValue, Devices: len(devices)}, *output, *pretty)
+		},
+	}
+}
+
+func deviceFileFormat(value, path string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "":
+		if strings.EqualFold(filepath.Ext(path), ".csv") {
+			return deviceFileFormatCSV, nil
+		}
+		return deviceFileFormatTSV, nil
+	case deviceFileFormatTSV:
+		return deviceFileFormatTSV, nil
+	case deviceFileFormatCSV:
+		return deviceFileFormatCSV, nil
+	default:
+		return "", fmt.Errorf("--format must be one of: tsv, csv")
+	}
+}
+
+// parseDeviceFile reads the rows of a device list. Rows that fail validation
+// are returned with the invalid status; the rest have no status yet.
+func parseDeviceFile(data []byte, format, defaultPlatform string) ([]asc.DeviceImportRow, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	if format == deviceFileFormatTSV {
+		reader.Comma = '\t'
+		reader.LazyQuotes = true
+	}
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+
+	var rows []asc.DeviceImportRow
+	first := true
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		if first {
+			first = false
+			if header := strings.TrimSpace(record[0]); strings.EqualFold(header, deviceFileHeader[0]) || strings.EqualFold(header, "udid") {
+				continue
+			}
+		}
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+		rows = append(rows, parseDeviceRecord(line, record, defaultPlatform))
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("no devices found")
+	}
+	return rows, nil
+}
+
+func parseDeviceRecord(line int, record []string, defaultPlatform string) asc.DeviceImportRow {
+	field := func(i int) string {
+		if i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	row := asc.DeviceImportRow{Line: line, UDID: field(0), Name: field(1), Platform: defaultPlatform}
+	invalid := func(message string) asc.DeviceImportRow {
+		row.Status = asc.DeviceImportStatusInvalid
+		row.Message = message
+		return row
+	}
+	if value := field(2); value != "" {
+		platform, ok := parseFilePlatform(value)
+		if !ok {
+			return invalid(fmt.Sprintf("unknown platform %q", value))
+		}
+		row.Platform = platform
+	}
+	switch {
+	case row.UDID == "":
+		return invalid("device ID is required")
+	case row.Name == "":
+		return invalid("device name is required")
+	case !validUDID(row.UDID, row.Platform):
+		return invalid(fmt.Sprintf("%q is not a valid %s device ID", row.UDID, row.Platform))
+	}
+	return row
+}
+
+// parseFilePlatform maps the platform column (ios, mac, ...) to an API
+// platform. API values such as MAC_OS are accepted too.
+func parseFilePlatform(value string) (string, bool) {
+	switch strings.ToLower(value) {
+	case "ios":
+		return "IOS", true
+	case "mac", "macos":
+		return "MAC_OS", true
+	case "tvos":
+		return "TV_OS", true
+	case "visionos":
+		return "VISION_OS", true
+	}
+	platform, err := normalizeDevicePlatform(value)
+	return platform, err == nil
+}
+
+// filePlatform is the inverse of parseFilePlatform.
+func filePlatform(platform string) string {
+	switch platform {
+	case "MAC_OS":
+		return "mac"
+	case "TV_OS":
+		return "tvos"
+	case "VISION_OS":
+		return "visionos"
+	default:
+		return "ios"
+	}
+}
+
+// validUDID reports whether udid has a format the platform uses: 40 hex
+// digits or 8-16 hex digits for iPhone, iPad, and Apple TV; 8-16 hex digits
+// for Apple Vision Pro; and a hardware UUID or 8-16 hex digits for Macs.
+func validUDID(udid, platform string) bool {
+	switch platform {
+	case "MAC_OS":
+		return macUUIDPattern.MatchString(udid) || modernUDIDPattern.MatchString(udid)
+	case "VISION_OS":
+		return modernUDIDPattern.MatchString(udid)
+	default:
+		return legacyUDIDPattern.MatchString(udid) || modernUDIDPattern.MatchString(udid)
+	}
+}
+
+// planDeviceImport marks duplicate, already registered, and over-limit rows.
+// Rows left without a status are the ones to register.
+//
+// Slots are counted per device class. A file row does not say which class it
+// is, so a platform with several classes (iOS) is only known to be full once
+// every class is; otherwise App Store Connect rejects rows of a full class
+// when they are registered.
+func planDeviceImport(rows []asc.DeviceImportRow, existing []asc.Resource[asc.DeviceAttributes]) {
+	registered := make(map[string]string, len(existing))
+	perClass := map[asc.DeviceClass]int{}
+	for _, device := range existing {
+		registered[strings.ToUpper(device.Attributes.UDID)] = device.ID
+		perClass[device.Attributes.DeviceClass]++
+	}
+	remaining := map[asc.DeviceClass]int{}
+	for _, classes := range platformDeviceClasses {
+		for _, class := range classes {
+			remaining[class] = max(0, annualDeviceLimit-perClass[class])
+		}
+	}
+
+	seen := map[string]int{}
+	for i := range rows {
+		row := &rows[i]
+		if row.Status != "" {
+			continue
+		}
+		key := strings.ToUpper(row.UDID)
+		if line, ok := seen[key]; ok {
+			row.Status = asc.DeviceImportStatusDuplicate
+			row.Message = fmt.Sprintf("same device ID as line %d", line)
+			continue
+		}
+		seen[key] = row.Line
+		if id, ok := registered[key]; ok {
+			row.Status = asc.DeviceImportStatusExists
+			row.DeviceID = id
+			continue
+		}
+		classes := platformDeviceClasses[row.Platform]
+		if !slices.ContainsFunc(classes, func(class asc.DeviceClass) bool { return remaining[class] > 0 }) {
+			row.Status = asc.DeviceImportStatusOverLimit
+			row.Message = fmt.Sprintf("no %s device slots left this membership year", row.Platform)
+			continue
+		}
+		if len(classes) == 1 {
+			remaining[classes[0]]--
+		}
+	}
+}
+
+func listAllDevices(ctx context.Context, client *asc.Client, opts []asc.DevicesOption) ([]asc.Resource[asc.DeviceAttributes], error) {
+	var devices []asc.Resource[asc.DeviceAttributes]
+	next := ""
+	for {
+		resp, err := client.GetDevices(ctx, append(opts, asc.WithDevicesLimit(200), asc.WithDevicesNextURL(next))...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list devices: %w", err)
+		}
+		devices = append(devices, resp.Data...)
+		if next = strings.TrimSpace(resp.Links.Next); next == "" {
+			return devices, nil
+		}
+	}
+}
+
+func formatDeviceFile(devices []asc.Resource[asc.DeviceAttributes], format string) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if format == deviceFileFormatTSV {
+		writer.Comma = '\t'
+	}
+	if err := writer.Write(deviceFileHeader); err != nil {
+		return nil, err
+	}
+	for _, device := range devices {
+		record := []string{device.Attributes.UDID, device.Attributes.Name, filePlatform(string(device.Attributes.Platform))}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
+
+func writeDeviceFile(path string, data []byte) error {
+	if asc.SimulateLocalChange("WRITE", path) {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	file, err := shared.OpenNewFileNoFollow(path, 0o644)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("output file already exists: %w", err)
+		}
+		return err
+	}
+	defer file.Close()
+
+	if _, err := file.Write(data); err != nil {
+		return err
+	}
+	return file.Sync()
+}
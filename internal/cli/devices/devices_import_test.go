@@ -0,0 +1,168 @@
+package devices
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	testLegacyUDID = "0123456789abcdef0123456789abcdef01234567"
+	testModernUDID = "00008030-001A35E11A68802E"
+	testMacUUID    = "A5B5CD50-14AB-5AF7-8B78-AB4751AB10A8"
+)
+
+func TestParseDeviceFileAppleFormat(t *testing.T) {
+	data := "Device ID\tDevice Name\tDevice Platform\n" +
+		testLegacyUDID + "\tQA iPhone\tios\n" +
+		"\n" +
+		testMacUUID + "\tQA Mac\tmac\n" +
+		testMacUUID + "\tWrong Platform\tios\n" +
+		testModernUDID + "\t\tios\n" +
+		testModernUDID + "\tQA Watch\twatchos\n"
+
+	rows, err := parseDeviceFile([]byte(data), deviceFileFormatTSV, "IOS")
+	if err != nil {
+		t.Fatalf("parseDeviceFile() error: %v", err)
+	}
+	var got []string
+	for _, row := range rows {
+		got = append(got, fmt.Sprintf("%d %s %s %s", row.Line, row.Name, row.Platform, row.Status))
+	}
+	want := []string{
+		"2 QA iPhone IOS ",
+		"4 QA Mac MAC_OS ",
+		"5 Wrong Platform IOS invalid",
+		"6  IOS invalid",
+		"7 QA Watch IOS invalid",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected rows:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestParseDeviceFileCSVWithoutHeader(t *testing.T) {
+	data := "\ufeff" + testModernUDID + ",\"Lab iPad, 2nd floor\"\n"
+
+	rows, err := parseDeviceFile([]byte(data), deviceFileFormatCSV, "VISION_OS")
+	if err != nil {
+		t.Fatalf("parseDeviceFile() error: %v", err)
+	}
+	if len(rows) != 1 || rows[0].Name != "Lab iPad, 2nd floor" || rows[0].Platform != "VISION_OS" || rows[0].Status != "" {
+		t.Fatalf("unexpected rows: %+v", rows)
+	}
+
+	if _, err := parseDeviceFile([]byte("Device ID\tDevice Name\n"), deviceFileFormatTSV, "IOS"); err == nil {
+		t.Fatal("expected error for a file without devices")
+	}
+}
+
+func TestValidUDID(t *testing.T) {
+	tests := []struct {
+		udid     string
+		platform string
+		want     bool
+	}{
+		{testLegacyUDID, "IOS", true},
+		{testModernUDID, "IOS", true},
+		{testMacUUID, "IOS", false},
+		{testMacUUID, "MAC_OS", true},
+		{testModernUDID, "MAC_OS", true},
+		{testLegacyUDID, "MAC_OS", false},
+		{testModernUDID, "VISION_OS", true},
+		{testLegacyUDID, "VISION_OS", false},
+		{"not-a-udid", "TV_OS", false},
+	}
+	for _, test := range tests {
+		if got := validUDID(test.udid, test.platform); got != test.want {
+			t.Errorf("validUDID(%q, %s) = %t, want %t", test.udid, test.platform, got, test.want)
+		}
+	}
+}
+
+func TestPlanDeviceImport(t *testing.T) {
+	var existing []asc.Resource[asc.DeviceAttributes]
+	for i := range annualDeviceLimit {
+		existing = append(existing, asc.Resource[asc.DeviceAttributes]{
+			ID:         fmt.Sprintf("MAC%d", i),
+			Attributes: asc.DeviceAttributes{UDID: fmt.Sprintf("MAC-UDID-%d", i), Platform: asc.DevicePlatformMacOS, DeviceClass: asc.DeviceClassMac},
+		})
+	}
+	// Full iPhone slots leave room for the other iOS device classes.
+	for i := range annualDeviceLimit {
+		existing = append(existing, asc.Resource[asc.DeviceAttributes]{
+			ID:         fmt.Sprintf("PHONE-FULL%d", i),
+			Attributes: asc.DeviceAttributes{UDID: fmt.Sprintf("PHONE-UDID-%d", i), Platform: asc.DevicePlatformIOS, DeviceClass: asc.DeviceClassIPhone},
+		})
+	}
+	existing = append(existing, asc.Resource[asc.DeviceAttributes]{
+		ID:         "PHONE1",
+		Attributes: asc.DeviceAttributes{UDID: strings.ToUpper(testLegacyUDID), Platform: asc.DevicePlatformIOS, DeviceClass: asc.DeviceClassIPhone},
+	})
+	rows := []asc.DeviceImportRow{
+		{Line: 1, UDID: testLegacyUDID, Platform: "IOS"},
+		{Line: 2, UDID: testModernUDID, Platform: "IOS"},
+		{Line: 3, UDID: strings.ToLower(testModernUDID), Platform: "IOS"},
+		{Line: 4, UDID: testMacUUID, Platform: "MAC_OS"},
+		{Line: 5, UDID: "bad", Platform: "IOS", Status: asc.DeviceImportStatusInvalid},
+	}
+
+	planDeviceImport(rows, existing)
+
+	want := []string{
+		asc.DeviceImportStatusExists,
+		"",
+		asc.DeviceImportStatusDuplicate,
+		asc.DeviceImportStatusOverLimit,
+		asc.DeviceImportStatusInvalid,
+	}
+	for i, row := range rows {
+		if row.Status != want[i] {
+			t.Fatalf("row %d: status %q, want %q (%+v)", row.Line, row.Status, want[i], rows)
+		}
+	}
+	if rows[0].DeviceID != "PHONE1" || !strings.Contains(rows[2].Message, "line 2") {
+		t.Fatalf("unexpected row details: %+v", rows)
+	}
+}
+
+func TestPlanDeviceImportOverLimitOnceEveryClassIsFull(t *testing.T) {
+	var existing []asc.Resource[asc.DeviceAttributes]
+	for _, class := range platformDeviceClasses["IOS"] {
+		for i := range annualDeviceLimit {
+			existing = append(existing, asc.Resource[asc.DeviceAttributes]{
+				ID:         fmt.Sprintf("%s%d", class, i),
+				Attributes: asc.DeviceAttributes{UDID: fmt.Sprintf("%s-UDID-%d", class, i), Platform: asc.DevicePlatformIOS, DeviceClass: class},
+			})
+		}
+	}
+	rows := []asc.DeviceImportRow{{Line: 1, UDID: testModernUDID, Platform: "IOS"}}
+
+	planDeviceImport(rows, existing)
+
+	if rows[0].Status != asc.DeviceImportStatusOverLimit {
+		t.Fatalf("expected over-limit, got %+v", rows[0])
+	}
+}
+
+func TestFormatDeviceFileRoundTrip(t *testing.T) {
+	devices := []asc.Resource[asc.DeviceAttributes]{
+		{Attributes: asc.DeviceAttributes{UDID: testLegacyUDID, Name: "QA iPhone", Platform: asc.DevicePlatformIOS}},
+		{Attributes: asc.DeviceAttributes{UDID: testMacUUID, Name: "QA Mac", Platform: asc.DevicePlatformMacOS}},
+	}
+	for _, format := range []string{deviceFileFormatTSV, deviceFileFormatCSV} {
+		data, err := formatDeviceFile(devices, format)
+		if err != nil {
+			t.Fatalf("formatDeviceFile(%s) error: %v", format, err)
+		}
+		rows, err := parseDeviceFile(data, format, "IOS")
+		if err != nil {
+			t.Fatalf("parseDeviceFile(%s) error: %v", format, err)
+		}
+		if len(rows) != 2 || rows[0].Name != "QA iPhone" || rows[1].Platform != "MAC_OS" || rows[1].Status != "" {
+			t.Fatalf("%s round trip mismatch: %+v", format, rows)
+		}
+	}
+}
@@ -18,7 +18,7 @@ func FeedbackCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("feedback", flag.ExitOnError)
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	includeScreenshots := fs.Bool("include-screenshots", false, "Include screenshot URLs in feedback output")
 	deviceModel := fs.String("device-model", "", "Filter by device model(s), comma-separated")
@@ -32,6 +32,9 @@ func FeedbackCommand() *ffcli.Command {
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+	shared.ResolveNameLists(fs, shared.ResourceBuild, "build")
+	shared.ResolveNameLists(fs, shared.ResourceBetaTester, "tester")
 
 	return &ffcli.Command{
 		Name:       "feedback",
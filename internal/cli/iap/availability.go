@@ -41,8 +41,9 @@ func IAPAvailabilityGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("availability get", flag.ExitOnError)
 
 	iapID := fs.String("iap-id", "", "In-app purchase ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceInAppPurchase, "iap-id")
 
 	return &ffcli.Command{
 		Name:       "get",
@@ -86,8 +87,9 @@ func IAPAvailabilitySetCommand() *ffcli.Command {
 	iapID := fs.String("iap-id", "", "In-app purchase ID")
 	territories := fs.String("territories", "", "Territory IDs (comma-separated)")
 	availableInNew := fs.Bool("available-in-new-territories", false, "Include new territories automatically")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceInAppPurchase, "iap-id")
 
 	return &ffcli.Command{
 		Name:       "set",
@@ -74,8 +74,10 @@ func IAPPricesCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	iapID := fs.String("iap-id", "", "In-app purchase ID")
 	territory := fs.String("territory", "", "Territory filter (e.g., USA)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+	shared.ResolveNames(fs, shared.ResourceInAppPurchase, "iap-id")
 
 	return &ffcli.Command{
 		Name:       "prices",
@@ -955,44 +957,30 @@ func dedupePriceEntries(entries []iapPriceEntry) []iapPriceEntry {
 }
 
 func printIAPPricesResult(result *iapPricesResult, format string, pretty bool) error {
-	switch strings.ToLower(strings.TrimSpace(format)) {
-	case "json":
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "json" {
 		return shared.PrintOutput(result, "json", pretty)
+	}
+	if pretty {
+		return fmt.Errorf("--pretty is only valid with JSON output")
+	}
+	headers, rows := iapPricesRows(result)
+	switch format {
 	case "table":
-		if pretty {
-			return fmt.Errorf("--pretty is only valid with JSON output")
-		}
-		return printIAPPricesTable(result)
+		asc.RenderTable(headers, rows)
 	case "markdown", "md":
-		if pretty {
-			return fmt.Errorf("--pretty is only valid with JSON output")
-		}
-		return printIAPPricesMarkdown(result)
+		asc.RenderMarkdown(headers, rows)
+	case "csv":
+		return asc.RenderCSV(headers, rows)
+	case "ndjson":
+		return asc.RenderNDJSON(headers, rows)
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
-}
-
-func printIAPPricesTable(result *iapPricesResult) error {
-	headers := []string{"ID", "Name", "Product ID", "Type", "Base Territory", "Current Price", "Estimated Proceeds", "Scheduled Changes"}
-	rows := make([][]string, 0, len(result.IAPs))
-	for _, item := range result.IAPs {
-		rows = append(rows, []string{
-			item.ID,
-			compactIAPText(item.Name),
-			item.ProductID,
-			item.Type,
-			item.BaseTerritory,
-			formatIAPMoney(item.CurrentPrice),
-			formatIAPMoney(item.EstimatedProceeds),
-			formatScheduledChanges(item.ScheduledChanges),
-		})
-	}
-	asc.RenderTable(headers, rows)
 	return nil
 }
 
-func printIAPPricesMarkdown(result *iapPricesResult) error {
+func iapPricesRows(result *iapPricesResult) ([]string, [][]string) {
 	headers := []string{"ID", "Name", "Product ID", "Type", "Base Territory", "Current Price", "Estimated Proceeds", "Scheduled Changes"}
 	rows := make([][]string, 0, len(result.IAPs))
 	for _, item := range result.IAPs {
@@ -1007,8 +995,7 @@ func printIAPPricesMarkdown(result *iapPricesResult) error {
 			formatScheduledChanges(item.ScheduledChanges),
 		})
 	}
-	asc.RenderMarkdown(headers, rows)
-	return nil
+	return headers, rows
 }
 
 func formatIAPMoney(value *iapMoney) string {
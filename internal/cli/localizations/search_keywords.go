@@ -45,7 +45,7 @@ func LocalizationsSearchKeywordsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("localizations search-keywords list", flag.ExitOnError)
 
 	localizationID := fs.String("localization-id", "", "App Store version localization ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -89,7 +89,7 @@ func LocalizationsSearchKeywordsAddCommand() *ffcli.Command {
 
 	localizationID := fs.String("localization-id", "", "App Store version localization ID")
 	keywords := fs.String("keywords", "", "Keywords (comma-separated)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -139,7 +139,7 @@ func LocalizationsSearchKeywordsDeleteCommand() *ffcli.Command {
 	localizationID := fs.String("localization-id", "", "App Store version localization ID")
 	keywords := fs.String("keywords", "", "Keywords (comma-separated)")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
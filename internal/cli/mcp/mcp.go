@@ -0,0 +1,97 @@
+package mcp
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// MCPCommand returns the mcp command group. factory must build the full root
+// command tree; it is called once to discover tools and again per tool call.
+func MCPCommand(version string, factory CommandFactory) *ffcli.Command {
+	fs := flag.NewFlagSet("mcp", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "mcp",
+		ShortUsage: "asc mcp <subcommand> [flags]",
+		ShortHelp:  "Expose asc commands to AI agents over the Model Context Protocol.",
+		LongHelp: `Expose asc commands to AI agents over the Model Context Protocol.
+
+Examples:
+  asc mcp serve
+  asc mcp serve --read-only
+  asc mcp serve --allow "apps_*,builds_*" --deny "*_delete"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			ServeCommand(version, factory),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// ServeCommand returns the mcp serve subcommand.
+func ServeCommand(version string, factory CommandFactory) *ffcli.Command {
+	fs := flag.NewFlagSet("mcp serve", flag.ExitOnError)
+
+	allow := fs.String("allow", "", "Comma-separated tool name globs to expose (default: all)")
+	deny := fs.String("deny", "", "Comma-separated tool name globs to hide (applied after --allow)")
+	readOnly := fs.Bool("read-only", false, "Expose only read-only tools")
+
+	return &ffcli.Command{
+		Name:       "serve",
+		ShortUsage: "asc mcp serve [flags]",
+		ShortHelp:  "Run an MCP server over stdio.",
+		LongHelp: `Run a Model Context Protocol server over stdio.
+
+Every runnable asc command becomes a tool named after its command path joined
+with underscores (asc testflight beta-groups list -> testflight_beta-groups_list).
+Tool arguments are the command's flags; results are the command's JSON output.
+Tools are annotated as read-only or mutating (and destructive for deletes,
+removals, and commands gated by --confirm). Only get, list, info, status,
+and similar read commands count as read-only; everything else is treated
+as mutating.
+
+Tools authenticate exactly like the CLI: the selected --profile, environment
+variables, config file, or keychain.
+
+Examples:
+  asc mcp serve
+  asc mcp serve --read-only
+  asc --profile ci mcp serve --allow "apps_*,builds_*,testflight_*"
+  asc mcp serve --deny "*_delete,*_remove*"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
+			}
+			filter := Filter{
+				Allow:    shared.SplitCSV(*allow),
+				Deny:     shared.SplitCSV(*deny),
+				ReadOnly: *readOnly,
+			}
+			if err := filter.Validate(); err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			server := NewServer(version, factory, filter)
+			if len(server.Tools()) == 0 {
+				return shared.UsageError("no tools match the --allow/--deny/--read-only filters")
+			}
+			fmt.Fprintf(os.Stderr, "asc MCP server ready with %d tools (stdio)\n", len(server.Tools()))
+			if err := server.Serve(ctx, os.Stdin, os.Stdout); err != nil {
+				return fmt.Errorf("mcp serve: %w", err)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,246 @@
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+func testCommands() []*ffcli.Command {
+	listFS := flag.NewFlagSet("widgets list", flag.ExitOnError)
+	limit := listFS.Int("limit", 0, "Maximum results")
+	ids := listFS.String("ids", "", "Comma-separated IDs")
+	paginate := listFS.Bool("paginate", false, "Fetch all pages")
+	output := listFS.String("output", "json", "Output format")
+
+	deleteFS := flag.NewFlagSet("widgets delete", flag.ExitOnError)
+	deleteFS.String("id", "", "Widget ID")
+	deleteFS.Bool("confirm", false, "Confirm deletion")
+
+	renameFS := flag.NewFlagSet("widgets rename", flag.ExitOnError)
+	renameFS.String("id", "", "Widget ID")
+	renameFS.Bool("confirm", false, "Confirm rename")
+
+	return []*ffcli.Command{
+		{
+			Name:      "widgets",
+			ShortHelp: "Manage widgets.",
+			FlagSet:   flag.NewFlagSet("widgets", flag.ExitOnError),
+			Subcommands: []*ffcli.Command{
+				{
+					Name:       "list",
+					ShortUsage: "asc widgets list [flags]",
+					ShortHelp:  "List widgets.",
+					FlagSet:    listFS,
+					Exec: func(ctx context.Context, args []string) error {
+						fmt.Printf(`{"limit":%d,"ids":%q,"paginate":%t,"output":%q}`+"\n", *limit, *ids, *paginate, *output)
+						return nil
+					},
+				},
+				{
+					Name:    "delete",
+					FlagSet: deleteFS,
+					Exec: func(ctx context.Context, args []string) error {
+						return fmt.Errorf("widgets delete: boom")
+					},
+				},
+				{Name: "rename", FlagSet: renameFS, Exec: func(context.Context, []string) error { return nil }},
+			},
+		},
+		{Name: "completion", Exec: func(context.Context, []string) error { return nil }},
+		{Name: "mcp", Exec: func(context.Context, []string) error { return nil }},
+	}
+}
+
+func toolNames(tools []*Tool) []string {
+	names := make([]string, 0, len(tools))
+	for _, tool := range tools {
+		names = append(names, tool.Name)
+	}
+	return names
+}
+
+func TestCollectToolsBuildsSchemasAndAnnotations(t *testing.T) {
+	tools := CollectTools(testCommands(), Filter{})
+	if got := strings.Join(toolNames(tools), ","); got != "widgets_delete,widgets_list,widgets_rename" {
+		t.Fatalf("unexpected tools: %s", got)
+	}
+
+	list := tools[1]
+	properties := list.InputSchema["properties"].(map[string]any)
+	wantTypes := map[string]string{"limit": "integer", "ids": "string", "paginate": "boolean"}
+	for name, want := range wantTypes {
+		prop, ok := properties[name].(map[string]any)
+		if !ok || prop["type"] != want {
+			t.Fatalf("property %s = %v, want type %s", name, properties[name], want)
+		}
+	}
+	if _, ok := properties["output"]; ok {
+		t.Fatal("expected output flag to be hidden from the schema")
+	}
+	if !list.Annotations.ReadOnlyHint || list.Annotations.DestructiveHint {
+		t.Fatalf("expected list to be read-only, got %+v", list.Annotations)
+	}
+	if !strings.Contains(list.Description, "Usage: asc widgets list [flags]") {
+		t.Fatalf("expected usage in description, got %q", list.Description)
+	}
+
+	del := tools[0]
+	if del.Annotations.ReadOnlyHint || !del.Annotations.DestructiveHint {
+		t.Fatalf("expected delete to be destructive, got %+v", del.Annotations)
+	}
+	rename := tools[2]
+	if rename.Annotations.ReadOnlyHint || !rename.Annotations.DestructiveHint {
+		t.Fatalf("expected --confirm gated command to be destructive, got %+v", rename.Annotations)
+	}
+}
+
+func TestCollectToolsAppliesFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter Filter
+		want   string
+	}{
+		{name: "read-only", filter: Filter{ReadOnly: true}, want: "widgets_list"},
+		{name: "allow", filter: Filter{Allow: []string{"widgets_de*", "widgets_list"}}, want: "widgets_delete,widgets_list"},
+		{name: "deny", filter: Filter{Deny: []string{"*_delete"}}, want: "widgets_list,widgets_rename"},
+		{name: "allow and deny", filter: Filter{Allow: []string{"widgets_*"}, Deny: []string{"widgets_list"}}, want: "widgets_delete,widgets_rename"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := strings.Join(toolNames(CollectTools(testCommands(), test.filter)), ","); got != test.want {
+				t.Fatalf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+
+	if err := (Filter{Allow: []string{"["}}).Validate(); err == nil {
+		t.Fatal("expected invalid glob to be rejected")
+	}
+}
+
+func TestClassifyCommand(t *testing.T) {
+	tests := []struct {
+		path        []string
+		mutating    bool
+		destructive bool
+	}{
+		{path: []string{"apps", "list"}},
+		{path: []string{"builds", "relationships"}},
+		{path: []string{"testflight", "beta-groups", "add-groups"}, mutating: true},
+		{path: []string{"review", "submissions-cancel"}, mutating: true, destructive: true},
+		{path: []string{"publish", "testflight"}, mutating: true},
+		{path: []string{"review", "submissions-list"}},
+		{path: []string{"auth", "status"}, mutating: true},
+		{path: []string{"signing", "sync"}, mutating: true},
+		{path: []string{"signing", "fetch"}, mutating: true},
+		{path: []string{"finance", "reports"}, mutating: true},
+		{path: []string{"analytics", "sales"}, mutating: true},
+		{path: []string{"widgets", "frobnicate"}, mutating: true},
+	}
+	for _, test := range tests {
+		mutating, destructive := classifyCommand(test.path)
+		if mutating != test.mutating || destructive != test.destructive {
+			t.Fatalf("%v: got mutating=%t destructive=%t", test.path, mutating, destructive)
+		}
+	}
+}
+
+func runSession(t *testing.T, server *Server, requests ...string) []map[string]any {
+	t.Helper()
+	var out strings.Builder
+	if err := server.Serve(context.Background(), strings.NewReader(strings.Join(requests, "\n")+"\n"), &out); err != nil {
+		t.Fatalf("Serve() error: %v", err)
+	}
+	var responses []map[string]any
+	scanner := bufio.NewScanner(strings.NewReader(out.String()))
+	for scanner.Scan() {
+		var resp map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &resp); err != nil {
+			t.Fatalf("invalid response %q: %v", scanner.Text(), err)
+		}
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+func TestServeInitializeAndListTools(t *testing.T) {
+	server := NewServer("1.2.3", testCommands, Filter{ReadOnly: true})
+	responses := runSession(t, server,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26"}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/list"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"resources/list"}`,
+		`not json`,
+	)
+	if len(responses) != 4 {
+		t.Fatalf("expected 4 responses (notification has none), got %d: %v", len(responses), responses)
+	}
+
+	initResult := responses[0]["result"].(map[string]any)
+	if initResult["protocolVersion"] != "2025-03-26" {
+		t.Fatalf("expected negotiated protocol version, got %v", initResult["protocolVersion"])
+	}
+	if initResult["serverInfo"].(map[string]any)["version"] != "1.2.3" {
+		t.Fatalf("unexpected serverInfo: %v", initResult["serverInfo"])
+	}
+
+	tools := responses[1]["result"].(map[string]any)["tools"].([]any)
+	if len(tools) != 1 || tools[0].(map[string]any)["name"] != "widgets_list" {
+		t.Fatalf("unexpected tools: %v", tools)
+	}
+
+	if code := responses[2]["error"].(map[string]any)["code"]; code != float64(codeMethodNotFound) {
+		t.Fatalf("expected method not found, got %v", responses[2])
+	}
+	if code := responses[3]["error"].(map[string]any)["code"]; code != float64(codeParseError) {
+		t.Fatalf("expected parse error, got %v", responses[3])
+	}
+}
+
+func TestServeCallToolRunsCommand(t *testing.T) {
+	server := NewServer("1.2.3", testCommands, Filter{})
+	responses := runSession(t, server,
+		`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"widgets_list","arguments":{"limit":5,"ids":["a","b"],"paginate":true}}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"widgets_list","arguments":{}}}`,
+		`{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"widgets_list","arguments":{"limit":"many"}}}`,
+		`{"jsonrpc":"2.0","id":4,"method":"tools/call","params":{"name":"widgets_delete","arguments":{"id":"1","confirm":true}}}`,
+		`{"jsonrpc":"2.0","id":5,"method":"tools/call","params":{"name":"widgets_missing"}}`,
+	)
+	if len(responses) != 5 {
+		t.Fatalf("expected 5 responses, got %d", len(responses))
+	}
+
+	first := responses[0]["result"].(map[string]any)
+	if first["isError"] != false {
+		t.Fatalf("expected success, got %v", first)
+	}
+	structured := first["structuredContent"].(map[string]any)
+	if structured["limit"] != float64(5) || structured["ids"] != "a,b" || structured["paginate"] != true || structured["output"] != "json" {
+		t.Fatalf("unexpected structured content: %v", structured)
+	}
+
+	// Each call parses into a fresh command tree, so flags do not leak between calls.
+	second := responses[1]["result"].(map[string]any)["structuredContent"].(map[string]any)
+	if second["limit"] != float64(0) || second["paginate"] != false {
+		t.Fatalf("expected defaults on second call, got %v", second)
+	}
+
+	for i, want := range map[int]string{2: "invalid value", 3: "widgets delete: boom"} {
+		result := responses[i]["result"].(map[string]any)
+		text := result["content"].([]any)[0].(map[string]any)["text"].(string)
+		if result["isError"] != true || !strings.Contains(text, want) {
+			t.Fatalf("response %d: expected error containing %q, got %v", i, want, result)
+		}
+	}
+
+	if code := responses[4]["error"].(map[string]any)["code"]; code != float64(codeInvalidParams) {
+		t.Fatalf("expected invalid params for unknown tool, got %v", responses[4])
+	}
+}
@@ -0,0 +1,302 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	latestProtocolVersion = "2025-06-18"
+	serverName            = "asc"
+
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+var supportedProtocolVersions = []string{latestProtocolVersion, "2025-03-26", "2024-11-05"}
+
+// CommandFactory builds a fresh root command tree. Each tool call parses
+// flags into a new tree so calls never share flag state.
+type CommandFactory func() []*ffcli.Command
+
+// Server is an MCP server speaking newline-delimited JSON-RPC over stdio.
+type Server struct {
+	version string
+	factory CommandFactory
+	tools   map[string]*Tool
+	ordered []*Tool
+
+	// runMu serializes tool calls: commands write to the process-wide
+	// os.Stdout and os.Stderr, which are redirected while a tool runs.
+	runMu sync.Mutex
+}
+
+// NewServer creates a server exposing the tools permitted by filter.
+func NewServer(version string, factory CommandFactory, filter Filter) *Server {
+	ordered := CollectTools(factory(), filter)
+	tools := make(map[string]*Tool, len(ordered))
+	for _, tool := range ordered {
+		tools[tool.Name] = tool
+	}
+	return &Server{version: version, factory: factory, tools: tools, ordered: ordered}
+}
+
+// Tools returns the exposed tools sorted by name.
+func (s *Server) Tools() []*Tool {
+	return s.ordered
+}
+
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type textContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type toolResult struct {
+	Content           []textContent `json:"content"`
+	StructuredContent any           `json:"structuredContent,omitempty"`
+	IsError           bool          `json:"isError"`
+}
+
+// Serve reads requests from in and writes responses to out until in is
+// exhausted or ctx is canceled.
+func (s *Server) Serve(ctx context.Context, in io.Reader, out io.Writer) error {
+	reader := bufio.NewReader(in)
+	encoder := json.NewEncoder(out)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil
+		}
+		line, err := reader.ReadBytes('\n')
+		if trimmed := bytes.TrimSpace(line); len(trimmed) > 0 {
+			if resp := s.handle(ctx, trimmed); resp != nil {
+				if encodeErr := encoder.Encode(resp); encodeErr != nil {
+					return fmt.Errorf("write response: %w", encodeErr)
+				}
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read request: %w", err)
+		}
+	}
+}
+
+// handle processes one message and returns the response, or nil for
+// notifications.
+func (s *Server) handle(ctx context.Context, raw []byte) *rpcResponse {
+	var req rpcRequest
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return errorResponse(nil, codeParseError, "parse error: "+err.Error())
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return errorResponse(req.ID, codeInvalidRequest, "invalid request")
+	}
+	notification := len(req.ID) == 0
+
+	var (
+		result any
+		rpcErr *rpcError
+	)
+	switch req.Method {
+	case "initialize":
+		result, rpcErr = s.initialize(req.Params)
+	case "ping":
+		result = map[string]any{}
+	case "tools/list":
+		result = map[string]any{"tools": s.ordered}
+	case "tools/call":
+		result, rpcErr = s.callTool(ctx, req.Params)
+	default:
+		if strings.HasPrefix(req.Method, "notifications/") {
+			return nil
+		}
+		rpcErr = &rpcError{Code: codeMethodNotFound, Message: "method not found: " + req.Method}
+	}
+
+	if notification {
+		return nil
+	}
+	if rpcErr != nil {
+		return errorResponse(req.ID, rpcErr.Code, rpcErr.Message)
+	}
+	return &rpcResponse{JSONRPC: "2.0", ID: req.ID, Result: result}
+}
+
+func (s *Server) initialize(params json.RawMessage) (any, *rpcError) {
+	var req struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &req); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: "invalid params: " + err.Error()}
+		}
+	}
+	version := latestProtocolVersion
+	if slices.Contains(supportedProtocolVersions, req.ProtocolVersion) {
+		version = req.ProtocolVersion
+	}
+	return map[string]any{
+		"protocolVersion": version,
+		"capabilities":    map[string]any{"tools": map[string]any{"listChanged": false}},
+		"serverInfo":      map[string]any{"name": serverName, "version": s.version},
+		"instructions":    "Each tool runs one asc command with the same credentials as the CLI. Tool arguments are the command's flags; results are the command's JSON output.",
+	}, nil
+}
+
+func (s *Server) callTool(ctx context.Context, params json.RawMessage) (any, *rpcError) {
+	var req struct {
+		Name      string         `json:"name"`
+		Arguments map[string]any `json:"arguments"`
+	}
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "invalid params: " + err.Error()}
+	}
+	tool, ok := s.tools[req.Name]
+	if !ok {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "unknown tool: " + req.Name}
+	}
+	args, err := tool.commandArgs(req.Arguments)
+	if err != nil {
+		return errorResult(err.Error()), nil
+	}
+	return s.run(ctx, tool, args), nil
+}
+
+// run executes the tool's command in-process with stdout/stderr captured and
+// stdin detached from the protocol stream.
+func (s *Server) run(ctx context.Context, tool *Tool, args []string) toolResult {
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	cmd := findCommand(s.factory(), tool.path)
+	if cmd == nil {
+		return errorResult("command not found: asc " + strings.Join(tool.path, " "))
+	}
+	shared.ResetResolvedRefs()
+	// Flag errors must be returned, not exit the server.
+	if cmd.FlagSet == nil {
+		cmd.FlagSet = flag.NewFlagSet(cmd.Name, flag.ContinueOnError)
+	}
+	cmd.FlagSet.Init(cmd.FlagSet.Name(), flag.ContinueOnError)
+	cmd.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr, runErr := captureOutput(func() error {
+		if err := cmd.Parse(args); err != nil {
+			return err
+		}
+		return cmd.Run(ctx)
+	})
+
+	if runErr != nil {
+		message := strings.TrimSpace(stderr)
+		if !errors.Is(runErr, flag.ErrHelp) {
+			message = strings.TrimSpace(message + "\n" + runErr.Error())
+		}
+		if message == "" {
+			message = "invalid arguments"
+		}
+		return errorResult(message)
+	}
+
+	result := toolResult{Content: []textContent{{Type: "text", Text: stdout}}}
+	var structured map[string]any
+	if err := json.Unmarshal([]byte(stdout), &structured); err == nil {
+		result.StructuredContent = structured
+	}
+	return result
+}
+
+func captureOutput(fn func() error) (string, string, error) {
+	stdinFile, err := os.Open(os.DevNull)
+	if err != nil {
+		return "", "", err
+	}
+	defer stdinFile.Close()
+	stdoutReader, stdoutWriter, err := os.Pipe()
+	if err != nil {
+		return "", "", err
+	}
+	stderrReader, stderrWriter, err := os.Pipe()
+	if err != nil {
+		_ = stdoutReader.Close()
+		_ = stdoutWriter.Close()
+		return "", "", err
+	}
+
+	var stdoutBuf, stderrBuf bytes.Buffer
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(&stdoutBuf, stdoutReader)
+	}()
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(&stderrBuf, stderrReader)
+	}()
+
+	runErr := func() (err error) {
+		origStdin, origStdout, origStderr := os.Stdin, os.Stdout, os.Stderr
+		os.Stdin, os.Stdout, os.Stderr = stdinFile, stdoutWriter, stderrWriter
+		defer func() {
+			os.Stdin, os.Stdout, os.Stderr = origStdin, origStdout, origStderr
+			if recovered := recover(); recovered != nil {
+				err = fmt.Errorf("command panicked: %v", recovered)
+			}
+		}()
+		return fn()
+	}()
+
+	_ = stdoutWriter.Close()
+	_ = stderrWriter.Close()
+	wg.Wait()
+	_ = stdoutReader.Close()
+	_ = stderrReader.Close()
+
+	return stdoutBuf.String(), stderrBuf.String(), runErr
+}
+
+func errorResult(message string) toolResult {
+	return toolResult{Content: []textContent{{Type: "text", Text: message}}, IsError: true}
+}
+
+func errorResponse(id json.RawMessage, code int, message string) *rpcResponse {
+	return &rpcResponse{JSONRPC: "2.0", ID: id, Error: &rpcError{Code: code, Message: message}}
+}
@@ -0,0 +1,379 @@
+package mcp
+
+import (
+	"flag"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+// excludedRoots are root commands never exposed as tools: the server itself,
+// shell integration, long-running local servers, the stateful watcher, and
+// commands that run arbitrary asc commands (raw API requests and workflows),
+// which cannot be classified as read-only.
+var excludedRoots = map[string]bool{
+	"api":        true,
+	"mcp":        true,
+	"completion": true,
+	"dev":        true,
+	"watch":      true,
+	"workflow":   true,
+}
+
+// excludedCommands are long-running commands under otherwise exposed roots.
+var excludedCommands = map[string]bool{
+	"webhooks serve": true,
+}
+
+// mutatingRoots are root commands whose leaves all change remote or local state.
+var mutatingRoots = map[string]bool{
+	"auth":    true,
+	"init":    true,
+	"install": true,
+	"migrate": true,
+	"notify":  true,
+	"publish": true,
+	"shots":   true,
+}
+
+// readOnlyVerbs mark a leaf as read-only when they are the last
+// hyphen-separated token of its name (e.g. "list", "submissions-get",
+// "video-clips-relationships"). Every other leaf counts as mutating, so new
+// commands stay out of --read-only until they are named like a read.
+var readOnlyVerbs = map[string]bool{
+	"audit": true, "get": true, "info": true, "inspect": true, "latest": true,
+	"list": true, "relationships": true, "status": true,
+}
+
+// destructiveVerbs mark a mutating leaf as destructive.
+var destructiveVerbs = map[string]bool{
+	"cancel": true, "clear": true, "delete": true, "disable": true, "end": true,
+	"expire": true, "logout": true, "remove": true, "revoke": true,
+}
+
+// hiddenFlags are handled by the server: tool results are always JSON.
+var hiddenFlags = map[string]bool{
+	"output": true,
+	"pretty": true,
+}
+
+type flagKind string
+
+const (
+	kindString  flagKind = "string"
+	kindBoolean flagKind = "boolean"
+	kindInteger flagKind = "integer"
+	kindNumber  flagKind = "number"
+)
+
+// Annotations are the MCP tool behavior hints.
+type Annotations struct {
+	Title           string `json:"title,omitempty"`
+	ReadOnlyHint    bool   `json:"readOnlyHint"`
+	DestructiveHint bool   `json:"destructiveHint"`
+	IdempotentHint  bool   `json:"idempotentHint"`
+	OpenWorldHint   bool   `json:"openWorldHint"`
+}
+
+// Tool is one leaf command exposed over MCP.
+type Tool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"inputSchema"`
+	Annotations Annotations    `json:"annotations"`
+
+	path      []string
+	flags     map[string]flagKind
+	hasOutput bool
+}
+
+// ReadOnly reports whether the tool was classified as read-only.
+func (t *Tool) ReadOnly() bool {
+	return t.Annotations.ReadOnlyHint
+}
+
+// Filter selects which tools are exposed. Allow and Deny hold glob patterns
+// matched against tool names (e.g. "apps_*").
+type Filter struct {
+	Allow    []string
+	Deny     []string
+	ReadOnly bool
+}
+
+// Validate checks that every pattern is a well-formed glob.
+func (f Filter) Validate() error {
+	for _, pattern := range append(append([]string{}, f.Allow...), f.Deny...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+func (f Filter) permits(tool *Tool) bool {
+	if f.ReadOnly && !tool.ReadOnly() {
+		return false
+	}
+	if len(f.Allow) > 0 && !matchesAny(f.Allow, tool.Name) {
+		return false
+	}
+	return !matchesAny(f.Deny, tool.Name)
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// CollectTools walks the command tree and returns a tool for every runnable
+// command permitted by filter, sorted by name. A command is runnable when it
+// has no subcommands or defines flags of its own.
+func CollectTools(commands []*ffcli.Command, filter Filter) []*Tool {
+	var tools []*Tool
+	var walk func(cmd *ffcli.Command, parents []string)
+	walk = func(cmd *ffcli.Command, parents []string) {
+		if cmd == nil || strings.TrimSpace(cmd.Name) == "" {
+			return
+		}
+		cmdPath := append(append([]string{}, parents...), cmd.Name)
+		if excludedCommands[strings.Join(cmdPath, " ")] {
+			return
+		}
+		if len(cmd.Subcommands) == 0 || hasFlags(cmd.FlagSet) {
+			if tool := newTool(cmd, cmdPath); filter.permits(tool) {
+				tools = append(tools, tool)
+			}
+		}
+		for _, sub := range cmd.Subcommands {
+			walk(sub, cmdPath)
+		}
+	}
+	for _, cmd := range commands {
+		if cmd == nil || excludedRoots[cmd.Name] {
+			continue
+		}
+		walk(cmd, nil)
+	}
+	sort.Slice(tools, func(i, j int) bool { return tools[i].Name < tools[j].Name })
+	return tools
+}
+
+func hasFlags(fs *flag.FlagSet) bool {
+	found := false
+	if fs != nil {
+		fs.VisitAll(func(*flag.Flag) { found = true })
+	}
+	return found
+}
+
+func newTool(cmd *ffcli.Command, cmdPath []string) *Tool {
+	tool := &Tool{
+		Name:  strings.Join(cmdPath, "_"),
+		path:  cmdPath,
+		flags: make(map[string]flagKind),
+	}
+
+	description := strings.TrimSpace(cmd.LongHelp)
+	if description == "" {
+		description = strings.TrimSpace(cmd.ShortHelp)
+	}
+	if usage := strings.TrimSpace(cmd.ShortUsage); usage != "" {
+		description += "\n\nUsage: " + usage
+	}
+	tool.Description = strings.TrimSpace(description)
+
+	properties := make(map[string]any)
+	hasConfirm := false
+	if cmd.FlagSet != nil {
+		cmd.FlagSet.VisitAll(func(f *flag.Flag) {
+			switch {
+			case f.Name == "output":
+				tool.hasOutput = true
+				return
+			case hiddenFlags[f.Name]:
+				return
+			case f.Name == "confirm":
+				hasConfirm = true
+			}
+			kind := classifyFlag(f)
+			tool.flags[f.Name] = kind
+			properties[f.Name] = flagSchema(f, kind)
+		})
+	}
+	tool.InputSchema = map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+
+	mutating, destructive := classifyCommand(cmdPath)
+	tool.Annotations = Annotations{
+		Title:           "asc " + strings.Join(cmdPath, " "),
+		ReadOnlyHint:    !mutating && !hasConfirm,
+		DestructiveHint: destructive || hasConfirm,
+		IdempotentHint:  !mutating && !hasConfirm,
+		OpenWorldHint:   true,
+	}
+	return tool
+}
+
+// classifyCommand reports whether a command path mutates state and whether
+// the mutation is destructive, based on its root and name tokens. Leaves are
+// mutating unless their name ends in a read-only verb.
+func classifyCommand(cmdPath []string) (mutating, destructive bool) {
+	tokens := strings.Split(cmdPath[len(cmdPath)-1], "-")
+	mutating = mutatingRoots[cmdPath[0]] || !readOnlyVerbs[tokens[len(tokens)-1]]
+	for _, token := range tokens {
+		if destructiveVerbs[token] {
+			destructive = true
+		}
+	}
+	return mutating, destructive
+}
+
+func classifyFlag(f *flag.Flag) flagKind {
+	if boolFlag, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && boolFlag.IsBoolFlag() {
+		return kindBoolean
+	}
+	getter, ok := f.Value.(flag.Getter)
+	if !ok {
+		return kindString
+	}
+	switch getter.Get().(type) {
+	case int, int64, uint, uint64:
+		return kindInteger
+	case float64:
+		return kindNumber
+	default:
+		return kindString
+	}
+}
+
+func flagSchema(f *flag.Flag, kind flagKind) map[string]any {
+	schema := map[string]any{
+		"type":        string(kind),
+		"description": f.Usage,
+	}
+	if getter, ok := f.Value.(flag.Getter); ok {
+		if _, isDuration := getter.Get().(time.Duration); isDuration {
+			schema["description"] = f.Usage + " (Go duration, e.g. 30s, 5m)"
+		}
+	}
+	switch kind {
+	case kindBoolean:
+		if f.DefValue == "true" {
+			schema["default"] = true
+		}
+	case kindInteger:
+		if value, err := strconv.ParseInt(f.DefValue, 10, 64); err == nil && value != 0 {
+			schema["default"] = value
+		}
+	case kindNumber:
+		if value, err := strconv.ParseFloat(f.DefValue, 64); err == nil && value != 0 {
+			schema["default"] = value
+		}
+	default:
+		if f.DefValue != "" && f.DefValue != "0s" {
+			schema["default"] = f.DefValue
+		}
+	}
+	return schema
+}
+
+// commandArgs converts tool arguments into command-line flags. Arrays are
+// joined with commas to match the CLI's comma-separated list flags.
+func (t *Tool) commandArgs(arguments map[string]any) ([]string, error) {
+	names := make([]string, 0, len(arguments))
+	for name := range arguments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	args := make([]string, 0, len(names)+1)
+	for _, name := range names {
+		kind, ok := t.flags[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown argument %q", name)
+		}
+		value, err := flagValue(arguments[name], kind)
+		if err != nil {
+			return nil, fmt.Errorf("argument %q: %w", name, err)
+		}
+		args = append(args, "--"+name+"="+value)
+	}
+	if t.hasOutput {
+		args = append(args, "--output=json")
+	}
+	return args, nil
+}
+
+func flagValue(value any, kind flagKind) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", fmt.Errorf("must not be null")
+	case bool:
+		if kind != kindBoolean && kind != kindString {
+			return "", fmt.Errorf("expected %s, got boolean", kind)
+		}
+		return strconv.FormatBool(v), nil
+	case float64:
+		if kind == kindBoolean {
+			return "", fmt.Errorf("expected boolean, got number")
+		}
+		if kind == kindInteger && v != float64(int64(v)) {
+			return "", fmt.Errorf("expected integer, got %v", v)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case string:
+		if kind == kindBoolean {
+			if _, err := strconv.ParseBool(v); err != nil {
+				return "", fmt.Errorf("expected boolean, got %q", v)
+			}
+		}
+		return v, nil
+	case []any:
+		if kind != kindString {
+			return "", fmt.Errorf("expected %s, got array", kind)
+		}
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			text, err := flagValue(item, kindString)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, text)
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+// findCommand resolves a command path in a freshly built command tree.
+func findCommand(commands []*ffcli.Command, cmdPath []string) *ffcli.Command {
+	var current *ffcli.Command
+	candidates := commands
+	for _, name := range cmdPath {
+		current = nil
+		for _, cmd := range candidates {
+			if cmd != nil && cmd.Name == name {
+				current = cmd
+				break
+			}
+		}
+		if current == nil {
+			return nil
+		}
+		candidates = current.Subcommands
+	}
+	return current
+}
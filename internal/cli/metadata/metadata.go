@@ -0,0 +1,290 @@
+package metadata
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// MetadataCommand returns the metadata command with subcommands.
+func MetadataCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "metadata",
+		ShortUsage: "asc metadata <subcommand> [flags]",
+		ShortHelp:  "Manage App Store metadata as a directory of files.",
+		LongHelp: `Manage App Store metadata as a directory of files.
+
+pull writes a version's metadata to a single directory tree; push applies
+the tree back, changing only what differs:
+
+  metadata/
+  ├── app.yaml        categories and age rating declaration
+  ├── review.yaml     App Review contact details, demo account, and notes
+  └── locales/
+      ├── en-US.yaml  app info + version localization, screenshot order
+      └── ...
+
+Files may be YAML or JSON. Keys match App Store Connect attribute names.
+
+Examples:
+  asc metadata pull --app "APP_ID" --version "1.2.3" --dir ./metadata
+  asc metadata push --app "APP_ID" --version "1.2.3" --dir ./metadata --dry-run
+  asc metadata push --app "APP_ID" --version "1.2.3" --dir ./metadata`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			MetadataPullCommand(),
+			MetadataPushCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+type metadataTargetFlags struct {
+	appID     *string
+	version   *string
+	versionID *string
+	platform  *string
+	appInfoID *string
+	dir       *string
+}
+
+func bindMetadataTargetFlags(fs *flag.FlagSet) metadataTargetFlags {
+	flags := metadataTargetFlags{
+		appID:     fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)"),
+		version:   fs.String("version", "", "App Store version string (e.g., 1.2.3)"),
+		versionID: fs.String("version-id", "", "App Store version ID"),
+		platform:  fs.String("platform", "IOS", "Platform for --version: IOS, MAC_OS, TV_OS, VISION_OS"),
+		appInfoID: fs.String("app-info", "", "App Info ID (optional override)"),
+		dir:       fs.String("dir", "", "Metadata directory (required)"),
+	}
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+	shared.ResolveNames(fs, shared.ResourceVersion, "version-id")
+	return flags
+}
+
+type metadataTarget struct {
+	appID     string
+	appInfoID string
+	versionID string
+	dir       string
+}
+
+// validate checks required flags, printing usage errors like other commands.
+func (f metadataTargetFlags) validate() (string, error) {
+	if strings.TrimSpace(*f.dir) == "" {
+		fmt.Fprintln(os.Stderr, "Error: --dir is required")
+		return "", flag.ErrHelp
+	}
+	if strings.TrimSpace(*f.version) == "" && strings.TrimSpace(*f.versionID) == "" {
+		fmt.Fprintln(os.Stderr, "Error: --version or --version-id is required")
+		return "", flag.ErrHelp
+	}
+	if strings.TrimSpace(*f.version) != "" && strings.TrimSpace(*f.versionID) != "" {
+		return "", shared.UsageError("--version and --version-id are mutually exclusive")
+	}
+	resolvedAppID := shared.ResolveAppID(*f.appID)
+	if resolvedAppID == "" {
+		fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+		return "", flag.ErrHelp
+	}
+	return resolvedAppID, nil
+}
+
+func (f metadataTargetFlags) resolve(ctx context.Context, client *asc.Client, appID string) (metadataTarget, error) {
+	target := metadataTarget{appID: appID, dir: strings.TrimSpace(*f.dir)}
+
+	target.versionID = strings.TrimSpace(*f.versionID)
+	if target.versionID == "" {
+		platform, err := shared.NormalizeAppStoreVersionPlatform(*f.platform)
+		if err != nil {
+			return target, shared.UsageError(err.Error())
+		}
+		target.versionID, err = shared.ResolveAppStoreVersionID(ctx, client, appID, strings.TrimSpace(*f.version), platform)
+		if err != nil {
+			return target, err
+		}
+	}
+
+	target.appInfoID = strings.TrimSpace(*f.appInfoID)
+	if target.appInfoID == "" {
+		appInfos, err := client.GetAppInfos(ctx, appID)
+		if err != nil {
+			return target, fmt.Errorf("failed to fetch app info: %w", err)
+		}
+		target.appInfoID = shared.SelectBestAppInfoID(appInfos)
+		if target.appInfoID == "" {
+			return target, fmt.Errorf("no app info found for app %q", appID)
+		}
+	}
+	return target, nil
+}
+
+// MetadataPullCommand returns the metadata pull subcommand.
+func MetadataPullCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata pull", flag.ExitOnError)
+
+	targetFlags := bindMetadataTargetFlags(fs)
+	fileFormat := fs.String("format", "yaml", "File format: yaml (default), json")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "pull",
+		ShortUsage: "asc metadata pull [flags]",
+		ShortHelp:  "Write App Store metadata to a directory tree.",
+		LongHelp: `Write App Store metadata to a directory tree.
+
+Pulls version localizations, app info localizations, categories, the age
+rating declaration, App Review details, and screenshot order. Existing files
+in the tree are overwritten. The demo account password is never written.
+
+Examples:
+  asc metadata pull --app "APP_ID" --version "1.2.3" --dir ./metadata
+  asc metadata pull --app "APP_ID" --version-id "VERSION_ID" --dir ./metadata --format json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID, err := targetFlags.validate()
+			if err != nil {
+				return err
+			}
+			format, err := normalizeMetadataFormat(*fileFormat)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("metadata pull: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			target, err := targetFlags.resolve(requestCtx, client, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("metadata pull: %w", err)
+			}
+
+			live, err := fetchLiveMetadata(requestCtx, client, target.appInfoID, target.versionID)
+			if err != nil {
+				return fmt.Errorf("metadata pull: %w", err)
+			}
+			if live.tree.Review != nil {
+				live.tree.Review.DemoAccountPassword = ""
+			}
+
+			files, err := writeMetadataTree(target.dir, live.tree, format)
+			if err != nil {
+				return fmt.Errorf("metadata pull: %w", err)
+			}
+
+			result := &asc.MetadataPullResult{
+				Dir:       target.dir,
+				AppID:     target.appID,
+				AppInfoID: target.appInfoID,
+				VersionID: target.versionID,
+				Format:    format,
+				Locales:   sortedLocales(live.tree.Locales),
+				Files:     files,
+			}
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// MetadataPushCommand returns the metadata push subcommand.
+func MetadataPushCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata push", flag.ExitOnError)
+
+	targetFlags := bindMetadataTargetFlags(fs)
+	dryRun := fs.Bool("dry-run", false, "Show the planned changes without applying them")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "push",
+		ShortUsage: "asc metadata push [flags]",
+		ShortHelp:  "Apply a metadata directory tree to App Store Connect.",
+		LongHelp: `Apply a metadata directory tree to App Store Connect.
+
+Compares the tree with the current metadata and applies only the fields
+that differ. Missing or empty fields are left unchanged. Screenshot lists
+reorder existing screenshots; use asc assets to upload new ones.
+
+Use --dry-run to print the plan without applying it.
+
+Examples:
+  asc metadata push --app "APP_ID" --version "1.2.3" --dir ./metadata --dry-run
+  asc metadata push --app "APP_ID" --version "1.2.3" --dir ./metadata
+  asc metadata push --app "APP_ID" --version-id "VERSION_ID" --dir ./metadata --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID, err := targetFlags.validate()
+			if err != nil {
+				return err
+			}
+
+			desired, err := readMetadataTree(strings.TrimSpace(*targetFlags.dir))
+			if err != nil {
+				return fmt.Errorf("metadata push: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("metadata push: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			target, err := targetFlags.resolve(requestCtx, client, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("metadata push: %w", err)
+			}
+
+			live, err := fetchLiveMetadata(requestCtx, client, target.appInfoID, target.versionID)
+			if err != nil {
+				return fmt.Errorf("metadata push: %w", err)
+			}
+
+			changes, err := planMetadataPush(desired, live)
+			if err != nil {
+				return fmt.Errorf("metadata push: %w", err)
+			}
+
+			result := &asc.MetadataPushResult{
+				Dir:       target.dir,
+				AppID:     target.appID,
+				AppInfoID: target.appInfoID,
+				VersionID: target.versionID,
+				DryRun:    *dryRun,
+				Changes:   make([]asc.MetadataChange, 0, len(changes)),
+			}
+			for _, change := range changes {
+				result.Changes = append(result.Changes, change.report())
+			}
+			if !*dryRun && len(changes) > 0 {
+				if err := applyMetadataChanges(requestCtx, client, target.appInfoID, target.versionID, changes); err != nil {
+					return fmt.Errorf("metadata push: %w", err)
+				}
+				result.Applied = true
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
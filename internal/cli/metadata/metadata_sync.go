@@ -0,0 +1,604 @@
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	metadataActionCreate  = "create"
+	metadataActionUpdate  = "update"
+	metadataActionReorder = "reorder"
+
+	metadataResourceCategories          = "categories"
+	metadataResourceAgeRating           = "ageRating"
+	metadataResourceReviewDetail        = "reviewDetail"
+	metadataResourceAppInfoLocalization = "appInfoLocalization"
+	metadataResourceVersionLocalization = "versionLocalization"
+	metadataResourceScreenshotSet       = "screenshotSet"
+)
+
+type metadataClient interface {
+	GetAppStoreVersionLocalizations(ctx context.Context, versionID string, opts ...asc.AppStoreVersionLocalizationsOption) (*asc.AppStoreVersionLocalizationsResponse, error)
+	CreateAppStoreVersionLocalization(ctx context.Context, versionID string, attributes asc.AppStoreVersionLocalizationAttributes) (*asc.AppStoreVersionLocalizationResponse, error)
+	UpdateAppStoreVersionLocalization(ctx context.Context, localizationID string, attributes asc.AppStoreVersionLocalizationAttributes) (*asc.AppStoreVersionLocalizationResponse, error)
+	GetAppInfoLocalizations(ctx context.Context, appInfoID string, opts ...asc.AppInfoLocalizationsOption) (*asc.AppInfoLocalizationsResponse, error)
+	CreateAppInfoLocalization(ctx context.Context, appInfoID string, attributes asc.AppInfoLocalizationAttributes) (*asc.AppInfoLocalizationResponse, error)
+	UpdateAppInfoLocalization(ctx context.Context, localizationID string, attributes asc.AppInfoLocalizationAttributes) (*asc.AppInfoLocalizationResponse, error)
+	GetAppInfoPrimaryCategoryRelationship(ctx context.Context, appInfoID string) (*asc.AppInfoPrimaryCategoryLinkageResponse, error)
+	GetAppInfoSecondaryCategoryRelationship(ctx context.Context, appInfoID string) (*asc.AppInfoSecondaryCategoryLinkageResponse, error)
+	UpdateAppInfoCategories(ctx context.Context, appInfoID string, primaryCategoryID, secondaryCategoryID string) (*asc.AppInfoResponse, error)
+	GetAgeRatingDeclarationForAppInfo(ctx context.Context, appInfoID string) (*asc.AgeRatingDeclarationResponse, error)
+	UpdateAgeRatingDeclaration(ctx context.Context, declarationID string, attributes asc.AgeRatingDeclarationAttributes) (*asc.AgeRatingDeclarationResponse, error)
+	GetAppStoreReviewDetailForVersion(ctx context.Context, versionID string) (*asc.AppStoreReviewDetailResponse, error)
+	CreateAppStoreReviewDetail(ctx context.Context, versionID string, attrs *asc.AppStoreReviewDetailCreateAttributes) (*asc.AppStoreReviewDetailResponse, error)
+	UpdateAppStoreReviewDetail(ctx context.Context, detailID string, attrs asc.AppStoreReviewDetailUpdateAttributes) (*asc.AppStoreReviewDetailResponse, error)
+	GetAppScreenshotSets(ctx context.Context, localizationID string) (*asc.AppScreenshotSetsResponse, error)
+	GetAppScreenshots(ctx context.Context, setID string) (*asc.AppScreenshotsResponse, error)
+	ReorderAppScreenshots(ctx context.Context, setID string, screenshotIDs []string) error
+}
+
+// metadataChange describes one planned metadata change.
+type metadataChange struct {
+	Action   string
+	Resource string
+	Locale   string
+	Target   string
+	Fields   []string
+
+	values        map[string]string
+	primary       string
+	secondary     string
+	ageRating     asc.AgeRatingDeclarationAttributes
+	review        asc.AppStoreReviewDetailUpdateAttributes
+	screenshotIDs []string
+}
+
+func (c metadataChange) report() asc.MetadataChange {
+	return asc.MetadataChange{
+		Action:   c.Action,
+		Resource: c.Resource,
+		Locale:   c.Locale,
+		Target:   c.Target,
+		Fields:   c.Fields,
+	}
+}
+
+// liveMetadata is the current App Store Connect state in tree form, plus
+// the resource IDs needed to apply changes.
+type liveMetadata struct {
+	tree                   *MetadataTree
+	ageRatingID            string
+	reviewDetailID         string
+	versionLocalizationIDs map[string]string
+	appInfoLocalizationIDs map[string]string
+	screenshotSetIDs       map[string]map[string]string
+}
+
+// fetchLiveMetadata reads the metadata covered by the tree layout.
+func fetchLiveMetadata(ctx context.Context, client metadataClient, appInfoID, versionID string) (*liveMetadata, error) {
+	live := &liveMetadata{
+		tree:                   &MetadataTree{App: &AppMetadata{}, Locales: map[string]*LocaleMetadata{}},
+		versionLocalizationIDs: map[string]string{},
+		appInfoLocalizationIDs: map[string]string{},
+		screenshotSetIDs:       map[string]map[string]string{},
+	}
+	localeEntry := func(locale string) *LocaleMetadata {
+		loc, ok := live.tree.Locales[locale]
+		if !ok {
+			loc = &LocaleMetadata{}
+			live.tree.Locales[locale] = loc
+		}
+		return loc
+	}
+
+	primary, err := client.GetAppInfoPrimaryCategoryRelationship(ctx, appInfoID)
+	if err != nil && !isMetadataNotFound(err) {
+		return nil, fmt.Errorf("failed to fetch primary category: %w", err)
+	}
+	secondary, err := client.GetAppInfoSecondaryCategoryRelationship(ctx, appInfoID)
+	if err != nil && !isMetadataNotFound(err) {
+		return nil, fmt.Errorf("failed to fetch secondary category: %w", err)
+	}
+	categories := &CategoriesMetadata{}
+	if primary != nil {
+		categories.Primary = primary.Data.ID
+	}
+	if secondary != nil {
+		categories.Secondary = secondary.Data.ID
+	}
+	if categories.Primary != "" || categories.Secondary != "" {
+		live.tree.App.Categories = categories
+	}
+
+	ageRating, err := client.GetAgeRatingDeclarationForAppInfo(ctx, appInfoID)
+	if err != nil && !isMetadataNotFound(err) {
+		return nil, fmt.Errorf("failed to fetch age rating declaration: %w", err)
+	}
+	if ageRating != nil && ageRating.Data.ID != "" {
+		live.ageRatingID = ageRating.Data.ID
+		values, err := ageRatingValues(ageRating.Data.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) > 0 {
+			live.tree.App.AgeRating = values
+		}
+	}
+
+	review, err := client.GetAppStoreReviewDetailForVersion(ctx, versionID)
+	if err != nil && !isMetadataNotFound(err) {
+		return nil, fmt.Errorf("failed to fetch review details: %w", err)
+	}
+	if review != nil && review.Data.ID != "" {
+		live.reviewDetailID = review.Data.ID
+		attrs := review.Data.Attributes
+		required := attrs.DemoAccountRequired
+		live.tree.Review = &ReviewMetadata{
+			ContactFirstName:    attrs.ContactFirstName,
+			ContactLastName:     attrs.ContactLastName,
+			ContactPhone:        attrs.ContactPhone,
+			ContactEmail:        attrs.ContactEmail,
+			DemoAccountName:     attrs.DemoAccountName,
+			DemoAccountPassword: attrs.DemoAccountPassword,
+			DemoAccountRequired: &required,
+			Notes:               attrs.Notes,
+		}
+	}
+
+	appInfoLocs, err := client.GetAppInfoLocalizations(ctx, appInfoID, asc.WithAppInfoLocalizationsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch app info localizations: %w", err)
+	}
+	for _, item := range appInfoLocs.Data {
+		locale := strings.TrimSpace(item.Attributes.Locale)
+		if locale == "" {
+			continue
+		}
+		live.appInfoLocalizationIDs[locale] = item.ID
+		if values := shared.AppInfoLocalizationValues(item.Attributes); len(values) > 0 {
+			localeEntry(locale).AppInfo = values
+		}
+	}
+
+	versionLocs, err := client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch version localizations: %w", err)
+	}
+	for _, item := range versionLocs.Data {
+		locale := strings.TrimSpace(item.Attributes.Locale)
+		if locale == "" {
+			continue
+		}
+		live.versionLocalizationIDs[locale] = item.ID
+		if values := shared.VersionLocalizationValues(item.Attributes); len(values) > 0 {
+			localeEntry(locale).Version = values
+		}
+
+		sets, err := client.GetAppScreenshotSets(ctx, item.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch screenshot sets for %s: %w", locale, err)
+		}
+		for _, set := range sets.Data {
+			displayType := strings.TrimSpace(set.Attributes.ScreenshotDisplayType)
+			if displayType == "" {
+				continue
+			}
+			if live.screenshotSetIDs[locale] == nil {
+				live.screenshotSetIDs[locale] = map[string]string{}
+			}
+			live.screenshotSetIDs[locale][displayType] = set.ID
+
+			screenshots, err := client.GetAppScreenshots(ctx, set.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch screenshots for %s %s: %w", locale, displayType, err)
+			}
+			if len(screenshots.Data) == 0 {
+				continue
+			}
+			refs := make([]ScreenshotMetadata, 0, len(screenshots.Data))
+			for _, shot := range screenshots.Data {
+				refs = append(refs, ScreenshotMetadata{ID: shot.ID, FileName: shot.Attributes.FileName})
+			}
+			loc := localeEntry(locale)
+			if loc.Screenshots == nil {
+				loc.Screenshots = map[string][]ScreenshotMetadata{}
+			}
+			loc.Screenshots[displayType] = refs
+		}
+	}
+
+	if live.tree.App.Categories == nil && len(live.tree.App.AgeRating) == 0 {
+		live.tree.App = nil
+	}
+	return live, nil
+}
+
+// planMetadataPush computes the minimal set of changes that makes live match desired.
+func planMetadataPush(desired *MetadataTree, live *liveMetadata) ([]metadataChange, error) {
+	changes := make([]metadataChange, 0)
+
+	if desired.App != nil {
+		var liveApp AppMetadata
+		if live.tree.App != nil {
+			liveApp = *live.tree.App
+		}
+		if change := planCategories(desired.App.Categories, liveApp.Categories); change != nil {
+			changes = append(changes, *change)
+		}
+		change, err := planAgeRating(desired.App.AgeRating, liveApp.AgeRating, live.ageRatingID)
+		if err != nil {
+			return nil, err
+		}
+		if change != nil {
+			changes = append(changes, *change)
+		}
+	}
+
+	if desired.Review != nil {
+		if change := planReviewDetail(desired.Review, live.tree.Review, live.reviewDetailID); change != nil {
+			changes = append(changes, *change)
+		}
+	}
+
+	for _, locale := range sortedLocales(desired.Locales) {
+		want := desired.Locales[locale]
+		have := live.tree.Locales[locale]
+		if have == nil {
+			have = &LocaleMetadata{}
+		}
+
+		if change := planLocalization(metadataResourceAppInfoLocalization, locale, want.AppInfo, have.AppInfo, live.appInfoLocalizationIDs[locale]); change != nil {
+			changes = append(changes, *change)
+		}
+		if change := planLocalization(metadataResourceVersionLocalization, locale, want.Version, have.Version, live.versionLocalizationIDs[locale]); change != nil {
+			changes = append(changes, *change)
+		}
+
+		displayTypes := make([]string, 0, len(want.Screenshots))
+		for displayType := range want.Screenshots {
+			displayTypes = append(displayTypes, displayType)
+		}
+		sort.Strings(displayTypes)
+		for _, displayType := range displayTypes {
+			setID := live.screenshotSetIDs[locale][displayType]
+			if setID == "" {
+				return nil, fmt.Errorf("locale %s: screenshot set %s not found; upload screenshots first", locale, displayType)
+			}
+			wantIDs, err := resolveScreenshotOrder(want.Screenshots[displayType], have.Screenshots[displayType])
+			if err != nil {
+				return nil, fmt.Errorf("locale %s: screenshots %s: %w", locale, displayType, err)
+			}
+			if slices.Equal(wantIDs, screenshotIDs(have.Screenshots[displayType])) {
+				continue
+			}
+			changes = append(changes, metadataChange{
+				Action:        metadataActionReorder,
+				Resource:      metadataResourceScreenshotSet,
+				Locale:        locale,
+				Target:        setID,
+				Fields:        []string{displayType},
+				screenshotIDs: wantIDs,
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+func planCategories(want, have *CategoriesMetadata) *metadataChange {
+	if want == nil {
+		return nil
+	}
+	if have == nil {
+		have = &CategoriesMetadata{}
+	}
+	primary := strings.TrimSpace(want.Primary)
+	secondary := strings.TrimSpace(want.Secondary)
+
+	var fields []string
+	if primary != "" && primary != have.Primary {
+		fields = append(fields, "primary")
+	} else {
+		primary = have.Primary
+	}
+	if secondary != "" && secondary != have.Secondary {
+		fields = append(fields, "secondary")
+	} else {
+		secondary = have.Secondary
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return &metadataChange{
+		Action:    metadataActionUpdate,
+		Resource:  metadataResourceCategories,
+		Fields:    fields,
+		primary:   primary,
+		secondary: secondary,
+	}
+}
+
+func planAgeRating(want, have map[string]any, declarationID string) (*metadataChange, error) {
+	if len(want) == 0 {
+		return nil, nil
+	}
+	// Decode the full map first so unknown keys are reported even when unchanged.
+	if _, err := decodeAgeRating(want); err != nil {
+		return nil, err
+	}
+
+	changed := make(map[string]any)
+	for key, value := range want {
+		if value == nil {
+			continue
+		}
+		if !metadataValuesEqual(value, have[key]) {
+			changed[key] = value
+		}
+	}
+	if len(changed) == 0 {
+		return nil, nil
+	}
+	if declarationID == "" {
+		return nil, fmt.Errorf("age rating declaration not found for app info")
+	}
+	attrs, err := decodeAgeRating(changed)
+	if err != nil {
+		return nil, err
+	}
+	return &metadataChange{
+		Action:    metadataActionUpdate,
+		Resource:  metadataResourceAgeRating,
+		Target:    declarationID,
+		Fields:    sortedMapKeys(changed),
+		ageRating: attrs,
+	}, nil
+}
+
+func planReviewDetail(want, have *ReviewMetadata, detailID string) *metadataChange {
+	if have == nil {
+		have = &ReviewMetadata{}
+	}
+	attrs := asc.AppStoreReviewDetailUpdateAttributes{}
+	var fields []string
+	setString := func(name, wantValue, haveValue string, target **string) {
+		if wantValue == "" || (detailID != "" && wantValue == haveValue) {
+			return
+		}
+		value := wantValue
+		*target = &value
+		fields = append(fields, name)
+	}
+	setString("contactFirstName", want.ContactFirstName, have.ContactFirstName, &attrs.ContactFirstName)
+	setString("contactLastName", want.ContactLastName, have.ContactLastName, &attrs.ContactLastName)
+	setString("contactPhone", want.ContactPhone, have.ContactPhone, &attrs.ContactPhone)
+	setString("contactEmail", want.ContactEmail, have.ContactEmail, &attrs.ContactEmail)
+	setString("demoAccountName", want.DemoAccountName, have.DemoAccountName, &attrs.DemoAccountName)
+	setString("demoAccountPassword", want.DemoAccountPassword, have.DemoAccountPassword, &attrs.DemoAccountPassword)
+	if want.DemoAccountRequired != nil && (detailID == "" || have.DemoAccountRequired == nil || *have.DemoAccountRequired != *want.DemoAccountRequired) {
+		value := *want.DemoAccountRequired
+		attrs.DemoAccountRequired = &value
+		fields = append(fields, "demoAccountRequired")
+	}
+	setString("notes", want.Notes, have.Notes, &attrs.Notes)
+
+	if len(fields) == 0 {
+		return nil
+	}
+	action := metadataActionUpdate
+	if detailID == "" {
+		action = metadataActionCreate
+	}
+	return &metadataChange{
+		Action:   action,
+		Resource: metadataResourceReviewDetail,
+		Target:   detailID,
+		Fields:   fields,
+		review:   attrs,
+	}
+}
+
+func planLocalization(resource, locale string, want, have map[string]string, localizationID string) *metadataChange {
+	values := make(map[string]string)
+	for key, value := range want {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		if localizationID != "" && have[key] == value {
+			continue
+		}
+		values[key] = value
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	action := metadataActionUpdate
+	if localizationID == "" {
+		action = metadataActionCreate
+	}
+	return &metadataChange{
+		Action:   action,
+		Resource: resource,
+		Locale:   locale,
+		Target:   localizationID,
+		Fields:   sortedStringKeys(values),
+		values:   values,
+	}
+}
+
+// resolveScreenshotOrder maps the desired screenshot list to live IDs.
+// Entries match by ID, or by file name when no ID is given.
+func resolveScreenshotOrder(want, have []ScreenshotMetadata) ([]string, error) {
+	if len(want) != len(have) {
+		return nil, fmt.Errorf("lists %d screenshots but the set has %d; push only reorders existing screenshots", len(want), len(have))
+	}
+	byID := make(map[string]bool, len(have))
+	byName := make(map[string][]string, len(have))
+	for _, shot := range have {
+		byID[shot.ID] = true
+		byName[shot.FileName] = append(byName[shot.FileName], shot.ID)
+	}
+
+	ids := make([]string, 0, len(want))
+	seen := make(map[string]bool, len(want))
+	for _, shot := range want {
+		id := strings.TrimSpace(shot.ID)
+		switch {
+		case id != "":
+			if !byID[id] {
+				return nil, fmt.Errorf("screenshot %q not found in set", id)
+			}
+		case strings.TrimSpace(shot.FileName) != "":
+			matches := byName[strings.TrimSpace(shot.FileName)]
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("screenshot %q not found in set", shot.FileName)
+			}
+			if len(matches) > 1 {
+				return nil, fmt.Errorf("screenshot file name %q is ambiguous; use id", shot.FileName)
+			}
+			id = matches[0]
+		default:
+			return nil, fmt.Errorf("screenshot entries require id or fileName")
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("screenshot %q listed more than once", id)
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// applyMetadataChanges applies planned changes in order, stopping at the first failure.
+func applyMetadataChanges(ctx context.Context, client metadataClient, appInfoID, versionID string, changes []metadataChange) error {
+	for _, change := range changes {
+		var err error
+		switch change.Resource {
+		case metadataResourceCategories:
+			_, err = client.UpdateAppInfoCategories(ctx, appInfoID, change.primary, change.secondary)
+		case metadataResourceAgeRating:
+			_, err = client.UpdateAgeRatingDeclaration(ctx, change.Target, change.ageRating)
+		case metadataResourceReviewDetail:
+			if change.Action == metadataActionCreate {
+				attrs := asc.AppStoreReviewDetailCreateAttributes(change.review)
+				_, err = client.CreateAppStoreReviewDetail(ctx, versionID, &attrs)
+			} else {
+				_, err = client.UpdateAppStoreReviewDetail(ctx, change.Target, change.review)
+			}
+		case metadataResourceAppInfoLocalization:
+			attrs := shared.BuildAppInfoLocalizationAttributes(change.Locale, change.values, change.Action == metadataActionCreate)
+			if change.Action == metadataActionCreate {
+				_, err = client.CreateAppInfoLocalization(ctx, appInfoID, attrs)
+			} else {
+				_, err = client.UpdateAppInfoLocalization(ctx, change.Target, attrs)
+			}
+		case metadataResourceVersionLocalization:
+			attrs := shared.BuildVersionLocalizationAttributes(change.Locale, change.values, change.Action == metadataActionCreate)
+			if change.Action == metadataActionCreate {
+				_, err = client.CreateAppStoreVersionLocalization(ctx, versionID, attrs)
+			} else {
+				_, err = client.UpdateAppStoreVersionLocalization(ctx, change.Target, attrs)
+			}
+		case metadataResourceScreenshotSet:
+			err = client.ReorderAppScreenshots(ctx, change.Target, change.screenshotIDs)
+		default:
+			err = fmt.Errorf("unsupported resource %q", change.Resource)
+		}
+		if err != nil {
+			return fmt.Errorf("%s %s%s: %w", change.Action, change.Resource, formatMetadataLocale(change.Locale), err)
+		}
+	}
+	return nil
+}
+
+func formatMetadataLocale(locale string) string {
+	if locale == "" {
+		return ""
+	}
+	return " (" + locale + ")"
+}
+
+func ageRatingValues(attrs asc.AgeRatingDeclarationAttributes) (map[string]any, error) {
+	data, err := json.Marshal(attrs)
+	if err != nil {
+		return nil, fmt.Errorf("encode age rating declaration: %w", err)
+	}
+	var values map[string]any
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("decode age rating declaration: %w", err)
+	}
+	return values, nil
+}
+
+func decodeAgeRating(values map[string]any) (asc.AgeRatingDeclarationAttributes, error) {
+	var attrs asc.AgeRatingDeclarationAttributes
+	data, err := json.Marshal(values)
+	if err != nil {
+		return attrs, fmt.Errorf("ageRating: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&attrs); err != nil {
+		return attrs, fmt.Errorf("ageRating: %w", err)
+	}
+	return attrs, nil
+}
+
+func metadataValuesEqual(a, b any) bool {
+	left, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	right, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(left, right)
+}
+
+func screenshotIDs(shots []ScreenshotMetadata) []string {
+	ids := make([]string, 0, len(shots))
+	for _, shot := range shots {
+		ids = append(ids, shot.ID)
+	}
+	return ids
+}
+
+func sortedMapKeys(values map[string]any) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedStringKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func isMetadataNotFound(err error) bool {
+	if asc.IsNotFound(err) {
+		return true
+	}
+	if apiErr, ok := errors.AsType[*asc.APIError](err); ok {
+		return apiErr.StatusCode == http.StatusNotFound || strings.EqualFold(apiErr.Code, "NOT_FOUND")
+	}
+	return false
+}
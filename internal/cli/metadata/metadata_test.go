@@ -0,0 +1,309 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMetadataCommandShape(t *testing.T) {
+	cmd := MetadataCommand()
+	if cmd.Name != "metadata" {
+		t.Fatalf("unexpected command name: %q", cmd.Name)
+	}
+	if len(cmd.Subcommands) != 2 {
+		t.Fatalf("expected 2 subcommands, got %d", len(cmd.Subcommands))
+	}
+	if got := Command(); got == nil {
+		t.Fatal("expected Command wrapper to return command")
+	}
+}
+
+func TestMetadataValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "missing dir", args: []string{"--app", "APP", "--version", "1.0"}},
+		{name: "missing version", args: []string{"--app", "APP", "--dir", "meta"}},
+		{name: "missing app", args: []string{"--version", "1.0", "--dir", "meta"}},
+		{name: "version and version-id", args: []string{"--app", "APP", "--version", "1.0", "--version-id", "V", "--dir", "meta"}},
+	}
+	for _, test := range tests {
+		for _, cmd := range []func() error{
+			func() error {
+				c := MetadataPullCommand()
+				if err := c.FlagSet.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				return c.Exec(context.Background(), nil)
+			},
+			func() error {
+				c := MetadataPushCommand()
+				if err := c.FlagSet.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				return c.Exec(context.Background(), nil)
+			},
+		} {
+			t.Run(test.name, func(t *testing.T) {
+				if err := cmd(); !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+		}
+	}
+}
+
+func TestMetadataTreeRoundTrip(t *testing.T) {
+	required := true
+	tree := &MetadataTree{
+		App: &AppMetadata{
+			Categories: &CategoriesMetadata{Primary: "GAMES", Secondary: "ENTERTAINMENT"},
+			AgeRating:  map[string]any{"gambling": false},
+		},
+		Review: &ReviewMetadata{ContactEmail: "review@example.com", DemoAccountRequired: &required},
+		Locales: map[string]*LocaleMetadata{
+			"en-US": {
+				AppInfo:     map[string]string{"name": "My App & More"},
+				Version:     map[string]string{"description": "Hello", "keywords": "a,b"},
+				Screenshots: map[string][]ScreenshotMetadata{"APP_IPHONE_67": {{ID: "S1", FileName: "one.png"}}},
+			},
+			"de-DE": {Version: map[string]string{"description": "Hallo"}},
+		},
+	}
+
+	for _, format := range []string{metadataFormatYAML, metadataFormatJSON} {
+		t.Run(format, func(t *testing.T) {
+			dir := t.TempDir()
+			files, err := writeMetadataTree(dir, tree, format)
+			if err != nil {
+				t.Fatalf("write error: %v", err)
+			}
+			want := []string{
+				filepath.Join(dir, "app."+format),
+				filepath.Join(dir, "review."+format),
+				filepath.Join(dir, "locales", "de-DE."+format),
+				filepath.Join(dir, "locales", "en-US."+format),
+			}
+			if !reflect.DeepEqual(files, want) {
+				t.Fatalf("files = %v, want %v", files, want)
+			}
+
+			got, err := readMetadataTree(dir)
+			if err != nil {
+				t.Fatalf("read error: %v", err)
+			}
+			if !reflect.DeepEqual(got.App.Categories, tree.App.Categories) {
+				t.Fatalf("categories = %+v", got.App.Categories)
+			}
+			if got.App.AgeRating["gambling"] != false {
+				t.Fatalf("age rating = %+v", got.App.AgeRating)
+			}
+			if !reflect.DeepEqual(got.Review, tree.Review) {
+				t.Fatalf("review = %+v", got.Review)
+			}
+			if !reflect.DeepEqual(got.Locales, tree.Locales) {
+				t.Fatalf("locales = %+v", got.Locales)
+			}
+		})
+	}
+}
+
+func TestReadMetadataTreeRejectsInvalidInput(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name:    "empty directory",
+			files:   map[string]string{},
+			wantErr: "no metadata files found",
+		},
+		{
+			name:    "unknown app key",
+			files:   map[string]string{"app.yaml": "category: GAMES\n"},
+			wantErr: "field category not found",
+		},
+		{
+			name:    "unknown version key",
+			files:   map[string]string{"locales/en-US.yaml": "version:\n  title: Nope\n"},
+			wantErr: "title",
+		},
+		{
+			name:    "invalid locale",
+			files:   map[string]string{"locales/not a locale.json": "{}"},
+			wantErr: "invalid locale",
+		},
+		{
+			name:    "duplicate formats",
+			files:   map[string]string{"review.yaml": "notes: a\n", "review.json": `{"notes":"b"}`},
+			wantErr: "keep only one",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range test.files {
+				path := filepath.Join(dir, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatalf("mkdir: %v", err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+					t.Fatalf("write: %v", err)
+				}
+			}
+			_, err := readMetadataTree(dir)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func testLiveMetadata() *liveMetadata {
+	required := false
+	return &liveMetadata{
+		tree: &MetadataTree{
+			App: &AppMetadata{
+				Categories: &CategoriesMetadata{Primary: "GAMES"},
+				AgeRating:  map[string]any{"gambling": false, "violenceCartoonOrFantasy": "NONE"},
+			},
+			Review: &ReviewMetadata{ContactEmail: "old@example.com", DemoAccountRequired: &required},
+			Locales: map[string]*LocaleMetadata{
+				"en-US": {
+					AppInfo: map[string]string{"name": "App"},
+					Version: map[string]string{"description": "Old", "keywords": "a"},
+					Screenshots: map[string][]ScreenshotMetadata{
+						"APP_IPHONE_67": {{ID: "S1", FileName: "one.png"}, {ID: "S2", FileName: "two.png"}},
+					},
+				},
+			},
+		},
+		ageRatingID:            "AGE_1",
+		reviewDetailID:         "REVIEW_1",
+		versionLocalizationIDs: map[string]string{"en-US": "VLOC_1"},
+		appInfoLocalizationIDs: map[string]string{"en-US": "ILOC_1"},
+		screenshotSetIDs:       map[string]map[string]string{"en-US": {"APP_IPHONE_67": "SET_1"}},
+	}
+}
+
+func TestPlanMetadataPushNoChanges(t *testing.T) {
+	live := testLiveMetadata()
+	changes, err := planMetadataPush(live.tree, live)
+	if err != nil {
+		t.Fatalf("plan error: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("expected no changes, got %+v", changes)
+	}
+}
+
+func TestPlanMetadataPushChanges(t *testing.T) {
+	live := testLiveMetadata()
+	desired := &MetadataTree{
+		App: &AppMetadata{
+			Categories: &CategoriesMetadata{Primary: "GAMES", Secondary: "PUZZLE"},
+			AgeRating:  map[string]any{"gambling": true, "violenceCartoonOrFantasy": "NONE"},
+		},
+		Review: &ReviewMetadata{ContactEmail: "new@example.com"},
+		Locales: map[string]*LocaleMetadata{
+			"en-US": {
+				Version: map[string]string{"description": "New", "keywords": "a", "whatsNew": ""},
+				Screenshots: map[string][]ScreenshotMetadata{
+					"APP_IPHONE_67": {{FileName: "two.png"}, {ID: "S1"}},
+				},
+			},
+			"fr-FR": {AppInfo: map[string]string{"name": "Appli"}},
+		},
+	}
+
+	changes, err := planMetadataPush(desired, live)
+	if err != nil {
+		t.Fatalf("plan error: %v", err)
+	}
+
+	type summary struct {
+		action, resource, locale, target string
+		fields                           []string
+	}
+	var got []summary
+	for _, change := range changes {
+		got = append(got, summary{change.Action, change.Resource, change.Locale, change.Target, change.Fields})
+	}
+	want := []summary{
+		{metadataActionUpdate, metadataResourceCategories, "", "", []string{"secondary"}},
+		{metadataActionUpdate, metadataResourceAgeRating, "", "AGE_1", []string{"gambling"}},
+		{metadataActionUpdate, metadataResourceReviewDetail, "", "REVIEW_1", []string{"contactEmail"}},
+		{metadataActionUpdate, metadataResourceVersionLocalization, "en-US", "VLOC_1", []string{"description"}},
+		{metadataActionReorder, metadataResourceScreenshotSet, "en-US", "SET_1", []string{"APP_IPHONE_67"}},
+		{metadataActionCreate, metadataResourceAppInfoLocalization, "fr-FR", "", []string{"name"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("changes = %+v\nwant %+v", got, want)
+	}
+	if changes[0].primary != "GAMES" || changes[0].secondary != "PUZZLE" {
+		t.Fatalf("expected categories to keep primary, got %q/%q", changes[0].primary, changes[0].secondary)
+	}
+	if !reflect.DeepEqual(changes[4].screenshotIDs, []string{"S2", "S1"}) {
+		t.Fatalf("screenshot order = %v", changes[4].screenshotIDs)
+	}
+}
+
+func TestPlanMetadataPushErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		desired *MetadataTree
+		wantErr string
+	}{
+		{
+			name:    "unknown age rating key",
+			desired: &MetadataTree{App: &AppMetadata{AgeRating: map[string]any{"unknownRating": "NONE"}}},
+			wantErr: "unknownRating",
+		},
+		{
+			name: "screenshot count mismatch",
+			desired: &MetadataTree{Locales: map[string]*LocaleMetadata{"en-US": {
+				Screenshots: map[string][]ScreenshotMetadata{"APP_IPHONE_67": {{ID: "S1"}}},
+			}}},
+			wantErr: "push only reorders existing screenshots",
+		},
+		{
+			name: "unknown screenshot",
+			desired: &MetadataTree{Locales: map[string]*LocaleMetadata{"en-US": {
+				Screenshots: map[string][]ScreenshotMetadata{"APP_IPHONE_67": {{ID: "S1"}, {ID: "S9"}}},
+			}}},
+			wantErr: `screenshot "S9" not found`,
+		},
+		{
+			name: "duplicate screenshot",
+			desired: &MetadataTree{Locales: map[string]*LocaleMetadata{"en-US": {
+				Screenshots: map[string][]ScreenshotMetadata{"APP_IPHONE_67": {{ID: "S1"}, {FileName: "one.png"}}},
+			}}},
+			wantErr: "listed more than once",
+		},
+		{
+			name: "missing screenshot set",
+			desired: &MetadataTree{Locales: map[string]*LocaleMetadata{"en-US": {
+				Screenshots: map[string][]ScreenshotMetadata{"APP_IPAD_PRO_129": {{ID: "S1"}}},
+			}}},
+			wantErr: "upload screenshots first",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := planMetadataPush(test.desired, testLiveMetadata())
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	metadataFormatYAML = "yaml"
+	metadataFormatJSON = "json"
+
+	metadataAppFileName    = "app"
+	metadataReviewFileName = "review"
+	metadataLocalesDirName = "locales"
+)
+
+var metadataFileExtensions = []string{".yaml", ".yml", ".json"}
+
+// MetadataTree is the canonical on-disk metadata for an app version:
+//
+//	<dir>/
+//	├── app.yaml        categories and age rating declaration
+//	├── review.yaml     App Review contact details, demo account, and notes
+//	└── locales/
+//	    └── en-US.yaml  app info and version localization, screenshot order
+//
+// Files may be YAML (.yaml, .yml) or JSON (.json). Keys match App Store
+// Connect attribute names. Missing or empty fields are left unchanged on push.
+type MetadataTree struct {
+	App     *AppMetadata
+	Review  *ReviewMetadata
+	Locales map[string]*LocaleMetadata
+}
+
+// AppMetadata holds app-level metadata stored in app.yaml.
+type AppMetadata struct {
+	Categories *CategoriesMetadata `yaml:"categories,omitempty" json:"categories,omitempty"`
+	AgeRating  map[string]any      `yaml:"ageRating,omitempty" json:"ageRating,omitempty"`
+}
+
+// CategoriesMetadata holds the primary and secondary category IDs.
+type CategoriesMetadata struct {
+	Primary   string `yaml:"primary,omitempty" json:"primary,omitempty"`
+	Secondary string `yaml:"secondary,omitempty" json:"secondary,omitempty"`
+}
+
+// ReviewMetadata holds App Review details stored in review.yaml.
+// Pull never writes demoAccountPassword; set it locally if push should manage it.
+type ReviewMetadata struct {
+	ContactFirstName    string `yaml:"contactFirstName,omitempty" json:"contactFirstName,omitempty"`
+	ContactLastName     string `yaml:"contactLastName,omitempty" json:"contactLastName,omitempty"`
+	ContactPhone        string `yaml:"contactPhone,omitempty" json:"contactPhone,omitempty"`
+	ContactEmail        string `yaml:"contactEmail,omitempty" json:"contactEmail,omitempty"`
+	DemoAccountName     string `yaml:"demoAccountName,omitempty" json:"demoAccountName,omitempty"`
+	DemoAccountPassword string `yaml:"demoAccountPassword,omitempty" json:"demoAccountPassword,omitempty"`
+	DemoAccountRequired *bool  `yaml:"demoAccountRequired,omitempty" json:"demoAccountRequired,omitempty"`
+	Notes               string `yaml:"notes,omitempty" json:"notes,omitempty"`
+}
+
+// LocaleMetadata holds per-locale metadata stored in locales/<locale>.yaml.
+type LocaleMetadata struct {
+	AppInfo     map[string]string               `yaml:"appInfo,omitempty" json:"appInfo,omitempty"`
+	Version     map[string]string               `yaml:"version,omitempty" json:"version,omitempty"`
+	Screenshots map[string][]ScreenshotMetadata `yaml:"screenshots,omitempty" json:"screenshots,omitempty"`
+}
+
+// ScreenshotMetadata identifies a screenshot within a set, in display order.
+type ScreenshotMetadata struct {
+	ID       string `yaml:"id,omitempty" json:"id,omitempty"`
+	FileName string `yaml:"fileName,omitempty" json:"fileName,omitempty"`
+}
+
+func normalizeMetadataFormat(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", metadataFormatYAML, "yml":
+		return metadataFormatYAML, nil
+	case metadataFormatJSON:
+		return metadataFormatJSON, nil
+	default:
+		return "", fmt.Errorf("--format must be yaml or json")
+	}
+}
+
+// readMetadataTree loads a metadata tree from dir.
+func readMetadataTree(dir string) (*MetadataTree, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%q is not a directory", dir)
+	}
+
+	tree := &MetadataTree{Locales: map[string]*LocaleMetadata{}}
+
+	appPath, err := findMetadataFile(dir, metadataAppFileName)
+	if err != nil {
+		return nil, err
+	}
+	if appPath != "" {
+		tree.App = &AppMetadata{}
+		if err := decodeMetadataFile(appPath, tree.App); err != nil {
+			return nil, err
+		}
+	}
+
+	reviewPath, err := findMetadataFile(dir, metadataReviewFileName)
+	if err != nil {
+		return nil, err
+	}
+	if reviewPath != "" {
+		tree.Review = &ReviewMetadata{}
+		if err := decodeMetadataFile(reviewPath, tree.Review); err != nil {
+			return nil, err
+		}
+	}
+
+	localesDir := filepath.Join(dir, metadataLocalesDirName)
+	entries, err := os.ReadDir(localesDir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("read locales: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(entry.Name())
+		if !isMetadataFileExtension(ext) {
+			continue
+		}
+		locale := strings.TrimSuffix(entry.Name(), ext)
+		if !shared.IsValidLocale(locale) {
+			return nil, fmt.Errorf("invalid locale file name %q", entry.Name())
+		}
+		if _, exists := tree.Locales[locale]; exists {
+			return nil, fmt.Errorf("duplicate locale %q in %s", locale, localesDir)
+		}
+		path := filepath.Join(localesDir, entry.Name())
+		loc := &LocaleMetadata{}
+		if err := decodeMetadataFile(path, loc); err != nil {
+			return nil, err
+		}
+		if err := shared.ValidateAppInfoLocalizationKeys(locale, loc.AppInfo); err != nil {
+			return nil, fmt.Errorf("%s: appInfo: %w", path, err)
+		}
+		if err := shared.ValidateVersionLocalizationKeys(locale, loc.Version); err != nil {
+			return nil, fmt.Errorf("%s: version: %w", path, err)
+		}
+		tree.Locales[locale] = loc
+	}
+
+	if tree.App == nil && tree.Review == nil && len(tree.Locales) == 0 {
+		return nil, fmt.Errorf("no metadata files found in %q", dir)
+	}
+	return tree, nil
+}
+
+func findMetadataFile(dir, name string) (string, error) {
+	found := ""
+	for _, ext := range metadataFileExtensions {
+		path := filepath.Join(dir, name+ext)
+		info, err := os.Stat(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return "", err
+		}
+		if info.IsDir() {
+			continue
+		}
+		if found != "" {
+			return "", fmt.Errorf("both %s and %s exist; keep only one", filepath.Base(found), filepath.Base(path))
+		}
+		found = path
+	}
+	return found, nil
+}
+
+func isMetadataFileExtension(ext string) bool {
+	for _, candidate := range metadataFileExtensions {
+		if strings.EqualFold(ext, candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeMetadataFile(path string, target any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(target); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("parse %s: %w", path, err)
+		}
+		return nil
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(target); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+	return nil
+}
+
+// writeMetadataTree writes tree to dir and returns the files written.
+func writeMetadataTree(dir string, tree *MetadataTree, format string) ([]string, error) {
+	ext := "." + format
+	files := make([]string, 0, len(tree.Locales)+2)
+
+	if !asc.SimulationActive() {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("create directory: %w", err)
+		}
+	}
+	if tree.App != nil {
+		path := filepath.Join(dir, metadataAppFileName+ext)
+		if err := encodeMetadataFile(path, tree.App, format); err != nil {
+			return nil, err
+		}
+		files = append(files, path)
+	}
+	if tree.Review != nil {
+		path := filepath.Join(dir, metadataReviewFileName+ext)
+		if err := encodeMetadataFile(path, tree.Review, format); err != nil {
+			return nil, err
+		}
+		files = append(files, path)
+	}
+
+	locales := sortedLocales(tree.Locales)
+	if len(locales) > 0 {
+		localesDir := filepath.Join(dir, metadataLocalesDirName)
+		if !asc.SimulationActive() {
+			if err := os.MkdirAll(localesDir, 0o755); err != nil {
+				return nil, fmt.Errorf("create locales directory: %w", err)
+			}
+		}
+		for _, locale := range locales {
+			if !shared.IsValidLocale(locale) {
+				return nil, fmt.Errorf("invalid locale %q", locale)
+			}
+			path := filepath.Join(localesDir, locale+ext)
+			if err := encodeMetadataFile(path, tree.Locales[locale], format); err != nil {
+				return nil, err
+			}
+			files = append(files, path)
+		}
+	}
+	return files, nil
+}
+
+func encodeMetadataFile(path string, value any, format string) error {
+	var buf bytes.Buffer
+	switch format {
+	case metadataFormatJSON:
+		encoder := json.NewEncoder(&buf)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(value); err != nil {
+			return fmt.Errorf("encode %s: %w", path, err)
+		}
+	default:
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(value); err != nil {
+			return fmt.Errorf("encode %s: %w", path, err)
+		}
+		if err := encoder.Close(); err != nil {
+			return fmt.Errorf("encode %s: %w", path, err)
+		}
+	}
+	if asc.SimulateLocalChange("WRITE", path) {
+		return nil
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
+
+func sortedLocales(locales map[string]*LocaleMetadata) []string {
+	keys := make([]string, 0, len(locales))
+	for locale := range locales {
+		keys = append(keys, locale)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -52,8 +52,10 @@ func MigrateImportCommand() *ffcli.Command {
 	versionID := fs.String("version-id", "", "App Store version ID (required unless Deliverfile app_version + platform)")
 	fastlaneDir := fs.String("fastlane-dir", "", "Path to fastlane directory (optional)")
 	dryRun := fs.Bool("dry-run", false, "Preview changes without uploading")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+	shared.ResolveNames(fs, shared.ResourceVersion, "version-id")
 
 	return &ffcli.Command{
 		Name:       "import",
@@ -270,8 +272,10 @@ func MigrateExportCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
 	versionID := fs.String("version-id", "", "App Store version ID (required)")
 	outputDir := fs.String("output-dir", "", "Output directory for fastlane structure (required)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+	shared.ResolveNames(fs, shared.ResourceVersion, "version-id")
 
 	return &ffcli.Command{
 		Name:       "export",
@@ -569,6 +573,19 @@ func printMigrateOutput(data any, format string, pretty bool) error {
 		}
 		return asc.PrintJSON(data)
 	}
+	if format == "csv" || format == "ndjson" {
+		if pretty {
+			return fmt.Errorf("--pretty is only valid with JSON output")
+		}
+		headers, rows, ok := migrateOutputRows(data)
+		if !ok {
+			return asc.PrintJSON(data)
+		}
+		if format == "csv" {
+			return asc.RenderCSV(headers, rows)
+		}
+		return asc.RenderNDJSON(headers, rows)
+	}
 
 	switch v := data.(type) {
 	case *MigrateImportResult:
@@ -694,7 +711,7 @@ func MigrateValidateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("migrate validate", flag.ExitOnError)
 
 	fastlaneDir := fs.String("fastlane-dir", "", "Path to fastlane directory (required)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -373,3 +373,61 @@ func printMigrateValidateResultTable(result *MigrateValidateResult) error {
 
 	return nil
 }
+
+// migrateOutputRows flattens migrate results into a single table for csv and
+// ndjson output. Import results get one row per planned or applied item.
+func migrateOutputRows(data any) ([]string, [][]string, bool) {
+	switch v := data.(type) {
+	case *MigrateImportResult:
+		headers := []string{"Section", "Locale", "Item", "Detail"}
+		var rows [][]string
+		for _, item := range v.MetadataFiles {
+			rows = append(rows, []string{"metadataFiles", item.Locale, strings.Join(item.Files, ", "), ""})
+		}
+		for _, item := range v.AppInfoFiles {
+			rows = append(rows, []string{"appInfoFiles", item.Locale, strings.Join(item.Files, ", "), ""})
+		}
+		for _, plan := range v.ScreenshotPlan {
+			rows = append(rows, []string{"screenshotPlan", plan.Locale, plan.DisplayType, strings.Join(plan.Files, ", ")})
+		}
+		for _, item := range v.Skipped {
+			rows = append(rows, []string{"skipped", "", item.Path, item.Reason})
+		}
+		for _, item := range v.Uploaded {
+			rows = append(rows, []string{"uploaded", item.Locale, item.Action, fmt.Sprintf("%d fields", item.Fields)})
+		}
+		for _, item := range v.AppInfoUploaded {
+			rows = append(rows, []string{"appInfoUploaded", item.Locale, item.Action, fmt.Sprintf("%d fields", item.Fields)})
+		}
+		if v.ReviewInfoResult != nil {
+			rows = append(rows, []string{"reviewInformation", "", v.ReviewInfoResult.Action, v.ReviewInfoResult.DetailID})
+		}
+		for _, item := range v.ScreenshotResults {
+			rows = append(rows, []string{"screenshotUploads", item.Locale, item.DisplayType, fmt.Sprintf("%d uploaded, %d skipped", len(item.Uploaded), len(item.Skipped))})
+		}
+		return headers, rows, true
+	case *MigrateExportResult:
+		headers := []string{"Locale", "Output Dir"}
+		rows := make([][]string, 0, len(v.Locales))
+		for _, locale := range v.Locales {
+			rows = append(rows, []string{locale, v.OutputDir})
+		}
+		return headers, rows, true
+	case *MigrateValidateResult:
+		headers := []string{"Locale", "Field", "Severity", "Message", "Length", "Limit"}
+		rows := make([][]string, 0, len(v.Issues))
+		for _, issue := range v.Issues {
+			length, limit := "", ""
+			if issue.Length > 0 {
+				length = fmt.Sprintf("%d", issue.Length)
+			}
+			if issue.Limit > 0 {
+				limit = fmt.Sprintf("%d", issue.Limit)
+			}
+			rows = append(rows, []string{issue.Locale, issue.Field, issue.Severity, issue.Message, length, limit})
+		}
+		return headers, rows, true
+	default:
+		return nil, nil, false
+	}
+}
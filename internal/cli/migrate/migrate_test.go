@@ -586,3 +586,26 @@ func TestValidateAppInfoLocalization_UsesRuneCount(t *testing.T) {
 		}
 	}
 }
+
+func TestMigrateOutputRows_ValidateResult(t *testing.T) {
+	result := &MigrateValidateResult{
+		Issues: []ValidationIssue{
+			{Locale: "en-US", Field: "keywords", Severity: "error", Message: "too long", Length: 120, Limit: 100},
+			{Locale: "de-DE", Field: "description", Severity: "warning", Message: "empty"},
+		},
+	}
+
+	headers, rows, ok := migrateOutputRows(result)
+	if !ok {
+		t.Fatal("expected validate result to have rows")
+	}
+	if len(headers) != 6 || len(rows) != 2 {
+		t.Fatalf("unexpected rows: %v %v", headers, rows)
+	}
+	if rows[0][4] != "120" || rows[0][5] != "100" || rows[1][4] != "" || rows[1][5] != "" {
+		t.Fatalf("expected raw lengths and empty cells for missing values, got %v", rows)
+	}
+	if _, _, ok := migrateOutputRows(struct{}{}); ok {
+		t.Fatal("expected unknown types to have no rows")
+	}
+}
@@ -0,0 +1,108 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const discordWebhookEnvVar = "ASC_DISCORD_WEBHOOK"
+
+// DiscordCommand returns the notify discord subcommand.
+func DiscordCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notify discord", flag.ExitOnError)
+
+	webhook := fs.String("webhook", "", "Discord webhook URL (or set "+discordWebhookEnvVar+" env var)")
+	message := fs.String("message", "", "Message to send to Discord")
+	title := fs.String("title", "", "Send the message as an embed with this title")
+	color := fs.String("color", "", "Embed color as hex (e.g. 2ecc71)")
+	username := fs.String("username", "", "Override the webhook's display name")
+	embedsJSON := fs.String("embeds-json", "", "Discord embeds JSON array")
+
+	return &ffcli.Command{
+		Name:       "discord",
+		ShortUsage: "asc notify discord --webhook URL --message TEXT [flags]",
+		ShortHelp:  "Send a message or embed to Discord via webhook.",
+		LongHelp: `Send a message or embed to Discord via webhook.
+
+With --title, the message is sent as an embed description. Pass --embeds-json
+to send your own embeds. The webhook URL can be provided via --webhook flag,
+ASC_DISCORD_WEBHOOK env var, or notify.discord.webhook in config.json.
+
+Examples:
+  asc notify discord --webhook "https://discord.com/api/webhooks/..." --message "Build uploaded"
+  asc notify discord --title "TestFlight" --message "Build 1234 is in TestFlight" --color 2ecc71
+  asc notify discord --message "Release ready" --embeds-json '[{"title":"v2.1","url":"https://example.com"}]'`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			notifyConfig, err := loadNotifyConfig()
+			if err != nil {
+				return fmt.Errorf("notify discord: %w", err)
+			}
+			var configWebhook, configUsername string
+			if notifyConfig.Discord != nil {
+				configWebhook = notifyConfig.Discord.Webhook
+				configUsername = notifyConfig.Discord.Username
+			}
+
+			webhookURL := firstNonEmpty(*webhook, os.Getenv(discordWebhookEnvVar), configWebhook)
+			if webhookURL == "" {
+				return shared.UsageErrorf("--webhook is required or set %s env var", discordWebhookEnvVar)
+			}
+			if err := validateTargetURL("--webhook", webhookURL); err != nil {
+				return shared.UsageError(err.Error())
+			}
+			msg := strings.TrimSpace(*message)
+			if msg == "" {
+				return shared.UsageError("--message is required")
+			}
+
+			payload := map[string]any{}
+			if name := firstNonEmpty(*username, configUsername); name != "" {
+				payload["username"] = name
+			}
+			switch {
+			case strings.TrimSpace(*embedsJSON) != "":
+				var embeds []json.RawMessage
+				if err := json.Unmarshal([]byte(*embedsJSON), &embeds); err != nil {
+					return shared.UsageErrorf("--embeds-json must contain a JSON array: %v", err)
+				}
+				payload["content"] = msg
+				payload["embeds"] = embeds
+			case strings.TrimSpace(*title) != "":
+				embed := map[string]any{"title": strings.TrimSpace(*title), "description": msg}
+				if value := strings.TrimPrefix(strings.TrimSpace(*color), "#"); value != "" {
+					parsed, err := strconv.ParseUint(value, 16, 32)
+					if err != nil || parsed > 0xFFFFFF {
+						return shared.UsageError("--color must be a hex RGB value such as 2ecc71")
+					}
+					embed["color"] = parsed
+				}
+				payload["embeds"] = []any{embed}
+			default:
+				payload["content"] = msg
+			}
+
+			body, err := json.Marshal(payload)
+			if err != nil {
+				return fmt.Errorf("notify discord: failed to marshal payload: %w", err)
+			}
+			if err := postTarget(ctx, http.MethodPost, webhookURL, body, nil); err != nil {
+				return fmt.Errorf("notify discord: %w", err)
+			}
+
+			fmt.Fprintln(os.Stderr, "Message sent to Discord successfully")
+			return nil
+		},
+	}
+}
@@ -0,0 +1,254 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+const (
+	smtpHostEnvVar     = "ASC_SMTP_HOST"
+	smtpPortEnvVar     = "ASC_SMTP_PORT"
+	smtpUsernameEnvVar = "ASC_SMTP_USERNAME"
+	smtpPasswordEnvVar = "ASC_SMTP_PASSWORD"
+	smtpFromEnvVar     = "ASC_SMTP_FROM"
+	smtpToEnvVar       = "ASC_SMTP_TO"
+
+	defaultSMTPPort  = 587
+	implicitTLSPort  = 465
+	smtpDialTimeout  = 30 * time.Second
+	smtpHeaderLength = 998
+)
+
+// smtpSettings is the resolved email configuration.
+type smtpSettings struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+	to       []string
+}
+
+// EmailCommand returns the notify email subcommand.
+func EmailCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notify email", flag.ExitOnError)
+
+	to := fs.String("to", "", "Recipient addresses, comma-separated (or set "+smtpToEnvVar+" env var)")
+	from := fs.String("from", "", "Sender address (or set "+smtpFromEnvVar+" env var)")
+	subject := fs.String("subject", "", "Email subject")
+	message := fs.String("message", "", "Plain text email body")
+	host := fs.String("smtp-host", "", "SMTP server host (or set "+smtpHostEnvVar+" env var)")
+	port := fs.Int("smtp-port", 0, "SMTP server port (default 587; 465 uses implicit TLS)")
+	username := fs.String("smtp-username", "", "SMTP username (or set "+smtpUsernameEnvVar+" env var)")
+
+	return &ffcli.Command{
+		Name:       "email",
+		ShortUsage: "asc notify email --to ADDRESSES --subject TEXT --message TEXT [flags]",
+		ShortHelp:  "Send a plain text email over SMTP.",
+		LongHelp: `Send a plain text email over SMTP.
+
+Connection settings come from flags, ASC_SMTP_* env vars, or notify.email in
+config.json, in that order. The password is read from ASC_SMTP_PASSWORD or
+config only. Port 465 uses implicit TLS; other ports upgrade with STARTTLS
+when the server offers it. Authentication requires TLS except on localhost.
+
+Examples:
+  asc notify email --to team@example.com --subject "TestFlight" --message "Build 1234 is in TestFlight"
+  ASC_SMTP_HOST=smtp.example.com ASC_SMTP_USERNAME=ci ASC_SMTP_PASSWORD=secret asc notify email --from ci@example.com --to a@example.com,b@example.com --subject "Release" --message "v2.1 submitted"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			notifyConfig, err := loadNotifyConfig()
+			if err != nil {
+				return fmt.Errorf("notify email: %w", err)
+			}
+			emailConfig := notifyConfig.Email
+			if emailConfig == nil {
+				emailConfig = &config.EmailNotifyConfig{}
+			}
+
+			settings, err := resolveSMTPSettings(emailConfig, *host, *port, *username, *from, *to)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			subjectText := strings.TrimSpace(*subject)
+			if subjectText == "" {
+				return shared.UsageError("--subject is required")
+			}
+			body := strings.TrimSpace(*message)
+			if body == "" {
+				return shared.UsageError("--message is required")
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+			if err := sendEmail(requestCtx, settings, buildEmailMessage(settings, subjectText, body, time.Now())); err != nil {
+				return fmt.Errorf("notify email: %w", err)
+			}
+
+			fmt.Fprintf(os.Stderr, "Email sent to %s successfully\n", strings.Join(settings.to, ", "))
+			return nil
+		},
+	}
+}
+
+func resolveSMTPSettings(cfg *config.EmailNotifyConfig, host string, port int, username, from, to string) (smtpSettings, error) {
+	settings := smtpSettings{
+		host:     firstNonEmpty(host, os.Getenv(smtpHostEnvVar), cfg.Host),
+		username: firstNonEmpty(username, os.Getenv(smtpUsernameEnvVar), cfg.Username),
+		password: firstNonEmpty(os.Getenv(smtpPasswordEnvVar), cfg.Password),
+		from:     firstNonEmpty(from, os.Getenv(smtpFromEnvVar), cfg.From),
+	}
+	if settings.host == "" {
+		return smtpSettings{}, fmt.Errorf("--smtp-host is required or set %s env var", smtpHostEnvVar)
+	}
+
+	settings.port = port
+	if settings.port == 0 {
+		if raw := strings.TrimSpace(os.Getenv(smtpPortEnvVar)); raw != "" {
+			parsed, err := strconv.Atoi(raw)
+			if err != nil {
+				return smtpSettings{}, fmt.Errorf("%s must be a port number", smtpPortEnvVar)
+			}
+			settings.port = parsed
+		}
+	}
+	if settings.port == 0 {
+		settings.port = cfg.Port
+	}
+	if settings.port == 0 {
+		settings.port = defaultSMTPPort
+	}
+	if settings.port < 1 || settings.port > 65535 {
+		return smtpSettings{}, fmt.Errorf("--smtp-port must be between 1 and 65535")
+	}
+
+	if settings.from == "" {
+		return smtpSettings{}, fmt.Errorf("--from is required or set %s env var", smtpFromEnvVar)
+	}
+	if _, err := mail.ParseAddress(settings.from); err != nil {
+		return smtpSettings{}, fmt.Errorf("--from must be a valid email address")
+	}
+
+	recipients := shared.SplitCSV(firstNonEmpty(to, os.Getenv(smtpToEnvVar)))
+	if len(recipients) == 0 {
+		recipients = cfg.To
+	}
+	for _, recipient := range recipients {
+		if recipient = strings.TrimSpace(recipient); recipient == "" {
+			continue
+		}
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return smtpSettings{}, fmt.Errorf("--to contains an invalid address %q", recipient)
+		}
+		settings.to = append(settings.to, recipient)
+	}
+	if len(settings.to) == 0 {
+		return smtpSettings{}, fmt.Errorf("--to is required or set %s env var", smtpToEnvVar)
+	}
+	return settings, nil
+}
+
+// buildEmailMessage renders an RFC 5322 plain text message.
+func buildEmailMessage(settings smtpSettings, subject, body string, now time.Time) []byte {
+	var b strings.Builder
+	header := func(name, value string) {
+		value = strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+		if len(value) > smtpHeaderLength {
+			value = value[:smtpHeaderLength]
+		}
+		fmt.Fprintf(&b, "%s: %s\r\n", name, value)
+	}
+	header("From", settings.from)
+	header("To", strings.Join(settings.to, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=utf-8")
+	header("Content-Transfer-Encoding", "8bit")
+	b.WriteString("\r\n")
+	for _, line := range strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n") {
+		b.WriteString(line)
+		b.WriteString("\r\n")
+	}
+	return []byte(b.String())
+}
+
+func sendEmail(ctx context.Context, settings smtpSettings, message []byte) error {
+	address := net.JoinHostPort(settings.host, strconv.Itoa(settings.port))
+	dialer := &net.Dialer{Timeout: smtpDialTimeout}
+
+	var (
+		conn net.Conn
+		err  error
+	)
+	if settings.port == implicitTLSPort {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: settings.host}}).DialContext(ctx, "tcp", address)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", address, err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, settings.host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if settings.port != implicitTLSPort {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: settings.host}); err != nil {
+				return fmt.Errorf("STARTTLS failed: %w", err)
+			}
+		}
+	}
+	if settings.username != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("server does not support authentication")
+		}
+		if err := client.Auth(smtp.PlainAuth("", settings.username, settings.password, settings.host)); err != nil {
+			return fmt.Errorf("authentication failed: %w", err)
+		}
+	}
+	if err := client.Mail(settings.from); err != nil {
+		return fmt.Errorf("MAIL FROM rejected: %w", err)
+	}
+	for _, recipient := range settings.to {
+		if err := client.Rcpt(recipient); err != nil {
+			return fmt.Errorf("recipient %s rejected: %w", recipient, err)
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("DATA rejected: %w", err)
+	}
+	if _, err := writer.Write(message); err != nil {
+		_ = writer.Close()
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("message rejected: %w", err)
+	}
+	return client.Quit()
+}
@@ -17,6 +17,7 @@ import (
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
 )
 
 const (
@@ -27,7 +28,7 @@ const (
 	slackWebhookMaxResponseBodyBytes = 4096
 )
 
-var slackHTTPClient = func() *http.Client {
+var notifyHTTPClient = func() *http.Client {
 	return &http.Client{Timeout: asc.ResolveTimeout()}
 }
 
@@ -49,13 +50,24 @@ func NotifyCommand() *ffcli.Command {
 		ShortHelp:  "Send notifications to external services.",
 		LongHelp: `Send notifications to external services.
 
+Providers read default targets from the "notify" section of config.json, so
+pipelines only need to pass the message.
+
 Examples:
   asc notify slack --webhook $WEBHOOK --message "Build uploaded"
-  ASC_SLACK_WEBHOOK=$WEBHOOK asc notify slack --message "Done"`,
+  ASC_SLACK_WEBHOOK=$WEBHOOK asc notify slack --message "Done"
+  asc notify teams --title "TestFlight" --message "Build 1234 is in TestFlight"
+  asc notify discord --message "Build 1234 is in TestFlight"
+  asc notify webhook --url https://example.com/hook --template '{"build":{{json .Message}}}' --message "1234"
+  asc notify email --to team@example.com --subject "TestFlight" --message "Build 1234 is in TestFlight"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			SlackCommand(),
+			TeamsCommand(),
+			DiscordCommand(),
+			WebhookCommand(),
+			EmailCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -75,7 +87,8 @@ func SlackCommand() *ffcli.Command {
 		LongHelp: `Send a message to Slack via incoming webhook.
 
 This command sends a JSON payload to a Slack incoming webhook URL.
-The webhook URL can be provided via --webhook flag or ASC_SLACK_WEBHOOK env var.
+The webhook URL can be provided via --webhook flag, ASC_SLACK_WEBHOOK env var,
+or notify.slack.webhook in config.json.
 When using blocks, keep --message as the top-level text fallback.
 
 Examples:
@@ -87,7 +100,16 @@ Examples:
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
-			webhookURL := resolveWebhook(*webhook)
+			notifyConfig, err := loadNotifyConfig()
+			if err != nil {
+				return fmt.Errorf("notify slack: %w", err)
+			}
+			slackConfig := notifyConfig.Slack
+			if slackConfig == nil {
+				slackConfig = &config.SlackNotifyConfig{}
+			}
+
+			webhookURL := firstNonEmpty(resolveWebhook(*webhook), slackConfig.Webhook)
 			if webhookURL == "" {
 				fmt.Fprintf(os.Stderr, "Error: --webhook is required or set %s env var\n", slackWebhookEnvVar)
 				return flag.ErrHelp
@@ -112,45 +134,15 @@ Examples:
 			payload := map[string]any{}
 			payload["text"] = msg
 
-			if ch := strings.TrimSpace(*channel); ch != "" {
+			if ch := firstNonEmpty(*channel, slackConfig.Channel); ch != "" {
 				payload["channel"] = ch
 			}
 			if blocks != nil {
 				payload["blocks"] = blocks
 			}
 
-			body, err := json.Marshal(payload)
-			if err != nil {
-				return fmt.Errorf("notify slack: failed to marshal payload: %w", err)
-			}
-
-			requestCtx, cancel := shared.ContextWithTimeout(ctx)
-			defer cancel()
-
-			req, err := http.NewRequestWithContext(requestCtx, "POST", webhookURL, bytes.NewReader(body))
-			if err != nil {
-				return fmt.Errorf("notify slack: failed to create request: %w", err)
-			}
-			req.Header.Set("Content-Type", "application/json")
-
-			client := slackHTTPClient()
-			resp, err := client.Do(req)
-			if err != nil {
-				return fmt.Errorf("notify slack: failed to send: %w", err)
-			}
-			defer resp.Body.Close()
-
-			if resp.StatusCode != http.StatusOK {
-				limited := io.LimitReader(resp.Body, slackWebhookMaxResponseBodyBytes)
-				respBody, readErr := io.ReadAll(limited)
-				if readErr != nil {
-					return fmt.Errorf("notify slack: failed to read response: %w", readErr)
-				}
-				message := strings.TrimSpace(string(respBody))
-				if message == "" {
-					return fmt.Errorf("notify slack: unexpected response %d", resp.StatusCode)
-				}
-				return fmt.Errorf("notify slack: unexpected response %d: %s", resp.StatusCode, message)
+			if err := postSlack(ctx, webhookURL, payload); err != nil {
+				return fmt.Errorf("notify slack: %w", err)
 			}
 
 			fmt.Fprintln(os.Stderr, "Message sent to Slack successfully")
@@ -159,6 +151,51 @@ Examples:
 	}
 }
 
+// SendSlackMessage posts a plain text message to a Slack incoming webhook.
+func SendSlackMessage(ctx context.Context, webhookURL, text string) error {
+	if err := validateSlackWebhookURL(webhookURL); err != nil {
+		return err
+	}
+	return postSlack(ctx, webhookURL, map[string]any{"text": text})
+}
+
+func postSlack(ctx context.Context, webhookURL string, payload map[string]any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(requestCtx, "POST", webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := notifyHTTPClient()
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		limited := io.LimitReader(resp.Body, slackWebhookMaxResponseBodyBytes)
+		respBody, readErr := io.ReadAll(limited)
+		if readErr != nil {
+			return fmt.Errorf("failed to read response: %w", readErr)
+		}
+		message := strings.TrimSpace(string(respBody))
+		if message == "" {
+			return fmt.Errorf("unexpected response %d", resp.StatusCode)
+		}
+		return fmt.Errorf("unexpected response %d: %s", resp.StatusCode, message)
+	}
+	return nil
+}
+
 func resolveWebhook(flagValue string) string {
 	if v := strings.TrimSpace(flagValue); v != "" {
 		return v
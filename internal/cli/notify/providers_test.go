@@ -0,0 +1,282 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+)
+
+func runProvider(t *testing.T, cmd *ffcli.Command, args ...string) (string, error) {
+	t.Helper()
+	cmd.FlagSet.SetOutput(io.Discard)
+	var runErr error
+	_, stderr := captureOutput(t, func() {
+		if err := cmd.Parse(args); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = cmd.Run(context.Background())
+	})
+	return stderr, runErr
+}
+
+func captureRequest(t *testing.T, status int) (*httptest.Server, *http.Request, *[]byte) {
+	t.Helper()
+	var body []byte
+	received := &http.Request{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*received = *r.Clone(context.Background())
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, received, &body
+}
+
+func isolateNotifyConfig(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.json")
+	t.Setenv("ASC_CONFIG_PATH", path)
+	for _, name := range []string{teamsWebhookEnvVar, discordWebhookEnvVar, webhookURLEnvVar, smtpHostEnvVar, smtpPortEnvVar, smtpUsernameEnvVar, smtpPasswordEnvVar, smtpFromEnvVar, smtpToEnvVar} {
+		t.Setenv(name, "")
+	}
+	return path
+}
+
+func TestNotifyTeamsSendsAdaptiveCard(t *testing.T) {
+	isolateNotifyConfig(t)
+	server, _, body := captureRequest(t, http.StatusAccepted)
+
+	if _, err := runProvider(t, TeamsCommand(), "--webhook", server.URL, "--title", "TestFlight", "--message", "Build 1234 is in TestFlight"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var payload struct {
+		Type        string `json:"type"`
+		Attachments []struct {
+			ContentType string `json:"contentType"`
+			Content     struct {
+				Type string `json:"type"`
+				Body []struct {
+					Text string `json:"text"`
+				} `json:"body"`
+			} `json:"content"`
+		} `json:"attachments"`
+	}
+	if err := json.Unmarshal(*body, &payload); err != nil {
+		t.Fatalf("invalid payload %s: %v", *body, err)
+	}
+	if payload.Type != "message" || len(payload.Attachments) != 1 {
+		t.Fatalf("unexpected payload: %s", *body)
+	}
+	card := payload.Attachments[0]
+	if card.ContentType != "application/vnd.microsoft.card.adaptive" || card.Content.Type != "AdaptiveCard" {
+		t.Fatalf("unexpected attachment: %s", *body)
+	}
+	if len(card.Content.Body) != 2 || card.Content.Body[0].Text != "TestFlight" || card.Content.Body[1].Text != "Build 1234 is in TestFlight" {
+		t.Fatalf("unexpected card body: %s", *body)
+	}
+}
+
+func TestNotifyTeamsRejectsInsecureRemoteWebhook(t *testing.T) {
+	isolateNotifyConfig(t)
+	stderr, err := runProvider(t, TeamsCommand(), "--webhook", "http://example.com/hook", "--message", "hi")
+	if !errors.Is(err, flag.ErrHelp) || !strings.Contains(stderr, "--webhook must use https") {
+		t.Fatalf("expected usage error, got %v (%q)", err, stderr)
+	}
+}
+
+func TestNotifyDiscordSendsEmbedFromConfig(t *testing.T) {
+	configPath := isolateNotifyConfig(t)
+	server, _, body := captureRequest(t, http.StatusNoContent)
+	config := `{"notify":{"discord":{"webhook":"` + server.URL + `","username":"asc"}}}`
+	if err := os.WriteFile(configPath, []byte(config), 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	if _, err := runProvider(t, DiscordCommand(), "--title", "TestFlight", "--message", "Build 1234", "--color", "#2ecc71"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var payload struct {
+		Username string `json:"username"`
+		Embeds   []struct {
+			Title       string `json:"title"`
+			Description string `json:"description"`
+			Color       int    `json:"color"`
+		} `json:"embeds"`
+	}
+	if err := json.Unmarshal(*body, &payload); err != nil {
+		t.Fatalf("invalid payload %s: %v", *body, err)
+	}
+	if payload.Username != "asc" || len(payload.Embeds) != 1 {
+		t.Fatalf("unexpected payload: %s", *body)
+	}
+	if embed := payload.Embeds[0]; embed.Title != "TestFlight" || embed.Description != "Build 1234" || embed.Color != 0x2ecc71 {
+		t.Fatalf("unexpected embed: %+v", embed)
+	}
+}
+
+func TestNotifyWebhookRendersTemplate(t *testing.T) {
+	isolateNotifyConfig(t)
+	server, request, body := captureRequest(t, http.StatusOK)
+
+	_, err := runProvider(t, WebhookCommand(),
+		"--url", server.URL,
+		"--method", "put",
+		"--template", `{"build":{{json .Vars.build}},"note":{{json .Message}},"missing":{{json .Vars.nope}}}`,
+		"--var", "build=1234",
+		"--header", "Authorization: Bearer token",
+		"--message", `In "TestFlight"`,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if request.Method != http.MethodPut || request.Header.Get("Authorization") != "Bearer token" {
+		t.Fatalf("unexpected request: %s %v", request.Method, request.Header)
+	}
+	if got := string(*body); got != `{"build":"1234","note":"In \"TestFlight\"","missing":""}` {
+		t.Fatalf("unexpected body: %s", got)
+	}
+}
+
+func TestNotifyWebhookReportsFailureStatus(t *testing.T) {
+	isolateNotifyConfig(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	_, err := runProvider(t, WebhookCommand(), "--url", server.URL, "--message", "hi")
+	if err == nil || !strings.Contains(err.Error(), "notify webhook: unexpected response 400: nope") {
+		t.Fatalf("expected status error, got %v", err)
+	}
+}
+
+func TestRenderWebhookBodyDefaultsAndErrors(t *testing.T) {
+	body, err := renderWebhookBody("", templateData{Message: "hi", Title: "T"})
+	if err != nil || string(body) != `{"text":"hi","title":"T"}` {
+		t.Fatalf("unexpected default body %s: %v", body, err)
+	}
+	if _, err := renderWebhookBody("{{.Nope", templateData{}); err == nil {
+		t.Fatal("expected template parse error")
+	}
+}
+
+func TestNotifyEmailSendsOverSMTP(t *testing.T) {
+	isolateNotifyConfig(t)
+	addr, received := startFakeSMTPServer(t)
+	host, port, _ := net.SplitHostPort(addr)
+	t.Setenv(smtpPortEnvVar, port)
+
+	_, err := runProvider(t, EmailCommand(),
+		"--smtp-host", host,
+		"--from", "ci@example.com",
+		"--to", "a@example.com, b@example.com",
+		"--subject", "TestFlight",
+		"--message", "Build 1234 is in TestFlight",
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	session := <-received
+	for _, want := range []string{
+		"MAIL FROM:<ci@example.com>",
+		"RCPT TO:<a@example.com>",
+		"RCPT TO:<b@example.com>",
+		"Subject: TestFlight",
+		"To: a@example.com, b@example.com",
+		"Build 1234 is in TestFlight",
+	} {
+		if !strings.Contains(session, want) {
+			t.Fatalf("expected SMTP session to contain %q, got:\n%s", want, session)
+		}
+	}
+}
+
+func TestNotifyEmailValidation(t *testing.T) {
+	isolateNotifyConfig(t)
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: []string{"--subject", "s", "--message", "m"}, want: "--smtp-host is required"},
+		{args: []string{"--smtp-host", "localhost", "--subject", "s", "--message", "m", "--to", "a@example.com"}, want: "--from is required"},
+		{args: []string{"--smtp-host", "localhost", "--from", "ci@example.com", "--to", "not-an-address", "--subject", "s", "--message", "m"}, want: "--to contains an invalid address"},
+		{args: []string{"--smtp-host", "localhost", "--from", "ci@example.com", "--to", "a@example.com", "--message", "m"}, want: "--subject is required"},
+	}
+	for _, test := range tests {
+		stderr, err := runProvider(t, EmailCommand(), test.args...)
+		if !errors.Is(err, flag.ErrHelp) || !strings.Contains(stderr, test.want) {
+			t.Fatalf("args %v: expected %q, got %v (%q)", test.args, test.want, err, stderr)
+		}
+	}
+}
+
+// startFakeSMTPServer accepts one SMTP session and sends its transcript of
+// client commands and message data on the returned channel.
+func startFakeSMTPServer(t *testing.T) (string, <-chan string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var transcript strings.Builder
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+		reply("220 localhost ESMTP")
+		inData := false
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				break
+			}
+			transcript.WriteString(line)
+			command := strings.TrimRight(line, "\r\n")
+			if inData {
+				if command == "." {
+					inData = false
+					reply("250 OK")
+				}
+				continue
+			}
+			switch upper := strings.ToUpper(command); {
+			case strings.HasPrefix(upper, "EHLO"):
+				reply("250-localhost")
+				reply("250 8BITMIME")
+			case strings.HasPrefix(upper, "DATA"):
+				inData = true
+				reply("354 End data with <CR><LF>.<CR><LF>")
+			case strings.HasPrefix(upper, "QUIT"):
+				reply("221 Bye")
+				received <- transcript.String()
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+		received <- transcript.String()
+	}()
+	return listener.Addr().String(), received
+}
@@ -0,0 +1,207 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+const notifyMaxResponseBodyBytes = 4096
+
+// loadNotifyConfig returns the notify section of the config file, or an
+// empty section when no config exists.
+func loadNotifyConfig() (*config.NotifyConfig, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		if errors.Is(err, config.ErrNotFound) {
+			return &config.NotifyConfig{}, nil
+		}
+		return nil, err
+	}
+	if cfg.Notify == nil {
+		return &config.NotifyConfig{}, nil
+	}
+	return cfg.Notify, nil
+}
+
+// firstNonEmpty returns the first value that is not blank, trimmed.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			return trimmed
+		}
+	}
+	return ""
+}
+
+// validateTargetURL requires an https URL. Plain http is allowed for
+// loopback hosts so providers can be tested against local stand-ins.
+func validateTargetURL(flagName, rawURL string) error {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Host == "" || parsed.User != nil {
+		return fmt.Errorf("%s must be a valid URL", flagName)
+	}
+	switch parsed.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if isLocalhost(strings.ToLower(parsed.Hostname())) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s must use https", flagName)
+}
+
+// postTarget sends body to a notification endpoint and treats any 2xx status
+// as success.
+func postTarget(ctx context.Context, method, targetURL string, body []byte, header http.Header) error {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(requestCtx, method, targetURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, values := range header {
+		req.Header.Del(name)
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+
+	resp, err := notifyHTTPClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, readErr := io.ReadAll(io.LimitReader(resp.Body, notifyMaxResponseBodyBytes))
+		if readErr != nil {
+			return fmt.Errorf("failed to read response: %w", readErr)
+		}
+		message := strings.TrimSpace(string(respBody))
+		if message == "" {
+			return fmt.Errorf("unexpected response %d", resp.StatusCode)
+		}
+		return fmt.Errorf("unexpected response %d: %s", resp.StatusCode, message)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// Targets are notification destinations for commands that forward events
+// (such as webhooks serve). Empty fields are skipped; email uses the SMTP
+// settings from ASC_SMTP_* env vars or notify.email in config.json.
+type Targets struct {
+	Slack   string
+	Teams   string
+	Discord string
+	Webhook string
+	Email   []string
+}
+
+// Empty reports whether no target is configured.
+func (t Targets) Empty() bool {
+	return t.Slack == "" && t.Teams == "" && t.Discord == "" && t.Webhook == "" && len(t.Email) == 0
+}
+
+// Validate checks every configured target, so mistakes surface before the
+// first event is sent.
+func (t Targets) Validate() error {
+	if t.Slack != "" {
+		if err := validateSlackWebhookURL(t.Slack); err != nil {
+			return err
+		}
+	}
+	for _, target := range []struct{ name, url string }{
+		{"Teams webhook URL", t.Teams},
+		{"Discord webhook URL", t.Discord},
+		{"webhook URL", t.Webhook},
+	} {
+		if target.url != "" {
+			if err := validateTargetURL(target.name, target.url); err != nil {
+				return err
+			}
+		}
+	}
+	if len(t.Email) > 0 {
+		if _, err := t.smtpSettings(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Send delivers title and message to every configured target. A failing
+// target does not stop the others; their errors are joined.
+func (t Targets) Send(ctx context.Context, title, message string) error {
+	var errs []error
+	if t.Slack != "" {
+		if err := SendSlackMessage(ctx, t.Slack, message); err != nil {
+			errs = append(errs, fmt.Errorf("slack: %w", err))
+		}
+	}
+	if t.Teams != "" {
+		if err := postJSON(ctx, t.Teams, teamsMessage(teamsCard(title, message))); err != nil {
+			errs = append(errs, fmt.Errorf("teams: %w", err))
+		}
+	}
+	if t.Discord != "" {
+		if err := postJSON(ctx, t.Discord, map[string]any{"content": message}); err != nil {
+			errs = append(errs, fmt.Errorf("discord: %w", err))
+		}
+	}
+	if t.Webhook != "" {
+		body, err := renderWebhookBody("", templateData{Message: message, Title: title})
+		if err == nil {
+			err = postTarget(ctx, http.MethodPost, t.Webhook, body, nil)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("webhook: %w", err))
+		}
+	}
+	if len(t.Email) > 0 {
+		settings, err := t.smtpSettings()
+		if err == nil {
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			err = sendEmail(requestCtx, settings, buildEmailMessage(settings, title, message, time.Now()))
+			cancel()
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("email: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (t Targets) smtpSettings() (smtpSettings, error) {
+	notifyConfig, err := loadNotifyConfig()
+	if err != nil {
+		return smtpSettings{}, err
+	}
+	emailConfig := notifyConfig.Email
+	if emailConfig == nil {
+		emailConfig = &config.EmailNotifyConfig{}
+	}
+	return resolveSMTPSettings(emailConfig, "", 0, "", "", strings.Join(t.Email, ","))
+}
+
+func postJSON(ctx context.Context, targetURL string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	return postTarget(ctx, http.MethodPost, targetURL, body, nil)
+}
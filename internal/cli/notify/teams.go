@@ -0,0 +1,139 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const teamsWebhookEnvVar = "ASC_TEAMS_WEBHOOK"
+
+// TeamsCommand returns the notify teams subcommand.
+func TeamsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notify teams", flag.ExitOnError)
+
+	webhook := fs.String("webhook", "", "Teams workflow/incoming webhook URL (or set "+teamsWebhookEnvVar+" env var)")
+	title := fs.String("title", "", "Card title")
+	message := fs.String("message", "", "Message to send to Teams")
+	cardJSON := fs.String("card-json", "", "Adaptive Card JSON object (replaces the generated card)")
+	cardFile := fs.String("card-file", "", "Path to an Adaptive Card JSON object file")
+
+	return &ffcli.Command{
+		Name:       "teams",
+		ShortUsage: "asc notify teams --webhook URL --message TEXT [flags]",
+		ShortHelp:  "Send an Adaptive Card to Microsoft Teams via webhook.",
+		LongHelp: `Send an Adaptive Card to Microsoft Teams via webhook.
+
+The message is wrapped in an Adaptive Card with an optional bold title. Pass
+--card-json or --card-file to send your own card instead. The webhook URL can
+be provided via --webhook flag, ASC_TEAMS_WEBHOOK env var, or
+notify.teams.webhook in config.json.
+
+Examples:
+  asc notify teams --webhook "https://example.webhook.office.com/..." --message "Build uploaded"
+  asc notify teams --title "TestFlight" --message "Build 1234 is in TestFlight"
+  asc notify teams --message "Release ready" --card-file ./card.json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			notifyConfig, err := loadNotifyConfig()
+			if err != nil {
+				return fmt.Errorf("notify teams: %w", err)
+			}
+			configWebhook := ""
+			if notifyConfig.Teams != nil {
+				configWebhook = notifyConfig.Teams.Webhook
+			}
+
+			webhookURL := firstNonEmpty(*webhook, os.Getenv(teamsWebhookEnvVar), configWebhook)
+			if webhookURL == "" {
+				return shared.UsageErrorf("--webhook is required or set %s env var", teamsWebhookEnvVar)
+			}
+			if err := validateTargetURL("--webhook", webhookURL); err != nil {
+				return shared.UsageError(err.Error())
+			}
+			card, err := parseJSONObject("--card-json", *cardJSON, "--card-file", *cardFile)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			msg := strings.TrimSpace(*message)
+			if msg == "" && card == nil {
+				return shared.UsageError("--message is required")
+			}
+			if card == nil {
+				card = teamsCard(strings.TrimSpace(*title), msg)
+			}
+
+			body, err := json.Marshal(teamsMessage(card))
+			if err != nil {
+				return fmt.Errorf("notify teams: failed to marshal payload: %w", err)
+			}
+			if err := postTarget(ctx, http.MethodPost, webhookURL, body, nil); err != nil {
+				return fmt.Errorf("notify teams: %w", err)
+			}
+
+			fmt.Fprintln(os.Stderr, "Message sent to Teams successfully")
+			return nil
+		},
+	}
+}
+
+// teamsMessage wraps an Adaptive Card in a Teams webhook message.
+func teamsMessage(card json.RawMessage) map[string]any {
+	return map[string]any{
+		"type": "message",
+		"attachments": []any{map[string]any{
+			"contentType": "application/vnd.microsoft.card.adaptive",
+			"content":     card,
+		}},
+	}
+}
+
+func teamsCard(title, message string) json.RawMessage {
+	body := make([]map[string]any, 0, 2)
+	if title != "" {
+		body = append(body, map[string]any{"type": "TextBlock", "text": title, "weight": "Bolder", "size": "Medium", "wrap": true})
+	}
+	body = append(body, map[string]any{"type": "TextBlock", "text": message, "wrap": true})
+	card, _ := json.Marshal(map[string]any{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"body":    body,
+	})
+	return card
+}
+
+// parseJSONObject reads a JSON object from an inline flag or a file flag.
+func parseJSONObject(inlineFlag, inline, fileFlag, path string) (json.RawMessage, error) {
+	inline = strings.TrimSpace(inline)
+	path = strings.TrimSpace(path)
+	if inline != "" && path != "" {
+		return nil, fmt.Errorf("only one of %s or %s may be set", inlineFlag, fileFlag)
+	}
+	if inline == "" && path == "" {
+		return nil, nil
+	}
+	source := inlineFlag
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be readable: %w", fileFlag, err)
+		}
+		inline = strings.TrimSpace(string(data))
+		source = fileFlag
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(inline), &object); err != nil {
+		return nil, fmt.Errorf("%s must contain a JSON object: %w", source, err)
+	}
+	return json.RawMessage(inline), nil
+}
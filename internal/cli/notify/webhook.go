@@ -0,0 +1,182 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+const webhookURLEnvVar = "ASC_NOTIFY_WEBHOOK_URL"
+
+// templateData is the data passed to --template bodies.
+type templateData struct {
+	Message   string
+	Title     string
+	Vars      map[string]string
+	Timestamp string
+}
+
+// keyValueFlag collects repeatable KEY<sep>VALUE flags.
+type keyValueFlag struct {
+	sep    string
+	values map[string]string
+}
+
+func (f *keyValueFlag) String() string {
+	return ""
+}
+
+func (f *keyValueFlag) Set(value string) error {
+	key, val, ok := strings.Cut(value, f.sep)
+	key = strings.TrimSpace(key)
+	if !ok || key == "" {
+		return fmt.Errorf("expected KEY%sVALUE, got %q", f.sep, value)
+	}
+	if f.values == nil {
+		f.values = map[string]string{}
+	}
+	f.values[key] = strings.TrimSpace(val)
+	return nil
+}
+
+// WebhookCommand returns the notify webhook subcommand.
+func WebhookCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notify webhook", flag.ExitOnError)
+
+	targetURL := fs.String("url", "", "Webhook URL (or set "+webhookURLEnvVar+" env var)")
+	method := fs.String("method", "", "HTTP method (default POST)")
+	message := fs.String("message", "", "Message text (available as {{.Message}})")
+	title := fs.String("title", "", "Title text (available as {{.Title}})")
+	bodyTemplate := fs.String("template", "", "Go text/template for the request body")
+	templateFile := fs.String("template-file", "", "Path to a Go text/template file for the request body")
+	headers := &keyValueFlag{sep: ":"}
+	fs.Var(headers, "header", "Request header as 'Name: value' (repeatable)")
+	vars := &keyValueFlag{sep: "="}
+	fs.Var(vars, "var", "Template variable as key=value, available as {{.Vars.key}} (repeatable)")
+
+	return &ffcli.Command{
+		Name:       "webhook",
+		ShortUsage: "asc notify webhook --url URL --message TEXT [flags]",
+		ShortHelp:  "Send a templated JSON payload to any webhook.",
+		LongHelp: `Send a templated JSON payload to any webhook.
+
+Without a template the body is {"title": ..., "text": ...}. Templates use Go
+text/template syntax with .Message, .Title, .Timestamp (RFC 3339), and .Vars.
+The json function encodes a value as a JSON literal, e.g. {{json .Message}}.
+
+The URL, method, headers, and template can also be set under
+notify.webhook in config.json; flags override config.
+
+Examples:
+  asc notify webhook --url https://example.com/hook --message "Build 1234 is in TestFlight"
+  asc notify webhook --url https://example.com/hook --template '{"build":{{json .Vars.build}},"note":{{json .Message}}}' --var build=1234 --message "In TestFlight"
+  asc notify webhook --template-file ./payload.tmpl --header "Authorization: Bearer $TOKEN" --message "Done"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			notifyConfig, err := loadNotifyConfig()
+			if err != nil {
+				return fmt.Errorf("notify webhook: %w", err)
+			}
+			webhookConfig := notifyConfig.Webhook
+			if webhookConfig == nil {
+				webhookConfig = &config.WebhookNotifyConfig{}
+			}
+
+			endpoint := firstNonEmpty(*targetURL, os.Getenv(webhookURLEnvVar), webhookConfig.URL)
+			if endpoint == "" {
+				return shared.UsageErrorf("--url is required or set %s env var", webhookURLEnvVar)
+			}
+			if err := validateTargetURL("--url", endpoint); err != nil {
+				return shared.UsageError(err.Error())
+			}
+			httpMethod := strings.ToUpper(firstNonEmpty(*method, webhookConfig.Method, http.MethodPost))
+			switch httpMethod {
+			case http.MethodPost, http.MethodPut, http.MethodPatch:
+			default:
+				return shared.UsageError("--method must be POST, PUT, or PATCH")
+			}
+			if strings.TrimSpace(*bodyTemplate) != "" && strings.TrimSpace(*templateFile) != "" {
+				return shared.UsageError("only one of --template or --template-file may be set")
+			}
+			source := firstNonEmpty(*bodyTemplate, webhookConfig.Template)
+			if path := strings.TrimSpace(*templateFile); path != "" {
+				data, err := os.ReadFile(path)
+				if err != nil {
+					return shared.UsageErrorf("--template-file must be readable: %v", err)
+				}
+				source = string(data)
+			}
+			msg := strings.TrimSpace(*message)
+			if msg == "" {
+				return shared.UsageError("--message is required")
+			}
+
+			data := templateData{
+				Message:   msg,
+				Title:     strings.TrimSpace(*title),
+				Vars:      vars.values,
+				Timestamp: time.Now().UTC().Format(time.RFC3339),
+			}
+			if data.Vars == nil {
+				data.Vars = map[string]string{}
+			}
+			body, err := renderWebhookBody(source, data)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			header := http.Header{}
+			for name, value := range webhookConfig.Headers {
+				header.Set(name, value)
+			}
+			for name, value := range headers.values {
+				header.Set(name, value)
+			}
+			if err := postTarget(ctx, httpMethod, endpoint, body, header); err != nil {
+				return fmt.Errorf("notify webhook: %w", err)
+			}
+
+			fmt.Fprintln(os.Stderr, "Webhook notification sent successfully")
+			return nil
+		},
+	}
+}
+
+// renderWebhookBody executes a body template, or builds the default
+// {"title","text"} payload when source is empty.
+func renderWebhookBody(source string, data templateData) ([]byte, error) {
+	if strings.TrimSpace(source) == "" {
+		payload := map[string]string{"text": data.Message}
+		if data.Title != "" {
+			payload["title"] = data.Title
+		}
+		return json.Marshal(payload)
+	}
+	tmpl, err := template.New("body").Option("missingkey=zero").Funcs(template.FuncMap{
+		"json": func(value any) (string, error) {
+			encoded, err := json.Marshal(value)
+			return string(encoded), err
+		},
+	}).Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("invalid --template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -31,6 +31,8 @@ func PerformanceDownloadCommand() *ffcli.Command {
 	decompress := fs.Bool("decompress", false, "Decompress gzip output (if compressed)")
 	outputFormat := fs.String("output-format", "json", "Output format for metadata: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+	shared.ResolveNames(fs, shared.ResourceBuild, "build")
 
 	return &ffcli.Command{
 		Name:       "download",
@@ -0,0 +1,324 @@
+package profiles
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// ProfilesInspectCommand returns the profiles inspect subcommand.
+func ProfilesInspectCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("inspect", flag.ExitOnError)
+
+	compare := fs.Bool("compare", false, "Compare the file against the live profile in App Store Connect")
+	id := fs.String("id", "", "Live profile ID for --compare (default: the profile with the file's UUID)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "inspect",
+		ShortUsage: "asc profiles inspect <file> [flags]",
+		ShortHelp:  "Decode a local provisioning profile.",
+		LongHelp: `Decode a local provisioning profile.
+
+Reads a .mobileprovision or .provisionprofile file offline and lists its team,
+app ID, entitlements, device UDIDs, certificate fingerprints, and expiry. The
+CMS signature is not verified.
+
+With --compare, the file is checked against the live profile's state,
+devices, and certificates, and every difference is reported. The command
+exits non-zero when the profiles have drifted. Without --id, the live
+profile is found by the file's name and UUID.
+
+Examples:
+  asc profiles inspect ./App_Store.mobileprovision
+  asc profiles inspect ./App_Store.mobileprovision --output table
+  asc profiles inspect ./Development.mobileprovision --compare
+  asc profiles inspect ./Development.mobileprovision --compare --id "PROFILE_ID"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			positional, err := shared.ParseInterspersed(fs, args)
+			if err != nil {
+				return err
+			}
+			if len(positional) != 1 {
+				return shared.UsageError("exactly one profile file is required")
+			}
+			idValue := strings.TrimSpace(*id)
+			if idValue != "" && !*compare {
+				return shared.UsageError("--id requires --compare")
+			}
+
+			path := positional[0]
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("profiles inspect: %w", err)
+			}
+			profile, err := shared.ParseProvisioningProfile(data)
+			if err != nil {
+				return fmt.Errorf("profiles inspect: %s: %w", path, err)
+			}
+			result := inspectProfile(path, profile, time.Now())
+
+			if *compare {
+				client, err := shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("profiles inspect: %w", err)
+				}
+
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				defer cancel()
+
+				result.Compare, err = compareLiveProfile(requestCtx, client, idValue, profile, result)
+				if err != nil {
+					return fmt.Errorf("profiles inspect: %w", err)
+				}
+			}
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			if result.Compare != nil && !result.Compare.InSync {
+				return shared.NewReportedError(fmt.Errorf("profiles inspect: %s differs from live profile %s (%d difference(s))", path, result.Compare.ProfileID, len(result.Compare.Differences)))
+			}
+			return nil
+		},
+	}
+}
+
+func inspectProfile(path string, profile *shared.ProvisioningProfile, now time.Time) *asc.ProfileInspectResult {
+	result := &asc.ProfileInspectResult{
+		File:                  path,
+		Name:                  profile.Name,
+		UUID:                  profile.UUID,
+		TeamName:              profile.TeamName,
+		AppIDName:             profile.AppIDName,
+		ApplicationIdentifier: profile.ApplicationIdentifier(),
+		BundleID:              profile.BundleID(),
+		Platforms:             profile.Platform,
+		CreationDate:          formatProfileTime(profile.CreationDate),
+		ExpirationDate:        formatProfileTime(profile.ExpirationDate),
+		Expired:               profile.Expired(now),
+		ProvisionsAllDevices:  profile.ProvisionsAllDevices,
+		Devices:               append([]string{}, profile.ProvisionedDevices...),
+		Certificates:          make([]asc.ProfileInspectCertificate, 0, len(profile.DeveloperCertificates)),
+		Entitlements:          profile.Entitlements,
+	}
+	if len(profile.TeamIdentifier) > 0 {
+		result.TeamID = profile.TeamIdentifier[0]
+	}
+	sort.Strings(result.Devices)
+	for _, der := range profile.DeveloperCertificates {
+		result.Certificates = append(result.Certificates, inspectCertificate(der))
+	}
+	return result
+}
+
+func inspectCertificate(der []byte) asc.ProfileInspectCertificate {
+	sum1 := sha1.Sum(der)
+	sum256 := sha256.Sum256(der)
+	info := asc.ProfileInspectCertificate{
+		SHA1:   strings.ToUpper(hex.EncodeToString(sum1[:])),
+		SHA256: strings.ToUpper(hex.EncodeToString(sum256[:])),
+	}
+	if cert, err := x509.ParseCertificate(der); err == nil {
+		info.CommonName = cert.Subject.CommonName
+		info.SerialNumber = strings.ToUpper(cert.SerialNumber.Text(16))
+		info.ExpirationDate = formatProfileTime(cert.NotAfter)
+	}
+	return info
+}
+
+func formatProfileTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.UTC().Format(time.RFC3339)
+}
+
+// compareLiveProfile diffs the local profile against the live one. Devices
+// and certificates come from the relationship endpoints rather than
+// include=devices,certificates, whose included lists are capped.
+func compareLiveProfile(ctx context.Context, client *asc.Client, id string, profile *shared.ProvisioningProfile, local *asc.ProfileInspectResult) (*asc.ProfileCompareResult, error) {
+	if id == "" {
+		found, err := findProfileByUUID(ctx, client, profile)
+		if err != nil {
+			return nil, err
+		}
+		id = found
+	}
+	resp, err := client.GetProfile(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch profile %s: %w", id, err)
+	}
+	live := resp.Data.Attributes
+
+	devices, err := liveProfileDevices(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+	certificates, err := liveProfileCertificates(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &asc.ProfileCompareResult{
+		ProfileID:    id,
+		ProfileState: string(live.ProfileState),
+		Differences:  []asc.ProfileDifference{},
+	}
+	addDiff := func(field, localValue, liveValue string) {
+		result.Differences = append(result.Differences, asc.ProfileDifference{Field: field, Local: localValue, Live: liveValue})
+	}
+	if live.ProfileState != "" && live.ProfileState != asc.ProfileStateActive {
+		addDiff("profileState", string(asc.ProfileStateActive), string(live.ProfileState))
+	}
+	if live.UUID != "" && live.UUID != local.UUID {
+		addDiff("uuid", local.UUID, live.UUID)
+	}
+	if live.Name != local.Name {
+		addDiff("name", local.Name, live.Name)
+	}
+	if liveExpiration := normalizeProfileTime(live.ExpirationDate); liveExpiration != "" && liveExpiration != local.ExpirationDate {
+		addDiff("expirationDate", local.ExpirationDate, liveExpiration)
+	}
+	if !profile.ProvisionsAllDevices {
+		for _, udid := range setDifference(local.Devices, devices) {
+			addDiff("device", udid, "")
+		}
+		for _, udid := range setDifference(devices, local.Devices) {
+			addDiff("device", "", udid)
+		}
+	}
+	localCertificates := make([]string, 0, len(local.Certificates))
+	for _, cert := range local.Certificates {
+		localCertificates = append(localCertificates, cert.SHA256)
+	}
+	for _, fingerprint := range setDifference(localCertificates, certificates) {
+		addDiff("certificate", fingerprint, "")
+	}
+	for _, fingerprint := range setDifference(certificates, localCertificates) {
+		addDiff("certificate", "", fingerprint)
+	}
+	result.InSync = len(result.Differences) == 0
+	return result, nil
+}
+
+func findProfileByUUID(ctx context.Context, client *asc.Client, profile *shared.ProvisioningProfile) (string, error) {
+	if strings.TrimSpace(profile.UUID) == "" {
+		return "", fmt.Errorf("the profile has no UUID; pass --id")
+	}
+	next := ""
+	for {
+		resp, err := client.GetProfiles(ctx,
+			asc.WithProfilesFilterName(profile.Name),
+			asc.WithProfilesLimit(200),
+			asc.WithProfilesNextURL(next),
+		)
+		if err != nil {
+			return "", fmt.Errorf("failed to list profiles: %w", err)
+		}
+		for _, item := range resp.Data {
+			if strings.EqualFold(item.Attributes.UUID, profile.UUID) {
+				return item.ID, nil
+			}
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			break
+		}
+		next = resp.Links.Next
+	}
+	return "", fmt.Errorf("no live profile named %q has UUID %s; it may have been regenerated or deleted (pass --id to compare with a specific profile)", profile.Name, profile.UUID)
+}
+
+func liveProfileDevices(ctx context.Context, client *asc.Client, id string) ([]string, error) {
+	var udids []string
+	next := ""
+	for {
+		resp, err := client.GetProfileDevices(ctx, id,
+			asc.WithProfileDevicesLimit(200),
+			asc.WithProfileDevicesNextURL(next),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch profile devices: %w", err)
+		}
+		for _, device := range resp.Data {
+			udids = append(udids, device.Attributes.UDID)
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return udids, nil
+		}
+		next = resp.Links.Next
+	}
+}
+
+// liveProfileCertificates returns the SHA-256 fingerprints of the profile's
+// certificates.
+func liveProfileCertificates(ctx context.Context, client *asc.Client, id string) ([]string, error) {
+	var fingerprints []string
+	next := ""
+	for {
+		resp, err := client.GetProfileCertificates(ctx, id,
+			asc.WithProfileCertificatesLimit(200),
+			asc.WithProfileCertificatesNextURL(next),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch profile certificates: %w", err)
+		}
+		for _, cert := range resp.Data {
+			der, err := decodeProfileContent(cert.Attributes.CertificateContent)
+			if err != nil {
+				return nil, fmt.Errorf("certificate %s: %w", cert.ID, err)
+			}
+			fingerprints = append(fingerprints, inspectCertificate(der).SHA256)
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return fingerprints, nil
+		}
+		next = resp.Links.Next
+	}
+}
+
+// normalizeProfileTime reformats an API timestamp like the ones decoded from
+// the file, so the two can be compared. Unparseable values yield "" and are
+// not compared.
+func normalizeProfileTime(value string) string {
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05.000-0700"} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return formatProfileTime(parsed.Truncate(time.Second))
+		}
+	}
+	return ""
+}
+
+// setDifference returns the sorted values in a that are not in b, compared
+// case-insensitively.
+func setDifference(a, b []string) []string {
+	seen := make(map[string]struct{}, len(b))
+	for _, value := range b {
+		seen[strings.ToUpper(value)] = struct{}{}
+	}
+	var out []string
+	for _, value := range a {
+		if _, ok := seen[strings.ToUpper(value)]; !ok {
+			out = append(out, value)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
@@ -56,10 +56,12 @@ func PublishTestFlightCommand() *ffcli.Command {
 	wait := fs.Bool("wait", false, "Wait for build processing to complete")
 	pollInterval := fs.Duration("poll-interval", shared.PublishDefaultPollInterval, "Polling interval for --wait and build discovery")
 	timeout := fs.Duration("timeout", 0, "Override upload + processing timeout (e.g., 30m)")
+	resume := fs.Bool("resume", false, "Resume an interrupted upload of the same IPA, skipping parts already uploaded")
 	testNotes := fs.String("test-notes", "", "What to Test notes for the build")
 	locale := fs.String("locale", "", "Locale for --test-notes (e.g., en-US)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
 
 	return &ffcli.Command{
 		Name:       "testflight",
@@ -73,10 +75,14 @@ Steps:
 3. Add build to specified beta groups
 4. Optionally notify testers
 
+If the upload is interrupted, rerun the same command with --resume to skip
+the parts that were already uploaded.
+
 Examples:
   asc publish testflight --app "123" --ipa app.ipa --group "GROUP_ID"
   asc publish testflight --app "123" --ipa app.ipa --group "External Testers"
   asc publish testflight --app "123" --ipa app.ipa --group "G1,G2" --wait --notify
+  asc publish testflight --app "123" --ipa app.ipa --group "GROUP_ID" --resume
   asc publish testflight --app "123" --ipa app.ipa --group "GROUP_ID" --test-notes "Test instructions" --locale "en-US" --wait`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -151,7 +157,7 @@ Examples:
 
 			platformValue := asc.Platform(normalizedPlatform)
 			timeoutOverride := *timeout > 0
-			uploadResult, err := uploadBuildAndWaitForID(requestCtx, client, resolvedAppID, *ipaPath, fileInfo, versionValue, buildNumberValue, platformValue, *pollInterval, timeoutValue, timeoutOverride)
+			uploadResult, err := uploadBuildAndWaitForID(requestCtx, client, resolvedAppID, *ipaPath, fileInfo, versionValue, buildNumberValue, platformValue, *pollInterval, timeoutValue, timeoutOverride, *resume)
 			if err != nil {
 				return fmt.Errorf("publish testflight: %w", err)
 			}
@@ -203,8 +209,9 @@ func PublishAppStoreCommand() *ffcli.Command {
 	wait := fs.Bool("wait", false, "Wait for build processing")
 	pollInterval := fs.Duration("poll-interval", shared.PublishDefaultPollInterval, "Polling interval for --wait and build discovery")
 	timeout := fs.Duration("timeout", 0, "Override upload + processing timeout (e.g., 30m)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
 
 	return &ffcli.Command{
 		Name:       "appstore",
@@ -272,7 +279,7 @@ Examples:
 
 			platformValue := asc.Platform(normalizedPlatform)
 			timeoutOverride := *timeout > 0
-			uploadResult, err := uploadBuildAndWaitForID(requestCtx, client, resolvedAppID, *ipaPath, fileInfo, versionValue, buildNumberValue, platformValue, *pollInterval, timeoutValue, timeoutOverride)
+			uploadResult, err := uploadBuildAndWaitForID(requestCtx, client, resolvedAppID, *ipaPath, fileInfo, versionValue, buildNumberValue, platformValue, *pollInterval, timeoutValue, timeoutOverride, false)
 			if err != nil {
 				return fmt.Errorf("publish appstore: %w", err)
 			}
@@ -332,29 +339,61 @@ type publishUploadResult struct {
 	BuildNumber string
 }
 
-func uploadBuildAndWaitForID(ctx context.Context, client *asc.Client, appID, ipaPath string, fileInfo os.FileInfo, version, buildNumber string, platform asc.Platform, pollInterval time.Duration, uploadTimeout time.Duration, overrideUploadTimeout bool) (*publishUploadResult, error) {
-	_, fileResp, err := prepareBuildUpload(ctx, client, appID, fileInfo, version, buildNumber, platform)
+func uploadBuildAndWaitForID(ctx context.Context, client *asc.Client, appID, ipaPath string, fileInfo os.FileInfo, version, buildNumber string, platform asc.Platform, pollInterval time.Duration, uploadTimeout time.Duration, overrideUploadTimeout bool, resume bool) (*publishUploadResult, error) {
+	journalKey, err := shared.BuildUploadJournalKey(appID, version, buildNumber, platform, ipaPath)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(fileResp.Data.Attributes.UploadOperations) == 0 {
+	var journal *asc.UploadJournal
+	if resume {
+		journal, err = shared.FindResumableBuildUpload(journalKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read upload journal: %w", err)
+		}
+	}
+
+	var fileID string
+	var operations []asc.UploadOperation
+	if journal != nil {
+		fileID = journal.FileID
+		operations = journal.Operations
+	} else {
+		uploadResp, fileResp, err := prepareBuildUpload(ctx, client, appID, fileInfo, version, buildNumber, platform)
+		if err != nil {
+			return nil, err
+		}
+		fileID = fileResp.Data.ID
+		operations = fileResp.Data.Attributes.UploadOperations
+		if len(operations) > 0 {
+			journal = shared.StartBuildUploadJournal(journalKey, uploadResp.Data.ID, fileResp)
+		}
+	}
+
+	if len(operations) == 0 {
 		return nil, fmt.Errorf("no upload operations returned")
 	}
 
+	var uploadOpts []asc.UploadOption
+	if journal != nil {
+		uploadOpts = append(uploadOpts, asc.WithUploadJournal(journal))
+	}
 	uploadCtx, uploadCancel := contextWithPublishUploadTimeout(ctx, uploadTimeout, overrideUploadTimeout)
-	err = asc.ExecuteUploadOperations(uploadCtx, ipaPath, fileResp.Data.Attributes.UploadOperations)
+	err = asc.ExecuteUploadOperations(uploadCtx, ipaPath, operations, uploadOpts...)
 	uploadCancel()
 	if err != nil {
 		return nil, err
 	}
 
 	commitCtx, commitCancel := contextWithPublishUploadTimeout(ctx, uploadTimeout, overrideUploadTimeout)
-	err = commitBuildUploadFile(commitCtx, client, fileResp.Data.ID, nil)
+	err = commitBuildUploadFile(commitCtx, client, fileID, nil)
 	commitCancel()
 	if err != nil {
 		return nil, err
 	}
+	if journal != nil {
+		shared.FinishBuildUploadJournal(journal)
+	}
 
 	buildResp, err := shared.WaitForBuildByNumber(ctx, client, appID, version, buildNumber, string(platform), pollInterval)
 	if err != nil {
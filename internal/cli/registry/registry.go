@@ -13,6 +13,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/alternativedistribution"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/analytics"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/androidiosmapping"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/api"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/app_events"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/appclips"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/apps"
@@ -29,6 +30,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/certificates"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/completion"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/crashes"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/dev"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/devices"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/docs"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/encryption"
@@ -41,7 +43,9 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/install"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/localizations"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/marketplace"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/mcp"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/merchantids"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/metadata"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/migrate"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/nominations"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notarization"
@@ -68,8 +72,10 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/users"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/validate"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/versions"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/watch"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/webhooks"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/winbackoffers"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/workflow"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/xcodecloud"
 )
 
@@ -156,11 +162,18 @@ func Subcommands(version string) []*ffcli.Command {
 		encryption.EncryptionCommand(),
 		promotedpurchases.PromotedPurchasesCommand(),
 		migrate.MigrateCommand(),
+		metadata.MetadataCommand(),
 		notify.NotifyCommand(),
+		watch.WatchCommand(),
+		workflow.WorkflowCommand(func() []*ffcli.Command { return Subcommands(version) }),
 		gamecenter.GameCenterCommand(),
+		dev.DevCommand(),
+		mcp.MCPCommand(version, func() []*ffcli.Command { return Subcommands(version) }),
+		api.APICommand(),
 		VersionCommand(version),
 	}
 
 	subs = append(subs, completion.CompletionCommand(subs))
+	shared.EnableResourceResolution(subs)
 	return subs
 }
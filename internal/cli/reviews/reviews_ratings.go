@@ -23,8 +23,9 @@ func ReviewsRatingsCommand() *ffcli.Command {
 	country := fs.String("country", "us", "Country code (e.g., us, gb, de)")
 	all := fs.Bool("all", false, "Fetch ratings from all countries")
 	workers := fs.Int("workers", 10, "Number of parallel workers for --all")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
 
 	return &ffcli.Command{
 		Name:       "ratings",
@@ -90,6 +91,8 @@ func executeSingleRatings(ctx context.Context, client *itunes.Client, appID, cou
 		return printRatingsTable(ratings)
 	case "markdown":
 		return printRatingsMarkdown(ratings)
+	case "csv", "ndjson":
+		return renderRatingsRows(output, []itunes.AppRatings{*ratings})
 	default:
 		return shared.PrintOutput(ratings, "json", pretty)
 	}
@@ -106,6 +109,8 @@ func executeAllRatings(ctx context.Context, client *itunes.Client, appID string,
 		return printGlobalRatingsTable(global)
 	case "markdown":
 		return printGlobalRatingsMarkdown(global)
+	case "csv", "ndjson":
+		return renderRatingsRows(output, global.ByCountry)
 	default:
 		return shared.PrintOutput(global, "json", pretty)
 	}
@@ -123,7 +128,7 @@ func normalizeRatingsOutput(output string, pretty bool) (string, error) {
 	switch format {
 	case "json":
 		return format, nil
-	case "table", "markdown":
+	case "table", "markdown", "csv", "ndjson":
 		if pretty {
 			return "", fmt.Errorf("--pretty is only valid with JSON output")
 		}
@@ -133,6 +138,28 @@ func normalizeRatingsOutput(output string, pretty bool) (string, error) {
 	}
 }
 
+// renderRatingsRows writes one row per country for csv and ndjson output.
+func renderRatingsRows(format string, ratings []itunes.AppRatings) error {
+	headers := []string{"Country", "Name", "Rating", "Count", "5 Stars", "4 Stars", "3 Stars", "2 Stars", "1 Star"}
+	rows := make([][]string, 0, len(ratings))
+	for _, r := range ratings {
+		row := []string{
+			r.Country,
+			r.CountryName,
+			strconv.FormatFloat(r.AverageRating, 'f', 2, 64),
+			strconv.FormatInt(r.RatingCount, 10),
+		}
+		for star := 5; star >= 1; star-- {
+			row = append(row, strconv.FormatInt(r.Histogram[star], 10))
+		}
+		rows = append(rows, row)
+	}
+	if format == "csv" {
+		return asc.RenderCSV(headers, rows)
+	}
+	return asc.RenderNDJSON(headers, rows)
+}
+
 func printRatingsTable(r *itunes.AppRatings) error {
 	fmt.Printf("\n%s\n", r.AppName)
 	fmt.Printf("App ID: %d | Country: %s\n", r.AppID, r.Country)
@@ -32,10 +32,11 @@ func NewAvailabilitySetCommand(config AvailabilitySetCommandConfig) *ffcli.Comma
 	var available OptionalBool
 	fs.Var(&available, "available", "Set availability: true or false")
 	var availableInNewTerritories OptionalBool
+	ResolveNames(fs, ResourceApp, "app")
 	if config.IncludeAvailableInNewTerritories {
 		fs.Var(&availableInNewTerritories, "available-in-new-territories", "Set availability for new territories: true or false")
 	}
-	output := fs.String("output", DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -29,6 +29,16 @@ func WaitForBuildByNumber(ctx context.Context, client *asc.Client, appID, versio
 	if buildNumber == "" {
 		return nil, fmt.Errorf("build number is required to resolve build")
 	}
+	ctx = asc.WithoutResponseCache(ctx)
+	if asc.SimulationActive() {
+		// A simulated upload never creates the build, so look once and fall
+		// back to a placeholder instead of polling.
+		build, err := findBuildByNumber(ctx, client, appID, version, buildNumber, platform)
+		if err != nil || build != nil {
+			return build, err
+		}
+		return &asc.BuildResponse{Data: asc.Resource[asc.BuildAttributes]{Type: asc.ResourceTypeBuilds, ID: "simulated-build"}}, nil
+	}
 
 	ticker := time.NewTicker(pollInterval)
 	defer ticker.Stop()
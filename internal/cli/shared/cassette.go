@@ -0,0 +1,75 @@
+package shared
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// ValidateCassetteFlags checks the root --record and --replay flags.
+func ValidateCassetteFlags() error {
+	record := strings.TrimSpace(recordPath)
+	replay := strings.TrimSpace(replayPath)
+	if record != "" && replay != "" {
+		return fmt.Errorf("--record and --replay are mutually exclusive")
+	}
+	if replay != "" {
+		if _, err := os.Stat(replay); err != nil {
+			return fmt.Errorf("--replay: %w", err)
+		}
+	}
+	return nil
+}
+
+// StartCassette enables recording or replay for the root --record/--replay
+// flags. The returned stop function disables it and closes the cassette.
+func StartCassette() (func() error, error) {
+	if path := strings.TrimSpace(replayPath); path != "" {
+		replayer, err := asc.LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		asc.SetCassetteReplayer(replayer)
+		return func() error {
+			asc.SetCassetteReplayer(nil)
+			return nil
+		}, nil
+	}
+	if path := strings.TrimSpace(recordPath); path != "" {
+		recorder, err := asc.NewCassetteRecorder(path)
+		if err != nil {
+			return nil, err
+		}
+		asc.SetCassetteRecorder(recorder)
+		return func() error {
+			asc.SetCassetteRecorder(nil)
+			return recorder.Close()
+		}, nil
+	}
+	return func() error { return nil }, nil
+}
+
+// replayCredentials returns credentials backed by a temporary, randomly
+// generated key for replaying cassettes without real credentials.
+func replayCredentials() (resolvedCredentials, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return resolvedCredentials{}, fmt.Errorf("failed to generate replay key: %w", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return resolvedCredentials{}, fmt.Errorf("failed to encode replay key: %w", err)
+	}
+	path, err := writeTempPrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	if err != nil {
+		return resolvedCredentials{}, fmt.Errorf("failed to write replay key: %w", err)
+	}
+	return resolvedCredentials{keyID: "REPLAY", issuerID: "REPLAY", keyPath: path}, nil
+}
@@ -26,12 +26,13 @@ func NewCategoriesSetCommand(config CategoriesSetCommandConfig) *ffcli.Command {
 
 	appID := fs.String("app", os.Getenv("ASC_APP_ID"), "App ID (required)")
 	var appInfoID *string
+	ResolveNames(fs, ResourceApp, "app")
 	if config.IncludeAppInfo {
 		appInfoID = fs.String("app-info", "", "App Info ID (optional override)")
 	}
 	primary := fs.String("primary", "", "Primary category ID (required)")
 	secondary := fs.String("secondary", "", "Secondary category ID (optional)")
-	output := fs.String("output", DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -11,8 +11,9 @@ const (
 )
 
 var (
-	reportFormat string
-	reportFile   string
+	reportFormat    string
+	reportFile      string
+	reportTestCases []JUnitTestCase
 )
 
 // BindCIFlags registers CI-related flags for report output.
@@ -55,3 +56,14 @@ func SetReportFormat(format string) {
 func SetReportFile(path string) {
 	reportFile = path
 }
+
+// SetReportTestCases replaces the single command-level test case of the CI
+// report with the given cases, e.g. one per workflow step.
+func SetReportTestCases(cases []JUnitTestCase) {
+	reportTestCases = cases
+}
+
+// ReportTestCases returns the cases set by SetReportTestCases.
+func ReportTestCases() []JUnitTestCase {
+	return reportTestCases
+}
@@ -0,0 +1,106 @@
+package shared
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+)
+
+// Fan-out limits for the root --parallel flag.
+const (
+	DefaultFanOutParallel = 4
+	MaxFanOutParallel     = 32
+)
+
+// FanOutAllApps selects every app visible to a profile in --apps.
+const FanOutAllApps = "all"
+
+// FanOutBundlePrefix selects apps whose bundle ID starts with a prefix in
+// --apps, e.g. bundle-prefix:com.example.*.
+const FanOutBundlePrefix = "bundle-prefix:"
+
+var (
+	fanOutApps     string
+	fanOutProfiles string
+	fanOutParallel int
+)
+
+// BindFanOutFlags registers the flags that run one command for several apps
+// or profiles.
+func BindFanOutFlags(fs *flag.FlagSet) {
+	fs.StringVar(&fanOutApps, "apps", "", "Run the command once per app: comma-separated app IDs, all, or bundle-prefix:PREFIX*")
+	fs.StringVar(&fanOutProfiles, "profiles", "", "Run the command once per authentication profile (comma-separated)")
+	fs.IntVar(&fanOutParallel, "parallel", DefaultFanOutParallel, fmt.Sprintf("Maximum concurrent runs for --apps/--profiles (1-%d)", MaxFanOutParallel))
+}
+
+// ValidateFanOutFlags checks --apps, --profiles, and --parallel after parsing.
+func ValidateFanOutFlags() error {
+	if fanOutParallel < 1 || fanOutParallel > MaxFanOutParallel {
+		return fmt.Errorf("--parallel must be between 1 and %d", MaxFanOutParallel)
+	}
+	if !FanOutEnabled() {
+		return nil
+	}
+	if strings.TrimSpace(fanOutApps) != "" && len(FanOutAppSelectors()) == 0 {
+		return fmt.Errorf("--apps must list at least one app")
+	}
+	if strings.TrimSpace(fanOutProfiles) != "" && len(FanOutProfiles()) == 0 {
+		return fmt.Errorf("--profiles must list at least one profile")
+	}
+	for _, selector := range FanOutAppSelectors() {
+		if selector == FanOutAllApps && len(FanOutAppSelectors()) > 1 {
+			return fmt.Errorf("--apps all cannot be combined with other apps")
+		}
+		if prefix, ok := strings.CutPrefix(selector, FanOutBundlePrefix); ok && strings.TrimSuffix(prefix, "*") == "" {
+			return fmt.Errorf("--apps %s needs a bundle ID prefix", selector)
+		}
+	}
+	if len(FanOutProfiles()) > 0 && strings.TrimSpace(selectedProfile) != "" {
+		return fmt.Errorf("--profile and --profiles are mutually exclusive")
+	}
+	if strings.TrimSpace(recordPath) != "" || strings.TrimSpace(replayPath) != "" {
+		return fmt.Errorf("--record and --replay cannot be combined with --apps or --profiles")
+	}
+	return nil
+}
+
+// FanOutEnabled reports whether --apps or --profiles is set.
+func FanOutEnabled() bool {
+	return strings.TrimSpace(fanOutApps) != "" || strings.TrimSpace(fanOutProfiles) != ""
+}
+
+// FanOutAppSelectors returns the --apps entries: app IDs, "all", or
+// bundle-prefix: selectors.
+func FanOutAppSelectors() []string {
+	return dedupe(splitCSV(fanOutApps))
+}
+
+// FanOutProfiles returns the --profiles entries.
+func FanOutProfiles() []string {
+	return dedupe(splitCSV(fanOutProfiles))
+}
+
+// FanOutParallel returns the --parallel limit.
+func FanOutParallel() int {
+	return fanOutParallel
+}
+
+// SetFanOut sets the fan-out flags (tests only).
+func SetFanOut(apps, profiles string, parallel int) {
+	fanOutApps = apps
+	fanOutProfiles = profiles
+	fanOutParallel = parallel
+}
+
+func dedupe(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		out = append(out, value)
+	}
+	return out
+}
@@ -0,0 +1,26 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+// RunHookCommand runs a user-supplied shell command with payload on stdin
+// and env added to the environment. Its output goes to stderr so it never
+// mixes with machine-readable stdout.
+func RunHookCommand(ctx context.Context, command string, payload []byte, env []string) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), env...)
+	return cmd.Run()
+}
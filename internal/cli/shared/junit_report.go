@@ -16,6 +16,7 @@ type JUnitTestCase struct {
 	Time      time.Duration // Test duration
 	Failure   string        // Failure type (empty if passed)
 	Message   string        // Failure message
+	Skipped   string        // Skip reason (empty if not skipped)
 	SystemOut string        // Standard output
 	SystemErr string        // Standard error
 }
@@ -71,10 +72,14 @@ func (r *JUnitReport) Marshal() ([]byte, error) {
 
 	tests := len(r.Tests)
 	failures := 0
+	skipped := 0
 	for _, tc := range r.Tests {
 		if tc.Failure != "" {
 			failures++
 		}
+		if tc.Skipped != "" {
+			skipped++
+		}
 	}
 
 	var testCases []testCaseXML
@@ -87,6 +92,7 @@ func (r *JUnitReport) Marshal() ([]byte, error) {
 		Tests:     tests,
 		Failures:  failures,
 		Errors:    0,
+		Skipped:   skipped,
 		Time:      formatDuration(totalDuration(r.Tests)),
 		Timestamp: r.Timestamp.Format(time.RFC3339),
 		TestCases: testCases,
@@ -116,6 +122,7 @@ type testCaseXML struct {
 	Classname string      `xml:"classname,attr"`
 	Time      string      `xml:"time,attr"`
 	Failure   *failureXML `xml:"failure,omitempty"`
+	Skipped   *skippedXML `xml:"skipped,omitempty"`
 	SystemOut string      `xml:"system-out,omitempty"`
 	SystemErr string      `xml:"system-err,omitempty"`
 }
@@ -140,6 +147,10 @@ func (tc JUnitTestCase) toXML() testCaseXML {
 		}
 	}
 
+	if tc.Skipped != "" {
+		xml.Skipped = &skippedXML{Message: tc.Skipped}
+	}
+
 	if tc.SystemOut != "" {
 		xml.SystemOut = tc.SystemOut
 	}
@@ -151,6 +162,11 @@ func (tc JUnitTestCase) toXML() testCaseXML {
 	return xml
 }
 
+// skippedXML is the internal XML structure for skipped test cases.
+type skippedXML struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
 // testsuiteXML is the internal XML structure for the test suite.
 type testsuiteXML struct {
 	XMLName   xml.Name      `xml:"testsuite"`
@@ -158,6 +174,7 @@ type testsuiteXML struct {
 	Tests     int           `xml:"tests,attr"`
 	Failures  int           `xml:"failures,attr"`
 	Errors    int           `xml:"errors,attr"`
+	Skipped   int           `xml:"skipped,attr,omitempty"`
 	Time      string        `xml:"time,attr"`
 	Timestamp string        `xml:"timestamp,attr,omitempty"`
 	TestCases []testCaseXML `xml:"testcase"`
@@ -310,3 +310,25 @@ type failingWriter struct{}
 func (failingWriter) Write([]byte) (int, error) {
 	return 0, fmt.Errorf("write failed")
 }
+
+func TestJUnitReport_MarshalSkipped(t *testing.T) {
+	report := JUnitReport{
+		Tests: []JUnitTestCase{
+			{Name: "upload", Classname: "workflow", Time: time.Second},
+			{Name: "notify", Classname: "workflow", Skipped: "condition not met"},
+		},
+		Timestamp: time.Now(),
+	}
+
+	data, err := report.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	output := string(data)
+	if !strings.Contains(output, `skipped="1"`) {
+		t.Fatalf("expected skipped count in testsuite, got %q", output)
+	}
+	if !strings.Contains(output, `<skipped message="condition not met"></skipped>`) {
+		t.Fatalf("expected skipped element, got %q", output)
+	}
+}
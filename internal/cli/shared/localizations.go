@@ -68,7 +68,7 @@ func WriteVersionLocalizationStrings(outputPath string, items []asc.Resource[asc
 		if locale == "" {
 			continue
 		}
-		byLocale[locale] = mapVersionLocalizationStrings(item.Attributes)
+		byLocale[locale] = VersionLocalizationValues(item.Attributes)
 	}
 	return writeLocalizationStrings(outputPath, byLocale, versionLocalizationKeys)
 }
@@ -80,7 +80,7 @@ func WriteAppInfoLocalizationStrings(outputPath string, items []asc.Resource[asc
 		if locale == "" {
 			continue
 		}
-		byLocale[locale] = mapAppInfoLocalizationStrings(item.Attributes)
+		byLocale[locale] = AppInfoLocalizationValues(item.Attributes)
 	}
 	return writeLocalizationStrings(outputPath, byLocale, appInfoLocalizationKeys)
 }
@@ -123,10 +123,10 @@ func writeLocalizationStrings(outputPath string, valuesByLocale map[string]map[s
 // Allows 2-3 letter language codes, optionally followed by BCP-47 subtags (case-insensitive).
 var localeValidationRegex = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]+)*$`)
 
-// isValidLocale checks if a locale string is safe to use in file paths.
+// IsValidLocale checks if a locale string is safe to use in file paths.
 // Valid locales follow the pattern: 2-3 lowercase letters, optionally followed by
 // a hyphen and uppercase letters/numbers (e.g., "en", "en-US", "zh-Hans").
-func isValidLocale(locale string) bool {
+func IsValidLocale(locale string) bool {
 	if locale == "" || len(locale) > 20 {
 		return false
 	}
@@ -156,7 +156,7 @@ func resolveLocalizationOutputPaths(outputPath string, locales []string) (map[st
 	}
 	for _, locale := range locales {
 		// Validate locale to prevent path traversal attacks
-		if !isValidLocale(locale) {
+		if !IsValidLocale(locale) {
 			return nil, fmt.Errorf("invalid locale code %q: must match pattern like 'en', 'en-US', or 'zh-Hans'", locale)
 		}
 		result[locale] = filepath.Join(outputPath, locale+".strings")
@@ -164,7 +164,8 @@ func resolveLocalizationOutputPaths(outputPath string, locales []string) (map[st
 	return result, nil
 }
 
-func mapVersionLocalizationStrings(attrs asc.AppStoreVersionLocalizationAttributes) map[string]string {
+// VersionLocalizationValues returns the non-empty version localization fields keyed by attribute name.
+func VersionLocalizationValues(attrs asc.AppStoreVersionLocalizationAttributes) map[string]string {
 	values := make(map[string]string)
 	setIfNotEmpty(values, "description", attrs.Description)
 	setIfNotEmpty(values, "keywords", attrs.Keywords)
@@ -175,7 +176,8 @@ func mapVersionLocalizationStrings(attrs asc.AppStoreVersionLocalizationAttribut
 	return values
 }
 
-func mapAppInfoLocalizationStrings(attrs asc.AppInfoLocalizationAttributes) map[string]string {
+// AppInfoLocalizationValues returns the non-empty app info localization fields keyed by attribute name.
+func AppInfoLocalizationValues(attrs asc.AppInfoLocalizationAttributes) map[string]string {
 	values := make(map[string]string)
 	setIfNotEmpty(values, "name", attrs.Name)
 	setIfNotEmpty(values, "subtitle", attrs.Subtitle)
@@ -282,7 +284,7 @@ func UploadVersionLocalizations(ctx context.Context, client versionLocalizationC
 	}
 
 	return uploadLocalizationValues(ctx, valuesByLocale, existingByLocale, func(locale string, values map[string]string, existingID string) (asc.LocalizationUploadLocaleResult, error) {
-		attributes := buildVersionLocalizationAttributes(locale, values, existingID == "")
+		attributes := BuildVersionLocalizationAttributes(locale, values, existingID == "")
 		if existingID == "" {
 			if dryRun {
 				return asc.LocalizationUploadLocaleResult{Locale: locale, Action: "create"}, nil
@@ -332,7 +334,7 @@ func UploadAppInfoLocalizations(ctx context.Context, client appInfoLocalizationC
 	}
 
 	return uploadLocalizationValues(ctx, valuesByLocale, existingByLocale, func(locale string, values map[string]string, existingID string) (asc.LocalizationUploadLocaleResult, error) {
-		attributes := buildAppInfoLocalizationAttributes(locale, values, existingID == "")
+		attributes := BuildAppInfoLocalizationAttributes(locale, values, existingID == "")
 		if existingID == "" {
 			if dryRun {
 				return asc.LocalizationUploadLocaleResult{Locale: locale, Action: "create"}, nil
@@ -420,6 +422,16 @@ func buildAllowedKeys(keys []string) map[string]bool {
 	return allowed
 }
 
+// ValidateVersionLocalizationKeys rejects keys that are not version localization attributes.
+func ValidateVersionLocalizationKeys(locale string, values map[string]string) error {
+	return validateLocalizationKeys(locale, values, buildAllowedKeys(versionLocalizationKeys))
+}
+
+// ValidateAppInfoLocalizationKeys rejects keys that are not app info localization attributes.
+func ValidateAppInfoLocalizationKeys(locale string, values map[string]string) error {
+	return validateLocalizationKeys(locale, values, buildAllowedKeys(appInfoLocalizationKeys))
+}
+
 func validateLocalizationKeys(locale string, values map[string]string, allowed map[string]bool) error {
 	unknown := make([]string, 0)
 	for key := range values {
@@ -434,7 +446,8 @@ func validateLocalizationKeys(locale string, values map[string]string, allowed m
 	return nil
 }
 
-func buildVersionLocalizationAttributes(locale string, values map[string]string, includeLocale bool) asc.AppStoreVersionLocalizationAttributes {
+// BuildVersionLocalizationAttributes converts localization values into API attributes.
+func BuildVersionLocalizationAttributes(locale string, values map[string]string, includeLocale bool) asc.AppStoreVersionLocalizationAttributes {
 	attrs := asc.AppStoreVersionLocalizationAttributes{}
 	if includeLocale {
 		attrs.Locale = locale
@@ -460,7 +473,8 @@ func buildVersionLocalizationAttributes(locale string, values map[string]string,
 	return attrs
 }
 
-func buildAppInfoLocalizationAttributes(locale string, values map[string]string, includeLocale bool) asc.AppInfoLocalizationAttributes {
+// BuildAppInfoLocalizationAttributes converts localization values into API attributes.
+func BuildAppInfoLocalizationAttributes(locale string, values map[string]string, includeLocale bool) asc.AppInfoLocalizationAttributes {
 	attrs := asc.AppInfoLocalizationAttributes{}
 	if includeLocale {
 		attrs.Locale = locale
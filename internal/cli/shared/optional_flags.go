@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"flag"
 	"fmt"
 	"strconv"
 	"strings"
@@ -18,3 +19,20 @@ func ParseOptionalBoolFlag(name, raw string) (*bool, error) {
 	}
 	return &value, nil
 }
+
+// ParseInterspersed parses flags that follow positional arguments and
+// returns the positional arguments in order.
+func ParseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for len(args) > 0 {
+		if args[0] == "--" {
+			return append(positional, args[1:]...), nil
+		}
+		positional = append(positional, args[0])
+		if err := fs.Parse(args[1:]); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+	}
+	return positional, nil
+}
@@ -0,0 +1,199 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/query"
+)
+
+// outputSelection holds the parsed --select and --query root flags.
+type outputSelection struct {
+	fields []string
+	query  *query.Query
+}
+
+func (s outputSelection) active() bool {
+	return len(s.fields) > 0 || s.query != nil
+}
+
+// ValidateOutputSelectionFlags checks --select and --query after parsing, so
+// a bad expression fails before any request is sent.
+func ValidateOutputSelectionFlags() error {
+	_, err := resolveOutputSelection()
+	return err
+}
+
+func resolveOutputSelection() (outputSelection, error) {
+	var selection outputSelection
+	for _, field := range splitCSV(outputSelect) {
+		for _, segment := range strings.Split(field, ".") {
+			if segment == "" {
+				return outputSelection{}, fmt.Errorf("--select contains an invalid path %q", field)
+			}
+		}
+		selection.fields = append(selection.fields, field)
+	}
+	if expression := strings.TrimSpace(outputQuery); expression != "" {
+		compiled, err := query.Compile(expression)
+		if err != nil {
+			return outputSelection{}, fmt.Errorf("invalid --query: %w", err)
+		}
+		selection.query = compiled
+	}
+	return selection, nil
+}
+
+// printSelectedOutput applies --query, then --select, and renders the result.
+// Field paths are resolved against each resource (the items under "data" for
+// API responses) and become the table columns.
+func printSelectedOutput(data any, format string, pretty bool, selection outputSelection) error {
+	value, err := query.FromValue(data)
+	if err != nil {
+		return fmt.Errorf("apply output selection: %w", err)
+	}
+	if selection.query != nil {
+		value, err = selection.query.Apply(value)
+		if err != nil {
+			return fmt.Errorf("invalid --query: %w", err)
+		}
+	}
+	if len(selection.fields) > 0 {
+		value = projectFields(value, selection.fields)
+	}
+
+	switch format {
+	case "json":
+		if pretty {
+			return asc.PrintPrettyJSON(value)
+		}
+		return asc.PrintJSON(value)
+	case "ndjson":
+		if pretty {
+			return fmt.Errorf("--pretty is only valid with JSON output")
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetEscapeHTML(false)
+		for _, record := range selectionRecords(value) {
+			if err := encoder.Encode(record); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "table", "markdown", "md", "csv":
+		if pretty {
+			return fmt.Errorf("--pretty is only valid with JSON output")
+		}
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+
+	headers, rows := selectionRows(selectionRecords(value), selection.fields)
+	switch format {
+	case "table":
+		asc.RenderTable(headers, rows)
+	case "csv":
+		return asc.RenderCSV(headers, rows)
+	default:
+		asc.RenderMarkdown(headers, rows)
+	}
+	return nil
+}
+
+// projectFields keeps only fields on each resource, leaving envelope keys
+// such as "links" and "meta" in place.
+func projectFields(value any, fields []string) any {
+	switch v := value.(type) {
+	case []any:
+		projected := make([]any, 0, len(v))
+		for _, item := range v {
+			projected = append(projected, projectFields(item, fields))
+		}
+		return projected
+	case *query.Object:
+		if data, ok := v.Get("data"); ok {
+			out := query.NewObject()
+			for _, key := range v.Keys() {
+				if key == "data" {
+					out.Set(key, projectFields(data, fields))
+					continue
+				}
+				item, _ := v.Get(key)
+				out.Set(key, item)
+			}
+			return out
+		}
+		out := query.NewObject()
+		for _, field := range fields {
+			query.SetPath(out, field, query.Lookup(v, field))
+		}
+		return out
+	default:
+		return value
+	}
+}
+
+// selectionRecords returns the rows to render: the resources under "data",
+// the elements of a list, or the value itself.
+func selectionRecords(value any) []any {
+	if object, ok := value.(*query.Object); ok {
+		if data, ok := object.Get("data"); ok {
+			value = data
+		}
+	}
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	default:
+		return []any{v}
+	}
+}
+
+func selectionRows(records []any, fields []string) ([]string, [][]string) {
+	headers := fields
+	if len(headers) == 0 {
+		seen := map[string]bool{}
+		for _, record := range records {
+			object, ok := record.(*query.Object)
+			if !ok {
+				continue
+			}
+			for _, key := range object.Keys() {
+				if !seen[key] {
+					seen[key] = true
+					headers = append(headers, key)
+				}
+			}
+		}
+	}
+
+	rows := make([][]string, 0, len(records))
+	if len(headers) == 0 {
+		for _, record := range records {
+			rows = append(rows, []string{query.String(record)})
+		}
+		return []string{"value"}, rows
+	}
+	for _, record := range records {
+		row := make([]string, len(headers))
+		if object, ok := record.(*query.Object); ok {
+			for i, header := range headers {
+				if len(fields) > 0 {
+					row[i] = query.String(query.Lookup(object, header))
+				} else {
+					item, _ := object.Get(header)
+					row[i] = query.String(item)
+				}
+			}
+		} else {
+			row[0] = query.String(record)
+		}
+		rows = append(rows, row)
+	}
+	return headers, rows
+}
@@ -35,8 +35,9 @@ func NewPricingSetCommand(config PricingSetCommandConfig) *ffcli.Command {
 	pricePointID := fs.String("price-point", "", "App price point ID")
 	baseTerritory := fs.String("base-territory", "", "Base territory ID (e.g., USA)")
 	startDate := fs.String("start-date", "", config.StartDateHelp)
-	output := fs.String("output", DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	ResolveNames(fs, ResourceApp, "app")
 
 	return &ffcli.Command{
 		Name:       config.CommandName,
@@ -0,0 +1,241 @@
+package shared
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"howett.net/plist"
+)
+
+// ProvisioningProfile is the property list embedded in a .mobileprovision or
+// .provisionprofile file.
+type ProvisioningProfile struct {
+	AppIDName                   string         `plist:"AppIDName"`
+	ApplicationIdentifierPrefix []string       `plist:"ApplicationIdentifierPrefix"`
+	CreationDate                time.Time      `plist:"CreationDate"`
+	Platform                    []string       `plist:"Platform"`
+	DeveloperCertificates       [][]byte       `plist:"DeveloperCertificates"`
+	Entitlements                map[string]any `plist:"Entitlements"`
+	ExpirationDate              time.Time      `plist:"ExpirationDate"`
+	Name                        string         `plist:"Name"`
+	ProvisionedDevices          []string       `plist:"ProvisionedDevices"`
+	ProvisionsAllDevices        bool           `plist:"ProvisionsAllDevices"`
+	TeamIdentifier              []string       `plist:"TeamIdentifier"`
+	TeamName                    string         `plist:"TeamName"`
+	UUID                        string         `plist:"UUID"`
+}
+
+// ParseProvisioningProfile reads the property list from a signed provisioning
+// profile. The property list is taken from the CMS (PKCS#7) SignedData
+// envelope; data that is not a well-formed envelope is searched for an
+// embedded XML property list instead. The CMS signature is not verified.
+func ParseProvisioningProfile(data []byte) (*ProvisioningProfile, error) {
+	content, err := cmsSignedContent(data)
+	if err != nil {
+		start := bytes.Index(data, []byte("<?xml"))
+		end := bytes.LastIndex(data, []byte("</plist>"))
+		if start < 0 || end < start {
+			return nil, errors.New("not a provisioning profile (no property list found)")
+		}
+		content = data[start : end+len("</plist>")]
+	}
+	var profile ProvisioningProfile
+	if _, err := plist.Unmarshal(content, &profile); err != nil {
+		return nil, fmt.Errorf("parse provisioning profile: %w", err)
+	}
+	return &profile, nil
+}
+
+var (
+	oidCMSData       = []byte{0x2a, 0x86, 0x48, 0x86, 0xf7, 0x0d, 0x01, 0x07, 0x01}
+	oidCMSSignedData = []byte{0x2a, 0x86, 0x48, 0x86, 0xf7, 0x0d, 0x01, 0x07, 0x02}
+)
+
+// cmsSignedContent returns the encapsulated content of a CMS SignedData
+// ContentInfo (RFC 5652). Profiles are BER encoded with indefinite lengths,
+// which encoding/asn1 rejects, so the envelope is walked by hand.
+func cmsSignedContent(data []byte) ([]byte, error) {
+	contentInfo, err := berChildren(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(contentInfo) < 2 || contentInfo[0].tag != 0x06 || !bytes.Equal(contentInfo[0].content, oidCMSSignedData) || contentInfo[1].tag != 0xa0 {
+		return nil, errors.New("not CMS signed data")
+	}
+	signedData, err := berChildren(contentInfo[1].content)
+	if err != nil {
+		return nil, err
+	}
+	// SignedData: version, digestAlgorithms, encapContentInfo, ...
+	if len(signedData) < 3 || signedData[2].tag != 0x30 {
+		return nil, errors.New("invalid CMS signed data")
+	}
+	encap, err := berSequence(signedData[2].content)
+	if err != nil {
+		return nil, err
+	}
+	if len(encap) < 2 || encap[0].tag != 0x06 || !bytes.Equal(encap[0].content, oidCMSData) || encap[1].tag != 0xa0 {
+		return nil, errors.New("CMS signed data has no content")
+	}
+	octets, err := berSequence(encap[1].content)
+	if err != nil {
+		return nil, err
+	}
+	if len(octets) != 1 {
+		return nil, errors.New("invalid CMS content")
+	}
+	return berOctetString(octets[0])
+}
+
+type berElement struct {
+	tag     byte
+	content []byte
+}
+
+// berChildren parses data as a single SEQUENCE and returns its elements.
+func berChildren(data []byte) ([]berElement, error) {
+	elem, _, err := readBER(data, 0)
+	if err != nil {
+		return nil, err
+	}
+	if elem.tag != 0x30 {
+		return nil, fmt.Errorf("expected a sequence, got BER tag 0x%02x", elem.tag)
+	}
+	return berSequence(elem.content)
+}
+
+// berSequence parses the consecutive elements in data.
+func berSequence(data []byte) ([]berElement, error) {
+	var elems []berElement
+	for len(data) > 0 {
+		elem, rest, err := readBER(data, 0)
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+		data = rest
+	}
+	return elems, nil
+}
+
+// berOctetString returns the value of a primitive or constructed OCTET
+// STRING.
+func berOctetString(elem berElement) ([]byte, error) {
+	switch elem.tag {
+	case 0x04:
+		return elem.content, nil
+	case 0x24:
+		chunks, err := berSequence(elem.content)
+		if err != nil {
+			return nil, err
+		}
+		var value []byte
+		for _, chunk := range chunks {
+			part, err := berOctetString(chunk)
+			if err != nil {
+				return nil, err
+			}
+			value = append(value, part...)
+		}
+		return value, nil
+	default:
+		return nil, fmt.Errorf("expected an octet string, got BER tag 0x%02x", elem.tag)
+	}
+}
+
+// readBER reads one element with a low tag number. For indefinite lengths
+// the content excludes the end-of-contents marker.
+func readBER(data []byte, depth int) (berElement, []byte, error) {
+	if depth > 32 {
+		return berElement{}, nil, errors.New("BER nesting too deep")
+	}
+	if len(data) < 2 {
+		return berElement{}, nil, errors.New("truncated BER element")
+	}
+	tag, lengthByte := data[0], data[1]
+	if tag&0x1f == 0x1f {
+		return berElement{}, nil, errors.New("unsupported BER tag")
+	}
+	data = data[2:]
+	switch {
+	case lengthByte == 0x80:
+		if tag&0x20 == 0 {
+			return berElement{}, nil, errors.New("indefinite length on a primitive BER element")
+		}
+		rest := data
+		for {
+			if len(rest) >= 2 && rest[0] == 0 && rest[1] == 0 {
+				return berElement{tag: tag, content: data[:len(data)-len(rest)]}, rest[2:], nil
+			}
+			_, next, err := readBER(rest, depth+1)
+			if err != nil {
+				return berElement{}, nil, err
+			}
+			rest = next
+		}
+	case lengthByte < 0x80:
+		return splitBER(tag, data, int(lengthByte))
+	default:
+		count := int(lengthByte & 0x7f)
+		if count > 4 || len(data) < count {
+			return berElement{}, nil, errors.New("invalid BER length")
+		}
+		length := 0
+		for _, b := range data[:count] {
+			length = length<<8 | int(b)
+		}
+		return splitBER(tag, data[count:], length)
+	}
+}
+
+func splitBER(tag byte, data []byte, length int) (berElement, []byte, error) {
+	if length < 0 || length > len(data) {
+		return berElement{}, nil, errors.New("truncated BER element")
+	}
+	return berElement{tag: tag, content: data[:length]}, data[length:], nil
+}
+
+// ApplicationIdentifier returns the profile's team-prefixed app ID, e.g.
+// ABCDE12345.com.example.app.
+func (p *ProvisioningProfile) ApplicationIdentifier() string {
+	for _, key := range []string{"application-identifier", "com.apple.application-identifier"} {
+		if value, ok := p.Entitlements[key].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// BundleID returns the application identifier without the team prefix. It
+// may be a wildcard such as com.example.*.
+func (p *ProvisioningProfile) BundleID() string {
+	identifier := p.ApplicationIdentifier()
+	for _, prefix := range append(p.ApplicationIdentifierPrefix, p.TeamIdentifier...) {
+		if rest, ok := strings.CutPrefix(identifier, prefix+"."); ok {
+			return rest
+		}
+	}
+	if _, rest, ok := strings.Cut(identifier, "."); ok {
+		return rest
+	}
+	return identifier
+}
+
+// HasCertificate reports whether the DER certificate is one of the profile's
+// developer certificates.
+func (p *ProvisioningProfile) HasCertificate(der []byte) bool {
+	for _, cert := range p.DeveloperCertificates {
+		if bytes.Equal(cert, der) {
+			return true
+		}
+	}
+	return false
+}
+
+// Expired reports whether the profile has expired at now.
+func (p *ProvisioningProfile) Expired(now time.Time) bool {
+	return !p.ExpirationDate.IsZero() && !now.Before(p.ExpirationDate)
+}
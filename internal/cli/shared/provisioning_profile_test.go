@@ -0,0 +1,80 @@
+package shared
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestParseProvisioningProfile(t *testing.T) {
+	data := []byte("0\x82signed\x00" + `<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0"><dict>
+<key>Name</key><string>Mac Store</string>
+<key>UUID</key><string>UUID-1</string>
+<key>TeamIdentifier</key><array><string>TEAM123456</string></array>
+<key>ExpirationDate</key><date>2030-01-02T03:04:05Z</date>
+<key>DeveloperCertificates</key><array><data>AQID</data></array>
+<key>Entitlements</key><dict><key>com.apple.application-identifier</key><string>TEAM123456.com.example.*</string></dict>
+</dict></plist>` + "\x00trailer")
+
+	profile, err := ParseProvisioningProfile(data)
+	if err != nil {
+		t.Fatalf("ParseProvisioningProfile() error: %v", err)
+	}
+	if profile.Name != "Mac Store" || profile.UUID != "UUID-1" || profile.BundleID() != "com.example.*" {
+		t.Fatalf("unexpected profile: %+v (bundle %q)", profile, profile.BundleID())
+	}
+	if !profile.HasCertificate([]byte{1, 2, 3}) || profile.HasCertificate([]byte{1}) {
+		t.Fatal("HasCertificate() mismatch")
+	}
+	if profile.Expired(time.Date(2029, 1, 1, 0, 0, 0, 0, time.UTC)) || !profile.Expired(time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Fatal("Expired() mismatch")
+	}
+
+	if _, err := ParseProvisioningProfile([]byte("not a profile")); err == nil {
+		t.Fatal("expected error for data without a property list")
+	}
+}
+
+func TestParseProvisioningProfileCMSEnvelope(t *testing.T) {
+	xml := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0"><dict>
+<key>Name</key><string>Chunked</string>
+<key>UUID</key><string>UUID-2</string>
+</dict></plist>`)
+	der := func(tag byte, content ...[]byte) []byte {
+		body := bytes.Join(content, nil)
+		return append([]byte{tag, 0x81, byte(len(body))}, body...)
+	}
+	indefinite := func(tag byte, content ...[]byte) []byte {
+		out := append([]byte{tag, 0x80}, bytes.Join(content, nil)...)
+		return append(out, 0, 0)
+	}
+	// Apple signs profiles with indefinite lengths; the content is split
+	// into chunks so only a real envelope parse yields the property list.
+	half := len(xml) / 2
+	envelope := indefinite(0x30,
+		der(0x06, oidCMSSignedData),
+		indefinite(0xa0, indefinite(0x30,
+			der(0x02, []byte{1}),
+			der(0x31),
+			indefinite(0x30,
+				der(0x06, oidCMSData),
+				indefinite(0xa0, indefinite(0x24, der(0x04, xml[:half]), der(0x04, xml[half:]))),
+			),
+			der(0x31),
+		)),
+	)
+
+	profile, err := ParseProvisioningProfile(envelope)
+	if err != nil {
+		t.Fatalf("ParseProvisioningProfile() error: %v", err)
+	}
+	if profile.Name != "Chunked" || profile.UUID != "UUID-2" {
+		t.Fatalf("unexpected profile: %+v", profile)
+	}
+
+	if _, err := cmsSignedContent(envelope[:len(envelope)-4]); err == nil {
+		t.Fatal("expected error for a truncated envelope")
+	}
+}
@@ -0,0 +1,239 @@
+package shared
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// ResourceKind identifies what an ID flag refers to for name resolution.
+type ResourceKind string
+
+const (
+	ResourceApp           ResourceKind = "app"
+	ResourceVersion       ResourceKind = "version"
+	ResourceBuild         ResourceKind = "build"
+	ResourceBetaGroup     ResourceKind = "betaGroup"
+	ResourceBetaTester    ResourceKind = "betaTester"
+	ResourceUser          ResourceKind = "user"
+	ResourceInAppPurchase ResourceKind = "inAppPurchase"
+	ResourceSubscription  ResourceKind = "subscription"
+)
+
+// resourceFlagValue marks a flag whose value may be a name, bundle ID,
+// build number, or email instead of an ID.
+type resourceFlagValue struct {
+	flag.Value
+	kind ResourceKind
+	list bool
+}
+
+func (v *resourceFlagValue) Get() any {
+	if getter, ok := v.Value.(flag.Getter); ok {
+		return getter.Get()
+	}
+	return v.String()
+}
+
+// ResolveNames opts the named flags of fs into name resolution for kind.
+func ResolveNames(fs *flag.FlagSet, kind ResourceKind, names ...string) {
+	markResourceFlags(fs, kind, false, names)
+}
+
+// ResolveNameLists is ResolveNames for comma-separated list flags.
+func ResolveNameLists(fs *flag.FlagSet, kind ResourceKind, names ...string) {
+	markResourceFlags(fs, kind, true, names)
+}
+
+func markResourceFlags(fs *flag.FlagSet, kind ResourceKind, list bool, names []string) {
+	for _, name := range names {
+		f := fs.Lookup(name)
+		if f == nil {
+			panic(fmt.Sprintf("shared: flag --%s is not defined", name))
+		}
+		f.Value = &resourceFlagValue{Value: f.Value, kind: kind, list: list}
+	}
+}
+
+// EnableResourceResolution wraps every command in the tree so that its ID
+// flags are resolved by ResolveResourceFlags before it runs.
+func EnableResourceResolution(commands []*ffcli.Command) {
+	for _, cmd := range commands {
+		if cmd == nil {
+			continue
+		}
+		if exec, fs := cmd.Exec, cmd.FlagSet; exec != nil && fs != nil {
+			cmd.Exec = func(ctx context.Context, args []string) error {
+				if err := ResolveResourceFlags(ctx, fs); err != nil {
+					return err
+				}
+				return exec(ctx, args)
+			}
+		}
+		EnableResourceResolution(cmd.Subcommands)
+	}
+}
+
+// ResolveResourceFlags replaces names in the set flags of fs that opted in
+// through ResolveNames with IDs. When an opted-in --app flag is empty, a
+// bundle ID or name in ASC_APP_ID is resolved into the flag. No client is
+// created unless a value needs a lookup.
+func ResolveResourceFlags(ctx context.Context, fs *flag.FlagSet) error {
+	if fs == nil {
+		return nil
+	}
+	var flags []*flag.Flag
+	fs.Visit(func(f *flag.Flag) {
+		kind := resourceFlagKind(f)
+		if kind == "" {
+			return
+		}
+		for _, value := range resourceFlagValues(f) {
+			if resourceNeedsLookup(kind, value) {
+				flags = append(flags, f)
+				return
+			}
+		}
+	})
+	appFlag := fs.Lookup("app")
+	envApp := ""
+	if appFlag != nil && resourceFlagKind(appFlag) == ResourceApp && strings.TrimSpace(appFlag.Value.String()) == "" {
+		if value := strings.TrimSpace(os.Getenv("ASC_APP_ID")); appRefNeedsLookup(value) {
+			envApp = value
+		}
+	}
+	if len(flags) == 0 && envApp == "" {
+		return nil
+	}
+
+	client, err := getASCClient()
+	if err != nil {
+		return err
+	}
+	requestCtx, cancel := contextWithTimeout(ctx)
+	defer cancel()
+
+	// Apps first: the other lookups are scoped to the resolved app.
+	if envApp != "" {
+		id, err := ResolveAppRef(requestCtx, client, envApp)
+		if err != nil {
+			return fmt.Errorf("ASC_APP_ID: %w", err)
+		}
+		if err := appFlag.Value.Set(id); err != nil {
+			return err
+		}
+	}
+	for _, f := range flags {
+		if resourceFlagKind(f) == ResourceApp {
+			if err := resolveResourceFlag(requestCtx, client, f, "", ""); err != nil {
+				return err
+			}
+		}
+	}
+
+	appID := ""
+	if appFlag == nil || !strings.Contains(appFlag.Value.String(), ",") {
+		appID = resolveAppID(flagValue(appFlag))
+		if appRefNeedsLookup(appID) {
+			if appID, err = ResolveAppRef(requestCtx, client, appID); err != nil {
+				return fmt.Errorf("app: %w", err)
+			}
+		}
+	}
+	platform := flagValue(fs.Lookup("platform"))
+	for _, f := range flags {
+		if resourceFlagKind(f) != ResourceApp {
+			if err := resolveResourceFlag(requestCtx, client, f, appID, platform); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func resolveResourceFlag(ctx context.Context, client *asc.Client, f *flag.Flag, appID, platform string) error {
+	values := resourceFlagValues(f)
+	resolved := make([]string, 0, len(values))
+	for _, value := range values {
+		var (
+			id  string
+			err error
+		)
+		switch resourceFlagKind(f) {
+		case ResourceApp:
+			id, err = ResolveAppRef(ctx, client, value)
+		case ResourceVersion:
+			id, err = ResolveAppStoreVersionRef(ctx, client, appID, value, platform)
+		case ResourceBuild:
+			id, err = ResolveBuildRef(ctx, client, appID, value)
+		case ResourceBetaGroup:
+			id, err = ResolveBetaGroupRef(ctx, client, appID, value)
+		case ResourceBetaTester:
+			id, err = ResolveBetaTesterRef(ctx, client, appID, value)
+		case ResourceUser:
+			id, err = ResolveUserRef(ctx, client, value)
+		case ResourceInAppPurchase:
+			id, err = ResolveInAppPurchaseRef(ctx, client, appID, value)
+		case ResourceSubscription:
+			id, err = ResolveSubscriptionRef(ctx, client, appID, value)
+		}
+		if err != nil {
+			return fmt.Errorf("--%s: %w", f.Name, err)
+		}
+		resolved = append(resolved, id)
+	}
+	return f.Value.Set(strings.Join(resolved, ","))
+}
+
+// resourceFlagKind returns the kind a flag opted into, or "" for flags that
+// take IDs only.
+func resourceFlagKind(f *flag.Flag) ResourceKind {
+	if value, ok := f.Value.(*resourceFlagValue); ok {
+		return value.kind
+	}
+	return ""
+}
+
+func resourceNeedsLookup(kind ResourceKind, value string) bool {
+	switch kind {
+	case ResourceApp:
+		return appRefNeedsLookup(value)
+	case ResourceVersion:
+		return versionStringPattern.MatchString(value)
+	case ResourceBuild:
+		return buildNumberPattern.MatchString(value)
+	case ResourceBetaGroup:
+		return betaGroupRefNeedsLookup(value)
+	case ResourceBetaTester, ResourceUser:
+		return emailRefNeedsLookup(value)
+	case ResourceInAppPurchase, ResourceSubscription:
+		return productRefNeedsLookup(value)
+	default:
+		return false
+	}
+}
+
+// resourceFlagValues splits list flags into their values.
+func resourceFlagValues(f *flag.Flag) []string {
+	value := strings.TrimSpace(f.Value.String())
+	if opted, ok := f.Value.(*resourceFlagValue); ok && opted.list {
+		return splitCSV(value)
+	}
+	if value == "" {
+		return nil
+	}
+	return []string{value}
+}
+
+func flagValue(f *flag.Flag) string {
+	if f == nil {
+		return ""
+	}
+	return strings.TrimSpace(f.Value.String())
+}
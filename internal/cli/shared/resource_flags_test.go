@@ -0,0 +1,42 @@
+package shared
+
+import (
+	"context"
+	"flag"
+	"testing"
+)
+
+func TestResolveResourceFlags_IgnoresFlagsThatDidNotOptIn(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.String("build", "", "Build ID")
+	if err := fs.Parse([]string{"--build", "42"}); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	// A lookup would need credentials; none are configured here.
+	t.Setenv("ASC_KEY_ID", "")
+	if err := ResolveResourceFlags(context.Background(), fs); err != nil {
+		t.Fatalf("expected no lookup for a flag without ResolveNames, got %v", err)
+	}
+	if got := fs.Lookup("build").Value.String(); got != "42" {
+		t.Fatalf("expected value to be left alone, got %q", got)
+	}
+}
+
+func TestResolveNameLists_SplitsValues(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.String("group", "", "Beta group IDs")
+	ResolveNameLists(fs, ResourceBetaGroup, "group")
+	if err := fs.Parse([]string{"--group", "G1, Beta Testers"}); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	f := fs.Lookup("group")
+	if kind := resourceFlagKind(f); kind != ResourceBetaGroup {
+		t.Fatalf("expected beta group kind, got %q", kind)
+	}
+	values := resourceFlagValues(f)
+	if len(values) != 2 || values[0] != "G1" || values[1] != "Beta Testers" {
+		t.Fatalf("unexpected values: %q", values)
+	}
+}
@@ -0,0 +1,350 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// ID flags also accept the identifiers people know: bundle IDs and names for
+// apps, version strings for App Store versions, build numbers for builds,
+// names for beta groups, emails for testers and users, and product IDs for
+// in-app purchases and subscriptions. Values that could be IDs are passed
+// through without a request; the name: and product: prefixes force a lookup
+// for names and product IDs that would otherwise look like IDs.
+const (
+	resourceNamePrefix    = "name:"
+	resourceProductPrefix = "product:"
+)
+
+// resolveLimit is the page size for lookups; a lookup that would need more
+// than one page is ambiguous anyway.
+const resolveLimit = 200
+
+var (
+	numericIDPattern     = regexp.MustCompile(`^[0-9]+$`)
+	versionStringPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+){0,3}$`)
+	buildNumberPattern   = regexp.MustCompile(`^[0-9]+(\.[0-9]+)*$`)
+)
+
+// resolvedRefs caches lookups for the rest of one command invocation, keyed
+// by kind, profile, scope, and value.
+var resolvedRefs = struct {
+	sync.Mutex
+	ids map[string]string
+}{ids: map[string]string{}}
+
+func cachedResolve(kind, scope, value string, lookup func() (string, error)) (string, error) {
+	key := strings.Join([]string{kind, resolveProfileName(), scope, value}, "\x00")
+	resolvedRefs.Lock()
+	id, ok := resolvedRefs.ids[key]
+	resolvedRefs.Unlock()
+	if ok {
+		return id, nil
+	}
+	id, err := lookup()
+	if err != nil {
+		return "", err
+	}
+	resolvedRefs.Lock()
+	resolvedRefs.ids[key] = id
+	resolvedRefs.Unlock()
+	return id, nil
+}
+
+// ResetResolvedRefs clears the lookup cache. It is called at the start of
+// every root invocation (a CLI run, an MCP tool call, a workflow step) so
+// long-lived processes never reuse a lookup from an earlier command.
+func ResetResolvedRefs() {
+	resolvedRefs.Lock()
+	resolvedRefs.ids = map[string]string{}
+	resolvedRefs.Unlock()
+}
+
+func appRefNeedsLookup(value string) bool {
+	if value == "" || numericIDPattern.MatchString(value) {
+		return false
+	}
+	return strings.HasPrefix(value, resourceNamePrefix) || strings.Contains(value, ".") || strings.ContainsAny(value, " \t")
+}
+
+func betaGroupRefNeedsLookup(value string) bool {
+	return strings.HasPrefix(value, resourceNamePrefix) || strings.ContainsAny(value, " \t")
+}
+
+func emailRefNeedsLookup(value string) bool {
+	return strings.Contains(value, "@")
+}
+
+func productRefNeedsLookup(value string) bool {
+	if value == "" || numericIDPattern.MatchString(value) {
+		return false
+	}
+	return strings.HasPrefix(value, resourceProductPrefix) || strings.Contains(value, ".")
+}
+
+// ResolveAppRef returns the app ID for an app ID, bundle ID, or app name.
+func ResolveAppRef(ctx context.Context, client *asc.Client, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if !appRefNeedsLookup(value) {
+		return value, nil
+	}
+	return cachedResolve("app", "", value, func() (string, error) {
+		name, explicitName := strings.CutPrefix(value, resourceNamePrefix)
+		if !explicitName && !strings.ContainsAny(value, " \t") {
+			resp, err := client.GetApps(ctx, asc.WithAppsBundleIDs([]string{value}), asc.WithAppsLimit(resolveLimit))
+			if err != nil {
+				return "", fmt.Errorf("look up app %q: %w", value, err)
+			}
+			var matches []string
+			for _, app := range resp.Data {
+				if strings.EqualFold(app.Attributes.BundleID, value) {
+					matches = append(matches, app.ID)
+				}
+			}
+			if len(matches) == 1 {
+				return matches[0], nil
+			}
+			if len(matches) > 1 {
+				return "", fmt.Errorf("multiple apps have bundle ID %q (%s); use the app ID", value, strings.Join(matches, ", "))
+			}
+		}
+
+		resp, err := client.GetApps(ctx, asc.WithAppsNames([]string{name}), asc.WithAppsLimit(resolveLimit))
+		if err != nil {
+			return "", fmt.Errorf("look up app %q: %w", value, err)
+		}
+		var matches []string
+		for _, app := range resp.Data {
+			if strings.EqualFold(strings.TrimSpace(app.Attributes.Name), name) {
+				matches = append(matches, fmt.Sprintf("%s (%s)", app.ID, app.Attributes.BundleID))
+			}
+		}
+		switch len(matches) {
+		case 0:
+			if explicitName {
+				return "", fmt.Errorf("no app named %q", name)
+			}
+			return "", fmt.Errorf("no app with bundle ID or name %q", value)
+		case 1:
+			id, _, _ := strings.Cut(matches[0], " ")
+			return id, nil
+		default:
+			return "", fmt.Errorf("multiple apps named %q: %s; use the app ID or bundle ID", name, strings.Join(matches, ", "))
+		}
+	})
+}
+
+// ResolveAppStoreVersionRef returns the version ID for a version ID or a
+// version string such as 2.1.0. platform narrows a version string that exists
+// on several platforms.
+func ResolveAppStoreVersionRef(ctx context.Context, client *asc.Client, appID, value, platform string) (string, error) {
+	value = strings.TrimSpace(value)
+	if !versionStringPattern.MatchString(value) {
+		return value, nil
+	}
+	platform = strings.ToUpper(strings.TrimSpace(platform))
+	if appID == "" {
+		return "", fmt.Errorf("resolving version %q requires --app (or ASC_APP_ID)", value)
+	}
+	return cachedResolve("version", appID+"/"+platform, value, func() (string, error) {
+		opts := []asc.AppStoreVersionsOption{
+			asc.WithAppStoreVersionsVersionStrings([]string{value}),
+			asc.WithAppStoreVersionsLimit(resolveLimit),
+		}
+		if platform != "" {
+			opts = append(opts, asc.WithAppStoreVersionsPlatforms([]string{platform}))
+		}
+		resp, err := client.GetAppStoreVersions(ctx, appID, opts...)
+		if err != nil {
+			return "", fmt.Errorf("look up version %q: %w", value, err)
+		}
+		var ids, descriptions []string
+		for _, version := range resp.Data {
+			if version.Attributes.VersionString != value {
+				continue
+			}
+			ids = append(ids, version.ID)
+			descriptions = append(descriptions, fmt.Sprintf("%s (%s)", version.ID, version.Attributes.Platform))
+		}
+		switch len(ids) {
+		case 0:
+			if platform != "" {
+				return "", fmt.Errorf("no App Store version %q on %s for app %s", value, platform, appID)
+			}
+			return "", fmt.Errorf("no App Store version %q for app %s", value, appID)
+		case 1:
+			return ids[0], nil
+		default:
+			return "", fmt.Errorf("multiple App Store versions %q: %s; pass --platform or the version ID", value, strings.Join(descriptions, ", "))
+		}
+	})
+}
+
+// ResolveBuildRef returns the build ID for a build ID or build number.
+func ResolveBuildRef(ctx context.Context, client *asc.Client, appID, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if !buildNumberPattern.MatchString(value) {
+		return value, nil
+	}
+	if appID == "" {
+		return "", fmt.Errorf("resolving build number %q requires --app (or ASC_APP_ID)", value)
+	}
+	return cachedResolve("build", appID, value, func() (string, error) {
+		resp, err := client.GetBuilds(ctx, appID, asc.WithBuildsVersion(value), asc.WithBuildsLimit(resolveLimit))
+		if err != nil {
+			return "", fmt.Errorf("look up build %q: %w", value, err)
+		}
+		var ids []string
+		for _, build := range resp.Data {
+			if build.Attributes.Version == value {
+				ids = append(ids, build.ID)
+			}
+		}
+		switch len(ids) {
+		case 0:
+			return "", fmt.Errorf("no build with build number %q for app %s", value, appID)
+		case 1:
+			return ids[0], nil
+		default:
+			return "", fmt.Errorf("multiple builds have build number %q (%s); use the build ID", value, strings.Join(ids, ", "))
+		}
+	})
+}
+
+// ResolveBetaGroupRef returns the beta group ID for a group ID or name.
+func ResolveBetaGroupRef(ctx context.Context, client BetaGroupsClient, appID, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if !betaGroupRefNeedsLookup(value) {
+		return value, nil
+	}
+	name := strings.TrimPrefix(value, resourceNamePrefix)
+	if appID == "" {
+		return "", fmt.Errorf("resolving beta group %q requires --app (or ASC_APP_ID)", name)
+	}
+	return cachedResolve("betaGroup", appID, name, func() (string, error) {
+		ids, err := ResolveBetaGroupIDs(ctx, client, appID, []string{name})
+		if err != nil {
+			return "", err
+		}
+		return ids[0], nil
+	})
+}
+
+// ResolveBetaTesterRef returns the beta tester ID for a tester ID or email.
+// appID may be empty to search every app.
+func ResolveBetaTesterRef(ctx context.Context, client *asc.Client, appID, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if !emailRefNeedsLookup(value) {
+		return value, nil
+	}
+	return cachedResolve("betaTester", appID, strings.ToLower(value), func() (string, error) {
+		resp, err := client.GetBetaTesters(ctx, appID, asc.WithBetaTestersEmail(value), asc.WithBetaTestersLimit(resolveLimit))
+		if err != nil {
+			return "", fmt.Errorf("look up beta tester %q: %w", value, err)
+		}
+		var ids []string
+		for _, tester := range resp.Data {
+			if strings.EqualFold(tester.Attributes.Email, value) {
+				ids = append(ids, tester.ID)
+			}
+		}
+		return singleMatch(ids, "beta tester", value, "email")
+	})
+}
+
+// ResolveUserRef returns the user ID for a user ID or email (username).
+func ResolveUserRef(ctx context.Context, client *asc.Client, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if !emailRefNeedsLookup(value) {
+		return value, nil
+	}
+	return cachedResolve("user", "", strings.ToLower(value), func() (string, error) {
+		resp, err := client.GetUsers(ctx, asc.WithUsersEmail(value), asc.WithUsersLimit(resolveLimit))
+		if err != nil {
+			return "", fmt.Errorf("look up user %q: %w", value, err)
+		}
+		var ids []string
+		for _, user := range resp.Data {
+			if strings.EqualFold(user.Attributes.Username, value) || strings.EqualFold(user.Attributes.Email, value) {
+				ids = append(ids, user.ID)
+			}
+		}
+		return singleMatch(ids, "user", value, "email")
+	})
+}
+
+// ResolveInAppPurchaseRef returns the in-app purchase ID for an ID or
+// product ID.
+func ResolveInAppPurchaseRef(ctx context.Context, client *asc.Client, appID, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if !productRefNeedsLookup(value) {
+		return value, nil
+	}
+	productID := strings.TrimPrefix(value, resourceProductPrefix)
+	if appID == "" {
+		return "", fmt.Errorf("resolving in-app purchase %q requires --app (or ASC_APP_ID)", productID)
+	}
+	return cachedResolve("inAppPurchase", appID, productID, func() (string, error) {
+		resp, err := client.GetInAppPurchasesV2(ctx, appID, asc.WithIAPProductIDs([]string{productID}), asc.WithIAPLimit(resolveLimit))
+		if err != nil {
+			return "", fmt.Errorf("look up in-app purchase %q: %w", productID, err)
+		}
+		var ids []string
+		for _, iap := range resp.Data {
+			if iap.Attributes.ProductID == productID {
+				ids = append(ids, iap.ID)
+			}
+		}
+		return singleMatch(ids, "in-app purchase", productID, "product ID")
+	})
+}
+
+// ResolveSubscriptionRef returns the subscription ID for an ID or product ID,
+// searching every subscription group of the app.
+func ResolveSubscriptionRef(ctx context.Context, client *asc.Client, appID, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if !productRefNeedsLookup(value) {
+		return value, nil
+	}
+	productID := strings.TrimPrefix(value, resourceProductPrefix)
+	if appID == "" {
+		return "", fmt.Errorf("resolving subscription %q requires --app (or ASC_APP_ID)", productID)
+	}
+	return cachedResolve("subscription", appID, productID, func() (string, error) {
+		groups, err := client.GetSubscriptionGroups(ctx, appID, asc.WithSubscriptionGroupsLimit(resolveLimit))
+		if err != nil {
+			return "", fmt.Errorf("look up subscription %q: %w", productID, err)
+		}
+		var ids []string
+		for _, group := range groups.Data {
+			resp, err := client.GetSubscriptions(ctx, group.ID, asc.WithSubscriptionsProductIDs([]string{productID}), asc.WithSubscriptionsLimit(resolveLimit))
+			if err != nil {
+				return "", fmt.Errorf("look up subscription %q: %w", productID, err)
+			}
+			for _, subscription := range resp.Data {
+				if subscription.Attributes.ProductID == productID {
+					ids = append(ids, subscription.ID)
+				}
+			}
+		}
+		return singleMatch(ids, "subscription", productID, "product ID")
+	})
+}
+
+func singleMatch(ids []string, kind, value, by string) (string, error) {
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s with %s %q", kind, by, value)
+	case 1:
+		return ids[0], nil
+	default:
+		sort.Strings(ids)
+		return "", fmt.Errorf("multiple %ss have %s %q (%s); use the ID", kind, by, value, strings.Join(ids, ", "))
+	}
+}
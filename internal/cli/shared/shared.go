@@ -65,6 +65,13 @@ var (
 	debug               OptionalBool
 	apiDebug            OptionalBool
 	noUpdate            bool
+	noCache             bool
+	refreshCache        bool
+	simulate            bool
+	recordPath          string
+	replayPath          string
+	outputSelect        string
+	outputQuery         string
 )
 
 var (
@@ -86,6 +93,14 @@ func BindRootFlags(fs *flag.FlagSet) {
 	fs.Var(&debug, "debug", "Enable debug logging to stderr")
 	fs.Var(&apiDebug, "api-debug", "Enable HTTP debug logging to stderr (redacts sensitive values)")
 	fs.BoolVar(&noUpdate, "no-update", false, "Skip update checks and auto-update")
+	fs.BoolVar(&noCache, "no-cache", false, "Bypass the response cache (overrides ASC_CACHE_TTL/config)")
+	fs.BoolVar(&refreshCache, "refresh", false, "Refetch cached responses and update the cache")
+	fs.BoolVar(&simulate, "simulate", false, "Print mutating API requests as a plan instead of sending them (GET requests still run)")
+	fs.StringVar(&recordPath, "record", "", "Record every HTTP request/response (redacted) to a JSONL cassette file")
+	fs.StringVar(&replayPath, "replay", "", "Serve HTTP responses from a cassette file instead of the network")
+	fs.StringVar(&outputSelect, "select", "", "Comma-separated field paths to keep in output (e.g. id,attributes.version)")
+	fs.StringVar(&outputQuery, "query", "", "JMESPath-style expression applied to output before rendering")
+	BindFanOutFlags(fs)
 	BindCIFlags(fs)
 }
 
@@ -94,6 +109,17 @@ func SelectedProfile() string {
 	return selectedProfile
 }
 
+// SimulateEnabled reports whether the root --simulate flag is set.
+func SimulateEnabled() bool {
+	return simulate
+}
+
+// PrintSimulationPlan prints a simulation plan in the command's output format.
+// Output selection (--select/--query) applies to command output, not the plan.
+func PrintSimulationPlan(report *asc.SimulationReport, format string, pretty bool) error {
+	return printFormatted(report, format, pretty)
+}
+
 // NoUpdate reports whether update checks are disabled via flag.
 func NoUpdate() bool {
 	return noUpdate
@@ -363,7 +389,14 @@ func resolveCredentials() (resolvedCredentials, error) {
 func getASCClient() (*asc.Client, error) {
 	resolved, err := resolveCredentials()
 	if err != nil {
-		return nil, err
+		if !errors.Is(err, ErrMissingAuth) || strings.TrimSpace(replayPath) == "" {
+			return nil, err
+		}
+		// Replayed responses don't need real credentials; sign with a
+		// throwaway key so cassettes can be replayed anywhere.
+		if resolved, err = replayCredentials(); err != nil {
+			return nil, err
+		}
 	}
 	if retryLog.IsSet() {
 		value := retryLog.Value()
@@ -383,7 +416,37 @@ func getASCClient() (*asc.Client, error) {
 	} else {
 		asc.SetDebugHTTPOverride(nil)
 	}
-	return asc.NewClient(resolved.keyID, resolved.issuerID, resolved.keyPath)
+	client, err := asc.NewClient(resolved.keyID, resolved.issuerID, resolved.keyPath)
+	if err != nil {
+		return nil, err
+	}
+	cache, err := resolveResponseCache()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: response cache disabled: %v\n", err)
+	}
+	client.SetResponseCache(cache)
+	return client, nil
+}
+
+// resolveResponseCache returns the response cache for the current profile,
+// or nil when caching is disabled. Recording and replaying bypass the cache so
+// every request reaches (or comes from) the cassette.
+func resolveResponseCache() (*asc.ResponseCache, error) {
+	if noCache || strings.TrimSpace(recordPath) != "" || strings.TrimSpace(replayPath) != "" {
+		return nil, nil
+	}
+	ttl, err := asc.ResolveCacheTTL()
+	if err != nil {
+		return nil, err
+	}
+	if ttl <= 0 {
+		return nil, nil
+	}
+	return asc.NewResponseCache(asc.ResponseCacheOptions{
+		Namespace: resolveProfileName(),
+		TTL:       ttl,
+		Refresh:   refreshCache,
+	})
 }
 
 func checkMixedCredentialSources(sources credentialSource) error {
@@ -528,7 +591,19 @@ func strictAuthEnabled() bool {
 
 func printOutput(data any, format string, pretty bool) error {
 	format = strings.ToLower(format)
-	switch format {
+	selection, err := resolveOutputSelection()
+	if err != nil {
+		return err
+	}
+	if selection.active() {
+		return printSelectedOutput(data, format, pretty, selection)
+	}
+	return printFormatted(data, format, pretty)
+}
+
+// printFormatted renders data in format without applying --select/--query.
+func printFormatted(data any, format string, pretty bool) error {
+	switch strings.ToLower(format) {
 	case "json":
 		if pretty {
 			return asc.PrintPrettyJSON(data)
@@ -544,6 +619,16 @@ func printOutput(data any, format string, pretty bool) error {
 			return fmt.Errorf("--pretty is only valid with JSON output")
 		}
 		return asc.PrintTable(data)
+	case "csv":
+		if pretty {
+			return fmt.Errorf("--pretty is only valid with JSON output")
+		}
+		return asc.PrintCSV(data)
+	case "ndjson":
+		if pretty {
+			return fmt.Errorf("--pretty is only valid with JSON output")
+		}
+		return asc.PrintNDJSON(data)
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
@@ -604,7 +689,7 @@ var (
 
 // DefaultOutputFormat returns the default output format for CLI commands.
 // It checks the ASC_DEFAULT_OUTPUT environment variable first, falling back to "json".
-// Valid values are "json", "table", "markdown", and "md".
+// Valid values are "json", "table", "markdown", "md", "csv", and "ndjson".
 func DefaultOutputFormat() string {
 	defaultOutputOnce.Do(func() {
 		defaultOutputValue = resolveDefaultOutput()
@@ -619,10 +704,10 @@ func resolveDefaultOutput() string {
 	}
 	normalized := strings.ToLower(env)
 	switch normalized {
-	case "json", "table", "markdown", "md":
+	case "json", "table", "markdown", "md", "csv", "ndjson":
 		return normalized
 	default:
-		fmt.Fprintf(os.Stderr, "Warning: invalid %s value %q (expected json, table, markdown, or md); using json\n", defaultOutputEnvVar, env)
+		fmt.Fprintf(os.Stderr, "Warning: invalid %s value %q (expected json, table, markdown, md, csv, or ndjson); using json\n", defaultOutputEnvVar, env)
 		return "json"
 	}
 }
@@ -692,7 +777,11 @@ func validateNextURL(next string) error {
 	if err != nil {
 		return fmt.Errorf("--next must be a valid URL: %w", err)
 	}
-	if parsed.Scheme != "https" || parsed.Host != "api.appstoreconnect.apple.com" {
+	base, err := url.Parse(asc.ResolveBaseURL())
+	if err != nil {
+		return fmt.Errorf("invalid base URL: %w", err)
+	}
+	if parsed.Host != base.Host || (parsed.Scheme != "https" && parsed.Scheme != base.Scheme) {
 		return fmt.Errorf("--next must be an App Store Connect URL")
 	}
 	return nil
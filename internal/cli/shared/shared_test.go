@@ -130,6 +130,18 @@ func TestDefaultOutputFormat_JSON(t *testing.T) {
 	}
 }
 
+func TestDefaultOutputFormat_CSVAndNDJSON(t *testing.T) {
+	for _, value := range []string{"csv", "ndjson"} {
+		t.Run(value, func(t *testing.T) {
+			resetDefaultOutput(t)
+			t.Setenv("ASC_DEFAULT_OUTPUT", value)
+			if got := DefaultOutputFormat(); got != value {
+				t.Fatalf("expected %q, got %q", value, got)
+			}
+		})
+	}
+}
+
 func TestDefaultOutputFormat_CaseInsensitive(t *testing.T) {
 	for _, value := range []string{"TABLE", "Table", "tAbLe", "MARKDOWN", "JSON"} {
 		t.Run(value, func(t *testing.T) {
@@ -195,6 +207,30 @@ func TestValidateNextURL_RejectsMalformedHost(t *testing.T) {
 	}
 }
 
+func TestResolveResponseCache_DisabledWhileRecordingOrReplaying(t *testing.T) {
+	t.Setenv("ASC_CACHE_TTL", "1h")
+	t.Setenv("HOME", t.TempDir())
+	prevRecord, prevReplay, prevNoCache := recordPath, replayPath, noCache
+	t.Cleanup(func() {
+		recordPath, replayPath, noCache = prevRecord, prevReplay, prevNoCache
+	})
+	recordPath, replayPath, noCache = "", "", false
+	if cache, err := resolveResponseCache(); err != nil || cache == nil {
+		t.Fatalf("expected a response cache without a cassette, got %v, %v", cache, err)
+	}
+
+	for _, paths := range [][2]string{{"session.jsonl", ""}, {"", "session.jsonl"}} {
+		recordPath, replayPath = paths[0], paths[1]
+		cache, err := resolveResponseCache()
+		if err != nil {
+			t.Fatalf("resolveResponseCache() error: %v", err)
+		}
+		if cache != nil {
+			t.Fatalf("expected no response cache with --record %q --replay %q", paths[0], paths[1])
+		}
+	}
+}
+
 func TestProgressEnabled_RespectsNoProgressFlag(t *testing.T) {
 	prevNoProgress := noProgress
 	prevIsTerminal := isTerminal
@@ -0,0 +1,247 @@
+package shared
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	pkcs12 "software.sslmate.com/src/go-pkcs12"
+)
+
+// Signing key types accepted by GenerateSigningKey.
+const (
+	SigningKeyTypeRSA = "rsa"
+	SigningKeyTypeEC  = "ec"
+)
+
+const (
+	signingRSAKeyBits         = 2048
+	signingKeyPBKDF2Rounds    = 600000
+	signingKeySaltSize        = 16
+	encryptedPrivateKeyPEMTag = "ENCRYPTED PRIVATE KEY"
+)
+
+var (
+	oidPBES2          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidAES256CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+// GenerateSigningKey creates a private key for a signing certificate: RSA
+// 2048 (the type Apple issues certificates for) or ECDSA P-256.
+func GenerateSigningKey(keyType string) (crypto.Signer, error) {
+	switch strings.ToLower(strings.TrimSpace(keyType)) {
+	case "", SigningKeyTypeRSA:
+		return rsa.GenerateKey(rand.Reader, signingRSAKeyBits)
+	case SigningKeyTypeEC:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, fmt.Errorf("key type must be %s or %s", SigningKeyTypeRSA, SigningKeyTypeEC)
+	}
+}
+
+// CreateSigningCSR returns a DER-encoded certificate signing request for key.
+// App Store Connect replaces the subject of the issued certificate, so only
+// the common name is set.
+func CreateSigningCSR(key crypto.Signer, commonName string) ([]byte, error) {
+	template := &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: commonName},
+	}
+	return x509.CreateCertificateRequest(rand.Reader, template, key)
+}
+
+// EncodeCertificatePEM wraps a DER certificate in a CERTIFICATE PEM block.
+func EncodeCertificatePEM(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// EncodeSigningP12 bundles key and certificate into a password-protected
+// PKCS#12 file. legacy selects 3DES encryption for keychains that cannot
+// import AES-encrypted bundles (macOS before 14).
+func EncodeSigningP12(key crypto.Signer, cert *x509.Certificate, password string, legacy bool) ([]byte, error) {
+	encoder := pkcs12.Modern2023
+	if legacy {
+		encoder = pkcs12.LegacyDES
+	}
+	return encoder.Encode(key, cert, nil, password)
+}
+
+// CheckSigningKeyMatchesCertificate reports an error when cert was not issued
+// for key.
+func CheckSigningKeyMatchesCertificate(key crypto.Signer, cert *x509.Certificate) error {
+	type publicKey interface{ Equal(crypto.PublicKey) bool }
+	if public, ok := key.Public().(publicKey); !ok || !public.Equal(cert.PublicKey) {
+		return errors.New("certificate does not match the private key")
+	}
+	return nil
+}
+
+type encryptedPrivateKeyInfo struct {
+	Algorithm     pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// EncryptSigningKeyPEM encodes key as an "ENCRYPTED PRIVATE KEY" PEM block
+// (PKCS#8 with PBES2: PBKDF2-HMAC-SHA256 and AES-256-CBC), the format
+// openssl and Keychain Access read.
+func EncryptSigningKeyPEM(key crypto.Signer, password string) ([]byte, error) {
+	if password == "" {
+		return nil, errors.New("a password is required to encrypt the private key")
+	}
+	plain, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, signingKeySaltSize)
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	block, err := signingKeyCipher(password, salt, signingKeyPBKDF2Rounds)
+	if err != nil {
+		return nil, err
+	}
+	padding := aes.BlockSize - len(plain)%aes.BlockSize
+	padded := append(plain, bytes.Repeat([]byte{byte(padding)}, padding)...)
+	encrypted := make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, padded)
+
+	kdfParams, err := asn1.Marshal(pbkdf2Params{
+		Salt:           salt,
+		IterationCount: signingKeyPBKDF2Rounds,
+		PRF:            pkix.AlgorithmIdentifier{Algorithm: oidHMACWithSHA256, Parameters: asn1.NullRawValue},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ivParam, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+	schemeParams, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: pkix.AlgorithmIdentifier{Algorithm: oidPBKDF2, Parameters: asn1.RawValue{FullBytes: kdfParams}},
+		EncryptionScheme:  pkix.AlgorithmIdentifier{Algorithm: oidAES256CBC, Parameters: asn1.RawValue{FullBytes: ivParam}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	der, err := asn1.Marshal(encryptedPrivateKeyInfo{
+		Algorithm:     pkix.AlgorithmIdentifier{Algorithm: oidPBES2, Parameters: asn1.RawValue{FullBytes: schemeParams}},
+		EncryptedData: encrypted,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: encryptedPrivateKeyPEMTag, Bytes: der}), nil
+}
+
+// DecryptSigningKeyPEM reads a key written by EncryptSigningKeyPEM (or any
+// PBES2 PBKDF2-HMAC-SHA256/AES-256-CBC PKCS#8 key). Unencrypted "PRIVATE
+// KEY" blocks are accepted as-is.
+func DecryptSigningKeyPEM(data []byte, password string) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM private key found")
+	}
+	plain := block.Bytes
+	switch block.Type {
+	case "PRIVATE KEY":
+	case encryptedPrivateKeyPEMTag:
+		var err error
+		if plain, err = decryptPKCS8(block.Bytes, password); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(plain)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+func decryptPKCS8(der []byte, password string) ([]byte, error) {
+	var info encryptedPrivateKeyInfo
+	if _, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, fmt.Errorf("parse encrypted private key: %w", err)
+	}
+	var scheme pbes2Params
+	if !info.Algorithm.Algorithm.Equal(oidPBES2) {
+		return nil, errors.New("unsupported private key encryption (only PBES2 is supported)")
+	}
+	if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &scheme); err != nil {
+		return nil, fmt.Errorf("parse encrypted private key: %w", err)
+	}
+	var kdf pbkdf2Params
+	if !scheme.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) || !scheme.EncryptionScheme.Algorithm.Equal(oidAES256CBC) {
+		return nil, errors.New("unsupported private key encryption (only PBKDF2 with AES-256-CBC is supported)")
+	}
+	if _, err := asn1.Unmarshal(scheme.KeyDerivationFunc.Parameters.FullBytes, &kdf); err != nil {
+		return nil, fmt.Errorf("parse encrypted private key: %w", err)
+	}
+	if !kdf.PRF.Algorithm.Equal(oidHMACWithSHA256) {
+		return nil, errors.New("unsupported private key encryption (only HMAC-SHA256 is supported)")
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(scheme.EncryptionScheme.Parameters.FullBytes, &iv); err != nil || len(iv) != aes.BlockSize {
+		return nil, errors.New("parse encrypted private key: invalid IV")
+	}
+	if len(info.EncryptedData) == 0 || len(info.EncryptedData)%aes.BlockSize != 0 {
+		return nil, errors.New("parse encrypted private key: invalid length")
+	}
+
+	block, err := signingKeyCipher(password, kdf.Salt, kdf.IterationCount)
+	if err != nil {
+		return nil, err
+	}
+	plain := make([]byte, len(info.EncryptedData))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, info.EncryptedData)
+	padding := int(plain[len(plain)-1])
+	if padding == 0 || padding > aes.BlockSize || !bytes.Equal(plain[len(plain)-padding:], bytes.Repeat([]byte{byte(padding)}, padding)) {
+		return nil, errors.New("decrypt private key: wrong password")
+	}
+	return plain[:len(plain)-padding], nil
+}
+
+func signingKeyCipher(password string, salt []byte, rounds int) (cipher.Block, error) {
+	key, err := pbkdf2.Key(sha256.New, password, salt, rounds, 32)
+	if err != nil {
+		return nil, err
+	}
+	return aes.NewCipher(key)
+}
@@ -0,0 +1,84 @@
+package shared
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// BuildUploadJournalKey returns the journal key for uploading filePath as the
+// given app build. It hashes the file, so call it once per upload.
+func BuildUploadJournalKey(appID, version, buildNumber string, platform asc.Platform, filePath string) (asc.UploadJournalKey, error) {
+	sum, err := asc.ComputeFileChecksum(filePath, asc.ChecksumAlgorithmSHA256)
+	if err != nil {
+		return asc.UploadJournalKey{}, err
+	}
+	return asc.UploadJournalKey{
+		AppID:        strings.TrimSpace(appID),
+		Version:      strings.TrimSpace(version),
+		BuildNumber:  strings.TrimSpace(buildNumber),
+		Platform:     platform,
+		FileChecksum: sum.Hash,
+	}, nil
+}
+
+// FindResumableBuildUpload returns the journal of an interrupted upload that
+// matches key, or nil when there is nothing to resume.
+func FindResumableBuildUpload(key asc.UploadJournalKey) (*asc.UploadJournal, error) {
+	dir, err := asc.DefaultUploadJournalDir()
+	if err != nil {
+		return nil, err
+	}
+	journal, err := asc.FindUploadJournal(dir, key)
+	if err != nil || journal == nil {
+		return nil, err
+	}
+	if len(journal.Operations) == 0 || journal.FileID == "" {
+		_ = journal.Remove()
+		return nil, nil
+	}
+	if journal.Expired(time.Now()) {
+		fmt.Fprintf(os.Stderr, "Warning: upload %s has expired upload URLs; starting a new upload\n", journal.UploadID)
+		_ = journal.Remove()
+		return nil, nil
+	}
+	fmt.Fprintf(os.Stderr, "Resuming upload %s (%d of %d parts already uploaded)\n", journal.UploadID, journal.CompletedCount(), len(journal.Operations))
+	return journal, nil
+}
+
+// StartBuildUploadJournal records a new build upload so it can be resumed later.
+// Failing to write the journal is not fatal: the upload continues without it.
+// Simulated uploads (root --simulate) are not journaled.
+func StartBuildUploadJournal(key asc.UploadJournalKey, uploadID string, fileResp *asc.BuildUploadFileResponse) *asc.UploadJournal {
+	if fileResp == nil || asc.SimulationActive() {
+		return nil
+	}
+	journal := &asc.UploadJournal{
+		UploadJournalKey:    key,
+		UploadID:            uploadID,
+		FileID:              fileResp.Data.ID,
+		FileName:            fileResp.Data.Attributes.FileName,
+		FileSize:            fileResp.Data.Attributes.FileSize,
+		Operations:          fileResp.Data.Attributes.UploadOperations,
+		SourceFileChecksums: fileResp.Data.Attributes.SourceFileChecksums,
+	}
+	dir, err := asc.DefaultUploadJournalDir()
+	if err == nil {
+		err = asc.CreateUploadJournal(dir, journal)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to write upload journal; --resume will not be available: %v\n", err)
+		return nil
+	}
+	return journal
+}
+
+// FinishBuildUploadJournal removes the journal once the upload is committed.
+func FinishBuildUploadJournal(journal *asc.UploadJournal) {
+	if err := journal.Remove(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to remove upload journal: %v\n", err)
+	}
+}
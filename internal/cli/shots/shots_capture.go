@@ -21,7 +21,7 @@ func ShotsCaptureCommand() *ffcli.Command {
 	udid := fs.String("udid", "booted", "Simulator UDID (default: booted)")
 	name := fs.String("name", "", "Screenshot name for output file (required)")
 	outputDir := fs.String("output-dir", "./screenshots/raw", "Output directory for captured PNG")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -17,7 +17,7 @@ type frameDevicesOutput struct {
 // ShotsFramesListDevicesCommand returns the shots frames list-devices subcommand.
 func ShotsFramesListDevicesCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list-devices", flag.ExitOnError)
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -23,7 +23,7 @@ func ShotsReviewApproveCommand() *ffcli.Command {
 	screenshotID := fs.String("id", "", "Screenshot ID to approve")
 	locale := fs.String("locale", "", "Locale selector/filter for matching review entries")
 	device := fs.String("device", "", "Device selector/filter for matching review entries")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
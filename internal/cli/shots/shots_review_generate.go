@@ -25,7 +25,7 @@ func ShotsReviewGenerateCommand() *ffcli.Command {
 	framedDir := fs.String("framed-dir", defaultShotsReviewFramedDir, "Directory containing framed screenshots (required)")
 	outputDir := fs.String("output-dir", defaultShotsReviewOutputDir, "Directory to write HTML and JSON review artifacts")
 	approvalPath := fs.String("approval-path", "", "Optional approvals file path (default: <output-dir>/approved.json)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -18,7 +18,7 @@ func ShotsReviewOpenCommand() *ffcli.Command {
 	outputDir := fs.String("output-dir", defaultShotsReviewOutputDir, "Directory containing review artifacts")
 	htmlPath := fs.String("html-path", "", "Optional HTML path (default: <output-dir>/index.html)")
 	dryRun := fs.Bool("dry-run", false, "Resolve path and print output without opening browser")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
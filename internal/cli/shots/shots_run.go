@@ -20,7 +20,7 @@ func ShotsRunCommand() *ffcli.Command {
 	bundleID := fs.String("bundle-id", "", "Override app bundle ID from plan")
 	udid := fs.String("udid", "", "Override simulator UDID from plan")
 	outputDir := fs.String("output-dir", "", "Override output directory from plan")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 
 	return &ffcli.Command{
@@ -0,0 +1,358 @@
+package signing
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Signing audit checks.
+const (
+	auditCertificateExpired   = "certificate-expired"
+	auditCertificateExpiring  = "certificate-expiring"
+	auditProfileInvalid       = "profile-invalid"
+	auditProfileExpired       = "profile-expired"
+	auditProfileExpiring      = "profile-expiring"
+	auditProfileUnreadable    = "profile-unreadable"
+	auditProfileRevokedCert   = "profile-revoked-certificate"
+	auditProfileMissingDevice = "profile-missing-devices"
+	auditBundleIDNoProfile    = "bundle-id-no-profile"
+)
+
+// SigningAuditCommand returns the signing audit subcommand.
+func SigningAuditCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("audit", flag.ExitOnError)
+
+	days := fs.Int("days", 30, "Warn about certificates and profiles expiring within this many days")
+	failOn := fs.String("fail-on", "error", "Exit non-zero on findings of this severity or worse: error, warning, none")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "audit",
+		ShortUsage: "asc signing audit [flags]",
+		ShortHelp:  "Report signing risks across certificates, profiles, bundle IDs, and devices.",
+		LongHelp: `Report signing risks across certificates, profiles, bundle IDs, and devices.
+
+Pages through every certificate, profile, bundle ID, and enabled device and
+reports:
+  error    certificate-expired          the certificate has expired
+  warning  certificate-expiring         the certificate expires within --days
+  error    profile-invalid              the profile is not ACTIVE
+  error    profile-expired              the profile has expired
+  warning  profile-expiring             the profile expires within --days
+  error    profile-revoked-certificate  the profile embeds a revoked or deleted certificate
+  warning  profile-missing-devices      a development or ad hoc profile lacks enabled devices
+  warning  profile-unreadable           the profile content could not be decoded
+  warning  bundle-id-no-profile         the bundle ID has no active profile
+
+The command exits non-zero when any finding is at or above --fail-on, so it
+can run on a schedule.
+
+Examples:
+  asc signing audit
+  asc signing audit --days 60 --output table
+  asc signing audit --fail-on warning`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if *days < 0 {
+				return shared.UsageError("--days must not be negative")
+			}
+			threshold := strings.ToLower(strings.TrimSpace(*failOn))
+			switch threshold {
+			case asc.SigningAuditSeverityError, asc.SigningAuditSeverityWarning, "none":
+			default:
+				return shared.UsageErrorf("--fail-on must be one of: error, warning, none")
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("signing audit: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			inventory, err := loadSigningInventory(requestCtx, client)
+			if err != nil {
+				return fmt.Errorf("signing audit: %w", err)
+			}
+			result := auditSigning(inventory, *days, time.Now())
+
+			if err := shared.PrintOutput(result, *output, *pretty); err != nil {
+				return err
+			}
+			failures := 0
+			switch threshold {
+			case asc.SigningAuditSeverityError:
+				failures = result.Summary.Errors
+			case asc.SigningAuditSeverityWarning:
+				failures = result.Summary.Errors + result.Summary.Warnings
+			}
+			if failures > 0 {
+				return shared.NewReportedError(fmt.Errorf("signing audit: found %d error(s) and %d warning(s)", result.Summary.Errors, result.Summary.Warnings))
+			}
+			return nil
+		},
+	}
+}
+
+// signingInventory is every signing resource of the team.
+type signingInventory struct {
+	certificates []asc.Resource[asc.CertificateAttributes]
+	profiles     []asc.Resource[asc.ProfileAttributes]
+	bundleIDs    []asc.Resource[asc.BundleIDAttributes]
+	devices      []asc.Resource[asc.DeviceAttributes]
+}
+
+func loadSigningInventory(ctx context.Context, client *asc.Client) (*signingInventory, error) {
+	inventory := &signingInventory{}
+	next := ""
+	for {
+		resp, err := client.GetCertificates(ctx, asc.WithCertificatesLimit(200), asc.WithCertificatesNextURL(next))
+		if err != nil {
+			return nil, fmt.Errorf("list certificates: %w", err)
+		}
+		inventory.certificates = append(inventory.certificates, resp.Data...)
+		if next = strings.TrimSpace(resp.Links.Next); next == "" {
+			break
+		}
+	}
+	for {
+		resp, err := client.GetProfiles(ctx, asc.WithProfilesLimit(200), asc.WithProfilesNextURL(next))
+		if err != nil {
+			return nil, fmt.Errorf("list profiles: %w", err)
+		}
+		inventory.profiles = append(inventory.profiles, resp.Data...)
+		if next = strings.TrimSpace(resp.Links.Next); next == "" {
+			break
+		}
+	}
+	for {
+		resp, err := client.GetBundleIDs(ctx, asc.WithBundleIDsLimit(200), asc.WithBundleIDsNextURL(next))
+		if err != nil {
+			return nil, fmt.Errorf("list bundle IDs: %w", err)
+		}
+		inventory.bundleIDs = append(inventory.bundleIDs, resp.Data...)
+		if next = strings.TrimSpace(resp.Links.Next); next == "" {
+			break
+		}
+	}
+	for {
+		resp, err := client.GetDevices(ctx,
+			asc.WithDevicesStatus(string(asc.DeviceStatusEnabled)),
+			asc.WithDevicesLimit(200),
+			asc.WithDevicesNextURL(next),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("list devices: %w", err)
+		}
+		inventory.devices = append(inventory.devices, resp.Data...)
+		if next = strings.TrimSpace(resp.Links.Next); next == "" {
+			break
+		}
+	}
+	return inventory, nil
+}
+
+// auditSigning turns an inventory into findings. Profiles are judged by their
+// decoded content, which carries the certificates and devices they were
+// generated with.
+func auditSigning(inventory *signingInventory, days int, now time.Time) *asc.SigningAuditResult {
+	result := &asc.SigningAuditResult{
+		ExpiringWithinDays: days,
+		Summary: asc.SigningAuditSummary{
+			Certificates: len(inventory.certificates),
+			Profiles:     len(inventory.profiles),
+			BundleIDs:    len(inventory.bundleIDs),
+			Devices:      len(inventory.devices),
+		},
+		Findings: []asc.SigningAuditFinding{},
+	}
+	add := func(check, severity, resourceType, id, name, message string) {
+		result.Findings = append(result.Findings, asc.SigningAuditFinding{
+			Check:        check,
+			Severity:     severity,
+			ResourceType: resourceType,
+			ResourceID:   id,
+			Name:         name,
+			Message:      message,
+		})
+	}
+	expiring := now.AddDate(0, 0, days)
+	checkExpiry := func(resourceType, id, name string, expiry time.Time, expiredCheck, expiringCheck string) {
+		switch {
+		case expiry.IsZero():
+		case !now.Before(expiry):
+			add(expiredCheck, asc.SigningAuditSeverityError, resourceType, id, name, "expired on "+expiry.UTC().Format(time.DateOnly))
+		case expiry.Before(expiring):
+			add(expiringCheck, asc.SigningAuditSeverityWarning, resourceType, id, name,
+				fmt.Sprintf("expires on %s (in %d day(s))", expiry.UTC().Format(time.DateOnly), int(expiry.Sub(now).Hours()/24)))
+		}
+	}
+
+	liveCertificates := make(map[string]struct{}, len(inventory.certificates))
+	for _, cert := range inventory.certificates {
+		name := cert.Attributes.DisplayName
+		if name == "" {
+			name = cert.Attributes.Name
+		}
+		expiry := parseAuditTime(cert.Attributes.ExpirationDate)
+		if der, err := decodeBase64Content("certificate", cert.Attributes.CertificateContent); err == nil {
+			liveCertificates[string(der)] = struct{}{}
+			if parsed, err := x509.ParseCertificate(der); err == nil {
+				expiry = parsed.NotAfter
+			}
+		}
+		checkExpiry("certificates", cert.ID, name, expiry, auditCertificateExpired, auditCertificateExpiring)
+	}
+
+	covered := map[string]bool{}
+	for _, item := range inventory.profiles {
+		attrs := item.Attributes
+		if attrs.ProfileState != asc.ProfileStateActive {
+			add(auditProfileInvalid, asc.SigningAuditSeverityError, "profiles", item.ID, attrs.Name,
+				fmt.Sprintf("profile is %s; regenerate or delete it", fallbackState(string(attrs.ProfileState))))
+			continue
+		}
+		content, err := decodeBase64Content("profile", attrs.ProfileContent)
+		if err != nil {
+			add(auditProfileUnreadable, asc.SigningAuditSeverityWarning, "profiles", item.ID, attrs.Name, err.Error())
+			continue
+		}
+		profile, err := shared.ParseProvisioningProfile(content)
+		if err != nil {
+			add(auditProfileUnreadable, asc.SigningAuditSeverityWarning, "profiles", item.ID, attrs.Name, err.Error())
+			continue
+		}
+		checkExpiry("profiles", item.ID, attrs.Name, profile.ExpirationDate, auditProfileExpired, auditProfileExpiring)
+		if !profile.Expired(now) {
+			covered[profile.BundleID()] = true
+		}
+
+		var revoked []string
+		for _, der := range profile.DeveloperCertificates {
+			if _, ok := liveCertificates[string(der)]; !ok {
+				revoked = append(revoked, describeAuditCertificate(der))
+			}
+		}
+		if len(revoked) > 0 {
+			add(auditProfileRevokedCert, asc.SigningAuditSeverityError, "profiles", item.ID, attrs.Name,
+				fmt.Sprintf("%d of %d certificate(s) are revoked or deleted: %s", len(revoked), len(profile.DeveloperCertificates), summarizeAuditList(revoked)))
+		}
+
+		if accepts := profileDeviceFilter(attrs.ProfileType); accepts != nil && !profile.ProvisionsAllDevices {
+			provisioned := make(map[string]bool, len(profile.ProvisionedDevices))
+			for _, udid := range profile.ProvisionedDevices {
+				provisioned[strings.ToUpper(udid)] = true
+			}
+			var missing []string
+			for _, device := range inventory.devices {
+				if accepts(device.Attributes) && !provisioned[strings.ToUpper(device.Attributes.UDID)] {
+					missing = append(missing, fmt.Sprintf("%s (%s)", device.Attributes.Name, device.Attributes.UDID))
+				}
+			}
+			if len(missing) > 0 {
+				sort.Strings(missing)
+				add(auditProfileMissingDevice, asc.SigningAuditSeverityWarning, "profiles", item.ID, attrs.Name,
+					fmt.Sprintf("missing %d enabled device(s): %s", len(missing), summarizeAuditList(missing)))
+			}
+		}
+	}
+
+	for _, bundle := range inventory.bundleIDs {
+		if !covered[bundle.Attributes.Identifier] {
+			add(auditBundleIDNoProfile, asc.SigningAuditSeverityWarning, "bundleIds", bundle.ID, bundle.Attributes.Identifier,
+				"no active provisioning profile")
+		}
+	}
+
+	sort.SliceStable(result.Findings, func(i, j int) bool {
+		a, b := result.Findings[i], result.Findings[j]
+		if a.Severity != b.Severity {
+			return a.Severity == asc.SigningAuditSeverityError
+		}
+		if a.Check != b.Check {
+			return a.Check < b.Check
+		}
+		return a.Name < b.Name
+	})
+	for _, finding := range result.Findings {
+		if finding.Severity == asc.SigningAuditSeverityError {
+			result.Summary.Errors++
+		} else {
+			result.Summary.Warnings++
+		}
+	}
+	return result
+}
+
+// profileDeviceFilter returns which enabled devices a development or ad hoc
+// profile type should include, or nil for types without devices.
+func profileDeviceFilter(profileType string) func(asc.DeviceAttributes) bool {
+	switch strings.ToUpper(profileType) {
+	case "IOS_APP_DEVELOPMENT", "IOS_APP_ADHOC":
+		return func(device asc.DeviceAttributes) bool {
+			return device.Platform == asc.DevicePlatformIOS && device.DeviceClass != asc.DeviceClassAppleTV
+		}
+	case "TVOS_APP_DEVELOPMENT", "TVOS_APP_ADHOC":
+		return func(device asc.DeviceAttributes) bool {
+			return device.DeviceClass == asc.DeviceClassAppleTV
+		}
+	case "MAC_APP_DEVELOPMENT", "MAC_CATALYST_APP_DEVELOPMENT":
+		return func(device asc.DeviceAttributes) bool {
+			return device.Platform == asc.DevicePlatformMacOS
+		}
+	default:
+		return nil
+	}
+}
+
+func describeAuditCertificate(der []byte) string {
+	sum := sha1.Sum(der)
+	fingerprint := strings.ToUpper(hex.EncodeToString(sum[:]))
+	if cert, err := x509.ParseCertificate(der); err == nil && cert.Subject.CommonName != "" {
+		return fmt.Sprintf("%s (SHA-1 %s)", cert.Subject.CommonName, fingerprint)
+	}
+	return "SHA-1 " + fingerprint
+}
+
+// summarizeAuditList joins the first few values and counts the rest.
+func summarizeAuditList(values []string) string {
+	const shown = 5
+	if len(values) <= shown {
+		return strings.Join(values, ", ")
+	}
+	return fmt.Sprintf("%s, and %d more", strings.Join(values[:shown], ", "), len(values)-shown)
+}
+
+// parseAuditTime parses App Store Connect timestamps such as
+// 2026-01-02T03:04:05.000+0000.
+func parseAuditTime(value string) time.Time {
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05.000-0700"} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed
+		}
+	}
+	return time.Time{}
+}
+
+func fallbackState(state string) string {
+	if state == "" {
+		return "in an unknown state"
+	}
+	return state
+}
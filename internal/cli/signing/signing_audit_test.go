@@ -0,0 +1,130 @@
+package signing
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"howett.net/plist"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func auditTestCertificate(t *testing.T, name string, notAfter time.Time) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    notAfter.AddDate(-1, 0, 0),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	return der
+}
+
+func auditTestProfile(t *testing.T, id, profileType, bundle string, expires time.Time, certs [][]byte, devices []string) asc.Resource[asc.ProfileAttributes] {
+	t.Helper()
+	data, err := plist.Marshal(map[string]any{
+		"Name":                  id,
+		"UUID":                  id,
+		"TeamIdentifier":        []string{"TEAM123456"},
+		"ExpirationDate":        expires,
+		"DeveloperCertificates": certs,
+		"ProvisionedDevices":    devices,
+		"Entitlements":          map[string]any{"application-identifier": "TEAM123456." + bundle},
+	}, plist.XMLFormat)
+	if err != nil {
+		t.Fatalf("marshal profile: %v", err)
+	}
+	return asc.Resource[asc.ProfileAttributes]{
+		ID: id,
+		Attributes: asc.ProfileAttributes{
+			Name:           id,
+			ProfileType:    profileType,
+			ProfileState:   asc.ProfileStateActive,
+			ProfileContent: base64.StdEncoding.EncodeToString(data),
+		},
+	}
+}
+
+func TestAuditSigningReportsRisks(t *testing.T) {
+	now := time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+	healthy := auditTestCertificate(t, "Apple Distribution: Example", now.AddDate(1, 0, 0))
+	expiring := auditTestCertificate(t, "Apple Development: Example", now.AddDate(0, 0, 10))
+	revoked := auditTestCertificate(t, "Apple Distribution: Revoked", now.AddDate(1, 0, 0))
+
+	invalid := auditTestProfile(t, "invalid", "IOS_APP_STORE", "com.example.old", now.AddDate(1, 0, 0), [][]byte{healthy}, nil)
+	invalid.Attributes.ProfileState = "INVALID"
+	inventory := &signingInventory{
+		certificates: []asc.Resource[asc.CertificateAttributes]{
+			{ID: "CERT1", Attributes: asc.CertificateAttributes{Name: "Distribution", CertificateContent: base64.StdEncoding.EncodeToString(healthy)}},
+			{ID: "CERT2", Attributes: asc.CertificateAttributes{Name: "Development", CertificateContent: base64.StdEncoding.EncodeToString(expiring)}},
+			{ID: "CERT3", Attributes: asc.CertificateAttributes{Name: "Old", ExpirationDate: "2030-05-01T00:00:00.000+0000"}},
+		},
+		profiles: []asc.Resource[asc.ProfileAttributes]{
+			auditTestProfile(t, "store", "IOS_APP_STORE", "com.example.app", now.AddDate(1, 0, 0), [][]byte{healthy}, nil),
+			auditTestProfile(t, "stale", "IOS_APP_STORE", "com.example.widget", now.AddDate(1, 0, 0), [][]byte{healthy, revoked}, nil),
+			auditTestProfile(t, "dev", "IOS_APP_DEVELOPMENT", "com.example.app", now.AddDate(0, 0, 5), [][]byte{expiring}, []string{"udid-1"}),
+			invalid,
+		},
+		bundleIDs: []asc.Resource[asc.BundleIDAttributes]{
+			{ID: "B1", Attributes: asc.BundleIDAttributes{Identifier: "com.example.app"}},
+			{ID: "B2", Attributes: asc.BundleIDAttributes{Identifier: "com.example.widget"}},
+			{ID: "B3", Attributes: asc.BundleIDAttributes{Identifier: "com.example.old"}},
+		},
+		devices: []asc.Resource[asc.DeviceAttributes]{
+			{ID: "D1", Attributes: asc.DeviceAttributes{Name: "iPhone", UDID: "UDID-1", Platform: asc.DevicePlatformIOS, DeviceClass: asc.DeviceClassIPhone}},
+			{ID: "D2", Attributes: asc.DeviceAttributes{Name: "iPad", UDID: "UDID-2", Platform: asc.DevicePlatformIOS, DeviceClass: asc.DeviceClassIPad}},
+			{ID: "D3", Attributes: asc.DeviceAttributes{Name: "Apple TV", UDID: "UDID-3", Platform: asc.DevicePlatformIOS, DeviceClass: asc.DeviceClassAppleTV}},
+			{ID: "D4", Attributes: asc.DeviceAttributes{Name: "Mac", UDID: "UDID-4", Platform: asc.DevicePlatformMacOS, DeviceClass: asc.DeviceClassMac}},
+		},
+	}
+
+	result := auditSigning(inventory, 30, now)
+
+	var got []string
+	for _, finding := range result.Findings {
+		got = append(got, finding.Severity+" "+finding.Check+" "+finding.ResourceID)
+	}
+	want := []string{
+		"error certificate-expired CERT3",
+		"error profile-invalid invalid",
+		"error profile-revoked-certificate stale",
+		"warning bundle-id-no-profile B3",
+		"warning certificate-expiring CERT2",
+		"warning profile-expiring dev",
+		"warning profile-missing-devices dev",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected findings:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if result.Summary.Errors != 3 || result.Summary.Warnings != 4 || result.Summary.Profiles != 4 || result.Summary.Devices != 4 {
+		t.Fatalf("unexpected summary: %+v", result.Summary)
+	}
+	for _, finding := range result.Findings {
+		switch finding.Check {
+		case auditProfileMissingDevice:
+			if finding.Message != "missing 1 enabled device(s): iPad (UDID-2)" {
+				t.Fatalf("unexpected missing devices message: %q", finding.Message)
+			}
+		case auditProfileRevokedCert:
+			if !strings.Contains(finding.Message, "1 of 2 certificate(s)") || !strings.Contains(finding.Message, "Apple Distribution: Revoked") {
+				t.Fatalf("unexpected revoked certificate message: %q", finding.Message)
+			}
+		}
+	}
+}
@@ -30,6 +30,7 @@ func SigningFetchCommand() *ffcli.Command {
 	createMissing := fs.Bool("create-missing", false, "Create missing profiles")
 	format := fs.String("format", "json", "Output format for metadata: json (default), table, markdown")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
 
 	return &ffcli.Command{
 		Name:       "fetch",
@@ -121,30 +122,38 @@ Examples:
 			result.ProfileID = profile.Data.ID
 			result.Created = created
 
-			if err := os.MkdirAll(outputDir, 0o755); err != nil {
-				return fmt.Errorf("signing fetch: create output dir: %w", err)
+			// Simulated runs record the files instead of writing them; a
+			// simulated profile has no content to decode anyway.
+			if !asc.SimulationActive() {
+				if err := os.MkdirAll(outputDir, 0o755); err != nil {
+					return fmt.Errorf("signing fetch: create output dir: %w", err)
+				}
 			}
 
 			profileName := safeFileName(profile.Data.Attributes.Name, profile.Data.ID)
 			profilePath := filepath.Join(outputDir, profileName+".mobileprovision")
-			profileContent, err := decodeBase64Content("profile", profile.Data.Attributes.ProfileContent)
-			if err != nil {
-				return fmt.Errorf("signing fetch: decode profile: %w", err)
-			}
-			if err := shared.WriteProfileFile(profilePath, profileContent); err != nil {
-				return fmt.Errorf("signing fetch: write profile: %w", err)
+			if !asc.SimulateLocalChange("WRITE", profilePath) {
+				profileContent, err := decodeBase64Content("profile", profile.Data.Attributes.ProfileContent)
+				if err != nil {
+					return fmt.Errorf("signing fetch: decode profile: %w", err)
+				}
+				if err := shared.WriteProfileFile(profilePath, profileContent); err != nil {
+					return fmt.Errorf("signing fetch: write profile: %w", err)
+				}
 			}
 			result.ProfileFile = profilePath
 
 			for _, cert := range certs.Data {
 				certName := safeFileName(cert.Attributes.SerialNumber, cert.ID)
 				certPath := filepath.Join(outputDir, certName+".cer")
-				certContent, err := decodeBase64Content("certificate", cert.Attributes.CertificateContent)
-				if err != nil {
-					return fmt.Errorf("signing fetch: decode certificate: %w", err)
-				}
-				if err := writeBinaryFile(certPath, certContent); err != nil {
-					return fmt.Errorf("signing fetch: write certificate: %w", err)
+				if !asc.SimulateLocalChange("WRITE", certPath) {
+					certContent, err := decodeBase64Content("certificate", cert.Attributes.CertificateContent)
+					if err != nil {
+						return fmt.Errorf("signing fetch: decode certificate: %w", err)
+					}
+					if err := writeBinaryFile(certPath, certContent); err != nil {
+						return fmt.Errorf("signing fetch: write certificate: %w", err)
+					}
 				}
 				result.CertificateFiles = append(result.CertificateFiles, certPath)
 			}
@@ -0,0 +1,345 @@
+package signing
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	signingStoreManifest   = "asc-signing.json"
+	signingStoreVersion    = 1
+	signingStoreKDF        = "pbkdf2-sha256"
+	signingStoreIterations = 600000
+	signingStoreCheck      = "asc signing store"
+	signingStoreSuffix     = ".enc"
+)
+
+// signingStoreMagic prefixes every encrypted file: the magic, a 12-byte
+// nonce, then the AES-256-GCM ciphertext. The file's path inside the store is
+// the additional data, so files cannot be swapped.
+var signingStoreMagic = []byte("ASCSIGN1")
+
+// signingStoreManifestFile is the plain-text manifest at the root of the
+// store. Check is the encrypted signingStoreCheck, used to reject a wrong
+// passphrase before anything is read or written.
+type signingStoreManifestFile struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Check      []byte `json:"check"`
+}
+
+// signingStore is a directory of AES-GCM encrypted signing assets inside a
+// git working directory.
+type signingStore struct {
+	dir      string
+	aead     cipher.AEAD
+	readonly bool
+	changed  []string
+}
+
+// openSigningStore opens the store at dir. Unless readonly, a missing
+// directory is created with git init and a missing manifest is written.
+func openSigningStore(ctx context.Context, dir, passphrase string, readonly bool) (*signingStore, error) {
+	info, err := os.Stat(dir)
+	switch {
+	case err == nil && !info.IsDir():
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	case errors.Is(err, os.ErrNotExist):
+		if readonly {
+			return nil, fmt.Errorf("signing store %s does not exist", dir)
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+		if _, err := runGit(ctx, dir, "init", "--quiet"); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case !readonly:
+		if _, err := runGit(ctx, dir, "rev-parse", "--is-inside-work-tree"); err != nil {
+			return nil, fmt.Errorf("%s is not a git working directory (run git init or use a clone)", dir)
+		}
+	}
+
+	store := &signingStore{dir: dir, readonly: readonly}
+	manifestPath := filepath.Join(dir, signingStoreManifest)
+	data, err := os.ReadFile(manifestPath)
+	if errors.Is(err, os.ErrNotExist) {
+		if readonly {
+			return nil, fmt.Errorf("%s has no %s; run asc signing sync without --readonly first", dir, signingStoreManifest)
+		}
+		return store, store.initManifest(passphrase)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest signingStoreManifestFile
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", signingStoreManifest, err)
+	}
+	if manifest.Version != signingStoreVersion || manifest.KDF != signingStoreKDF || manifest.Iterations <= 0 {
+		return nil, fmt.Errorf("unsupported %s (version %d, kdf %q)", signingStoreManifest, manifest.Version, manifest.KDF)
+	}
+	if store.aead, err = signingStoreAEAD(passphrase, manifest.Salt, manifest.Iterations); err != nil {
+		return nil, err
+	}
+	check, err := store.decrypt(signingStoreManifest, manifest.Check)
+	if err != nil || string(check) != signingStoreCheck {
+		return nil, errors.New("wrong passphrase for the signing store")
+	}
+	return store, nil
+}
+
+func (s *signingStore) initManifest(passphrase string) error {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	aead, err := signingStoreAEAD(passphrase, salt, signingStoreIterations)
+	if err != nil {
+		return err
+	}
+	s.aead = aead
+	check, err := s.encrypt(signingStoreManifest, []byte(signingStoreCheck))
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(signingStoreManifestFile{
+		Version:    signingStoreVersion,
+		KDF:        signingStoreKDF,
+		Iterations: signingStoreIterations,
+		Salt:       salt,
+		Check:      check,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(filepath.Join(s.dir, signingStoreManifest), append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+	s.changed = append(s.changed, signingStoreManifest)
+	return nil
+}
+
+func signingStoreAEAD(passphrase string, salt []byte, iterations int) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, iterations, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (s *signingStore) encrypt(name string, plain []byte) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	out := append(append([]byte{}, signingStoreMagic...), nonce...)
+	return s.aead.Seal(out, nonce, plain, []byte(name)), nil
+}
+
+func (s *signingStore) decrypt(name string, data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, signingStoreMagic) || len(data) < len(signingStoreMagic)+s.aead.NonceSize() {
+		return nil, fmt.Errorf("%s is not an encrypted signing file", name)
+	}
+	data = data[len(signingStoreMagic):]
+	nonce, ciphertext := data[:s.aead.NonceSize()], data[s.aead.NonceSize():]
+	plain, err := s.aead.Open(nil, nonce, ciphertext, []byte(name))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt %s: %w", name, err)
+	}
+	return plain, nil
+}
+
+// Read returns the decrypted contents of name (a slash-separated path
+// without the .enc suffix), or ok=false when it is not in the store.
+func (s *signingStore) Read(name string) ([]byte, bool, error) {
+	data, err := os.ReadFile(s.path(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	plain, err := s.decrypt(name, data)
+	if err != nil {
+		return nil, false, err
+	}
+	return plain, true, nil
+}
+
+// Write encrypts data into name. Unchanged contents are not rewritten, so
+// the git history only records real changes.
+func (s *signingStore) Write(name string, data []byte) error {
+	if s.readonly {
+		return fmt.Errorf("cannot write %s: the store is read-only", name)
+	}
+	if existing, ok, err := s.Read(name); err == nil && ok && bytes.Equal(existing, data) {
+		return nil
+	}
+	encrypted, err := s.encrypt(name, data)
+	if err != nil {
+		return err
+	}
+	target := s.path(name)
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	if err := writeFileAtomic(target, encrypted, 0o644); err != nil {
+		return err
+	}
+	s.changed = append(s.changed, name)
+	return nil
+}
+
+// Remove deletes name from the store.
+func (s *signingStore) Remove(name string) error {
+	if s.readonly {
+		return fmt.Errorf("cannot remove %s: the store is read-only", name)
+	}
+	if err := os.Remove(s.path(name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	s.changed = append(s.changed, name)
+	return nil
+}
+
+// List returns the names of the files directly inside dir.
+func (s *signingStore) List(dir string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(s.dir, filepath.FromSlash(dir)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if name, ok := strings.CutSuffix(entry.Name(), signingStoreSuffix); ok && entry.Type().IsRegular() {
+			names = append(names, path.Join(dir, name))
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (s *signingStore) path(name string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(name)+signingStoreSuffix)
+}
+
+// Commit records the changes of this run in git. Only the files this run
+// wrote or removed and the manifest are staged, so anything else in the
+// working directory (such as decrypted exports) is never committed. It
+// reports false when nothing changed.
+func (s *signingStore) Commit(ctx context.Context, message string) (bool, error) {
+	if s.readonly || len(s.changed) == 0 {
+		return false, nil
+	}
+	var present, removed []string
+	for _, name := range s.changedFiles() {
+		if _, err := os.Lstat(filepath.Join(s.dir, filepath.FromSlash(name))); err == nil {
+			present = append(present, name)
+		} else {
+			removed = append(removed, name)
+		}
+	}
+	if len(present) > 0 {
+		if _, err := runGit(ctx, s.dir, append([]string{"add", "--"}, present...)...); err != nil {
+			return false, err
+		}
+	}
+	if len(removed) > 0 {
+		if _, err := runGit(ctx, s.dir, append([]string{"rm", "--cached", "--quiet", "--ignore-unmatch", "--"}, removed...)...); err != nil {
+			return false, err
+		}
+	}
+	staged, err := runGit(ctx, s.dir, append([]string{"diff", "--cached", "--name-only", "--"}, s.changedFiles()...)...)
+	if err != nil {
+		return false, err
+	}
+	paths := strings.Fields(staged)
+	if len(paths) == 0 {
+		return false, nil
+	}
+	if _, err := runGit(ctx, s.dir, append([]string{"commit", "--quiet", "-m", message, "--"}, paths...)...); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// changedFiles returns the store-relative paths of the files changed by this
+// run, plus the manifest.
+func (s *signingStore) changedFiles() []string {
+	files := []string{signingStoreManifest}
+	for _, name := range s.changed {
+		if name != signingStoreManifest {
+			files = append(files, name+signingStoreSuffix)
+		}
+	}
+	sort.Strings(files)
+	return slices.Compact(files)
+}
+
+// runGit runs git in dir and returns its output.
+var runGit = func(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], message)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return string(out), nil
+}
+
+// writeFileAtomic replaces path with data via a temporary file, so readers
+// never see a partial file and symlinks at path are replaced, not followed.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,146 @@
+package signing
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func setupGit(t *testing.T) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	t.Setenv("GIT_AUTHOR_NAME", "asc")
+	t.Setenv("GIT_AUTHOR_EMAIL", "asc@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "asc")
+	t.Setenv("GIT_COMMITTER_EMAIL", "asc@example.com")
+}
+
+func TestSigningStoreRoundTrip(t *testing.T) {
+	setupGit(t)
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "store")
+
+	store, err := openSigningStore(ctx, dir, "secret", false)
+	if err != nil {
+		t.Fatalf("openSigningStore() error: %v", err)
+	}
+	if err := store.Write("certs/IOS_DISTRIBUTION/CERT1.key", []byte("private key")); err != nil {
+		t.Fatalf("Write() error: %v", err)
+	}
+	committed, err := store.Commit(ctx, "add key")
+	if err != nil || !committed {
+		t.Fatalf("Commit() = %t, %v", committed, err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(dir, "certs", "IOS_DISTRIBUTION", "CERT1.key.enc"))
+	if err != nil {
+		t.Fatalf("expected encrypted file: %v", err)
+	}
+	if bytes.Contains(raw, []byte("private key")) {
+		t.Fatal("store file is not encrypted")
+	}
+
+	reopened, err := openSigningStore(ctx, dir, "secret", true)
+	if err != nil {
+		t.Fatalf("openSigningStore(readonly) error: %v", err)
+	}
+	data, ok, err := reopened.Read("certs/IOS_DISTRIBUTION/CERT1.key")
+	if err != nil || !ok || string(data) != "private key" {
+		t.Fatalf("Read() = %q, %t, %v", data, ok, err)
+	}
+	names, err := reopened.List("certs/IOS_DISTRIBUTION")
+	if err != nil || len(names) != 1 || names[0] != "certs/IOS_DISTRIBUTION/CERT1.key" {
+		t.Fatalf("List() = %q, %v", names, err)
+	}
+	if err := reopened.Write("certs/IOS_DISTRIBUTION/CERT2.key", nil); err == nil {
+		t.Fatal("expected read-only store to reject writes")
+	}
+
+	// Rewriting identical contents leaves nothing to commit.
+	if err := store.Write("certs/IOS_DISTRIBUTION/CERT1.key", []byte("private key")); err != nil {
+		t.Fatalf("Write() error: %v", err)
+	}
+	if committed, err := store.Commit(ctx, "no-op"); err != nil || committed {
+		t.Fatalf("expected no commit for unchanged contents, got %t, %v", committed, err)
+	}
+
+	if _, err := openSigningStore(ctx, dir, "wrong", true); err == nil || !strings.Contains(err.Error(), "wrong passphrase") {
+		t.Fatalf("expected wrong passphrase error, got %v", err)
+	}
+}
+
+func TestSigningStoreRejectsMovedFiles(t *testing.T) {
+	setupGit(t)
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "store")
+
+	store, err := openSigningStore(ctx, dir, "secret", false)
+	if err != nil {
+		t.Fatalf("openSigningStore() error: %v", err)
+	}
+	if err := store.Write("profiles/IOS_APP_STORE/com.example.app.mobileprovision", []byte("profile")); err != nil {
+		t.Fatalf("Write() error: %v", err)
+	}
+	from := filepath.Join(dir, "profiles", "IOS_APP_STORE", "com.example.app.mobileprovision.enc")
+	to := filepath.Join(dir, "profiles", "IOS_APP_STORE", "com.example.other.mobileprovision.enc")
+	if err := os.Rename(from, to); err != nil {
+		t.Fatalf("rename: %v", err)
+	}
+	if _, _, err := store.Read("profiles/IOS_APP_STORE/com.example.other.mobileprovision"); err == nil {
+		t.Fatal("expected a file moved to another path to fail authentication")
+	}
+}
+
+func TestOpenSigningStoreReadonlyRequiresStore(t *testing.T) {
+	if _, err := openSigningStore(context.Background(), filepath.Join(t.TempDir(), "missing"), "secret", true); err == nil {
+		t.Fatal("expected error for a missing read-only store")
+	}
+}
+
+func TestSigningStoreCommitStagesOnlyChangedFiles(t *testing.T) {
+	setupGit(t)
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "store")
+
+	store, err := openSigningStore(ctx, dir, "secret", false)
+	if err != nil {
+		t.Fatalf("openSigningStore() error: %v", err)
+	}
+	if err := store.Write("certs/IOS_DISTRIBUTION/CERT1.cer", []byte("certificate")); err != nil {
+		t.Fatalf("Write() error: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "CERT1.p12"), []byte("plaintext"), 0o600); err != nil {
+		t.Fatalf("write stray file: %v", err)
+	}
+	if committed, err := store.Commit(ctx, "add certificate"); err != nil || !committed {
+		t.Fatalf("Commit() = %t, %v", committed, err)
+	}
+
+	tracked, err := runGit(ctx, dir, "ls-files")
+	if err != nil {
+		t.Fatalf("git ls-files: %v", err)
+	}
+	if got, want := strings.Fields(tracked), []string{"asc-signing.json", "certs/IOS_DISTRIBUTION/CERT1.cer.enc"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("tracked files = %q, want %q", got, want)
+	}
+
+	reopened, err := openSigningStore(ctx, dir, "secret", false)
+	if err != nil {
+		t.Fatalf("openSigningStore() error: %v", err)
+	}
+	if err := reopened.Remove("certs/IOS_DISTRIBUTION/CERT1.cer"); err != nil {
+		t.Fatalf("Remove() error: %v", err)
+	}
+	if committed, err := reopened.Commit(ctx, "remove certificate"); err != nil || !committed {
+		t.Fatalf("Commit() = %t, %v", committed, err)
+	}
+	if tracked, _ := runGit(ctx, dir, "ls-files"); strings.TrimSpace(tracked) != "asc-signing.json" {
+		t.Fatalf("expected only the manifest to stay tracked, got %q", tracked)
+	}
+}
@@ -0,0 +1,538 @@
+package signing
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const signingPassphraseEnvVar = "ASC_SIGNING_PASSPHRASE"
+
+// SigningSyncCommand returns the signing sync subcommand.
+func SigningSyncCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("sync", flag.ExitOnError)
+
+	repo := fs.String("repo", "", "Git working directory of the encrypted signing store (required)")
+	bundleIDs := fs.String("bundle-id", "", "Bundle identifier(s), comma-separated (required)")
+	profileType := fs.String("profile-type", "", "Profile type: IOS_APP_STORE, IOS_APP_DEVELOPMENT, MAC_APP_STORE, etc. (required)")
+	certType := fs.String("certificate-type", "", "Certificate type (default: inferred from --profile-type)")
+	deviceIDs := fs.String("device", "", "Device ID(s), comma-separated (required to create development profiles)")
+	passphrase := fs.String("passphrase", "", "Store passphrase (or "+signingPassphraseEnvVar+")")
+	readonly := fs.Bool("readonly", false, "Only read the store: never create, renew, or commit (for CI)")
+	outputDir := fs.String("output-dir", "./signing", "Directory for the decrypted profiles, certificate, and .p12")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "sync",
+		ShortUsage: "asc signing sync --repo PATH --bundle-id ID[,ID...] --profile-type TYPE [flags]",
+		ShortHelp:  "Sync certificates and profiles with an encrypted git store.",
+		LongHelp: `Sync certificates and profiles with an encrypted git store.
+
+The store is a local git working directory (clone it from wherever your team
+shares it). Certificates, private keys, and provisioning profiles are kept
+AES-256-GCM encrypted with a key derived from the passphrase; only the
+manifest (asc-signing.json) is plain text.
+
+Without --readonly, sync creates a certificate (with a locally generated key)
+when the store has none that is valid, creates a profile for each bundle ID
+when App Store Connect has no active one that includes the certificate,
+replaces expired or revoked ones, and commits the changes. A missing --repo
+directory is created with git init.
+
+With --readonly, sync only decrypts what the store holds and fails if it is
+missing or expired; no App Store Connect credentials are needed.
+
+Either way, the certificate (.cer), a .p12 bundle (password: the passphrase),
+and the profiles are written to --output-dir.
+
+With the root --simulate flag, sync needs --readonly (a certificate cannot be
+planned without creating it) and lists the exports instead of writing them.
+
+Examples:
+  ASC_SIGNING_PASSPHRASE="..." asc signing sync --repo ./certificates --bundle-id com.example.app --profile-type IOS_APP_STORE
+  asc signing sync --repo ./certificates --bundle-id "com.example.app,com.example.app.widget" --profile-type IOS_APP_DEVELOPMENT --device "DEVICE1,DEVICE2"
+  asc signing sync --repo ./certificates --bundle-id com.example.app --profile-type IOS_APP_STORE --readonly --output-dir ./signing`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			repoDir := strings.TrimSpace(*repo)
+			if repoDir == "" {
+				return shared.UsageError("--repo is required")
+			}
+			bundles := shared.SplitCSV(*bundleIDs)
+			if len(bundles) == 0 {
+				return shared.UsageError("--bundle-id is required")
+			}
+			profType := strings.ToUpper(strings.TrimSpace(*profileType))
+			if profType == "" {
+				return shared.UsageError("--profile-type is required")
+			}
+			certificateType := strings.ToUpper(strings.TrimSpace(*certType))
+			if certificateType == "" {
+				inferred, err := inferCertificateType(profType)
+				if err != nil {
+					return shared.UsageError(err.Error())
+				}
+				certificateType = inferred
+			}
+			secret := *passphrase
+			if secret == "" {
+				secret = os.Getenv(signingPassphraseEnvVar)
+			}
+			if secret == "" {
+				return shared.UsageErrorf("--passphrase (or %s) is required", signingPassphraseEnvVar)
+			}
+			exportDir := strings.TrimSpace(*outputDir)
+			if exportDir == "" {
+				return shared.UsageError("--output-dir must not be empty")
+			}
+
+			if asc.SimulationActive() && !*readonly {
+				return shared.UsageError("--simulate requires --readonly")
+			}
+
+			store, err := openSigningStore(ctx, repoDir, secret, *readonly)
+			if err != nil {
+				return fmt.Errorf("signing sync: %w", err)
+			}
+
+			syncer := &signingSyncer{
+				store:           store,
+				certificateType: certificateType,
+				profileType:     profType,
+				deviceIDs:       shared.SplitCSV(*deviceIDs),
+				now:             time.Now(),
+			}
+			if !*readonly {
+				client, err := shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("signing sync: %w", err)
+				}
+				syncer.client = client
+			}
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			identity, err := syncer.syncCertificate(requestCtx)
+			if err != nil {
+				return fmt.Errorf("signing sync: %w", err)
+			}
+			result := &asc.SigningSyncResult{
+				Repo:                      repoDir,
+				Readonly:                  *readonly,
+				CertificateID:             identity.id,
+				CertificateType:           certificateType,
+				CertificateExpirationDate: identity.cert.NotAfter.UTC().Format(time.RFC3339),
+				CertificateStatus:         identity.status,
+			}
+			profiles := make(map[string][]byte, len(bundles))
+			for _, bundle := range bundles {
+				profile, content, err := syncer.syncProfile(requestCtx, bundle, identity)
+				if err != nil {
+					return fmt.Errorf("signing sync: %s: %w", bundle, err)
+				}
+				result.Profiles = append(result.Profiles, profile)
+				profiles[bundle] = content
+			}
+
+			if err := syncer.removeStale(); err != nil {
+				return fmt.Errorf("signing sync: remove stale certificates: %w", err)
+			}
+			result.Committed, err = store.Commit(ctx, fmt.Sprintf("asc signing sync: %s %s", profType, strings.Join(bundles, ", ")))
+			if err != nil {
+				return fmt.Errorf("signing sync: commit: %w", err)
+			}
+			if err := exportSigningFiles(exportDir, secret, identity, profiles, result); err != nil {
+				return fmt.Errorf("signing sync: %w", err)
+			}
+
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// signingIdentity is a certificate with its private key.
+type signingIdentity struct {
+	id     string
+	der    []byte
+	cert   *x509.Certificate
+	key    crypto.Signer
+	status string
+}
+
+type signingSyncer struct {
+	store           *signingStore
+	client          *asc.Client
+	certificateType string
+	profileType     string
+	deviceIDs       []string
+	now             time.Time
+
+	liveProfiles []asc.Resource[asc.ProfileAttributes]
+	stale        []string
+}
+
+func (s *signingSyncer) certificateDir() string {
+	return path.Join("certs", s.certificateType)
+}
+
+// syncCertificate returns the stored certificate that is valid longest. Unless
+// the store is read-only, a new one is created when none is valid, and
+// certificates that expired or were revoked are noted for removeStale.
+func (s *signingSyncer) syncCertificate(ctx context.Context) (*signingIdentity, error) {
+	names, err := s.store.List(s.certificateDir())
+	if err != nil {
+		return nil, err
+	}
+	var live map[string]bool
+	if s.client != nil {
+		if live, err = s.liveCertificateIDs(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		best  *signingIdentity
+		stale []string
+		seen  int
+	)
+	for _, name := range names {
+		id, ok := strings.CutSuffix(path.Base(name), ".cer")
+		if !ok {
+			continue
+		}
+		seen++
+		identity, err := s.readIdentity(id)
+		if err != nil {
+			return nil, err
+		}
+		if !s.now.Before(identity.cert.NotAfter) || (live != nil && !live[id]) {
+			stale = append(stale, id)
+			continue
+		}
+		if best == nil || identity.cert.NotAfter.After(best.cert.NotAfter) {
+			best = identity
+		}
+	}
+
+	if s.client == nil {
+		if best == nil {
+			return nil, fmt.Errorf("the store has no valid %s certificate; run asc signing sync without --readonly", s.certificateType)
+		}
+		best.status = asc.SigningSyncStatusCurrent
+		return best, nil
+	}
+
+	s.stale = stale
+	if best != nil {
+		best.status = asc.SigningSyncStatusCurrent
+		return best, nil
+	}
+
+	identity, err := s.createIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identity.status = asc.SigningSyncStatusCreated
+	if seen > 0 {
+		identity.status = asc.SigningSyncStatusRenewed
+	}
+	return identity, nil
+}
+
+// removeStale removes the certificates and keys syncCertificate found expired
+// or revoked. It runs only once the sync has succeeded, right before the
+// commit, so a failed run never leaves the store without its old identity.
+func (s *signingSyncer) removeStale() error {
+	for _, id := range s.stale {
+		for _, suffix := range []string{".cer", ".key"} {
+			if err := s.store.Remove(path.Join(s.certificateDir(), id+suffix)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *signingSyncer) readIdentity(id string) (*signingIdentity, error) {
+	base := path.Join(s.certificateDir(), id)
+	der, _, err := s.store.Read(base + ".cer")
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("certificate %s in the store: %w", id, err)
+	}
+	keyPEM, ok, err := s.store.Read(base + ".key")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("certificate %s in the store has no private key", id)
+	}
+	key, err := shared.DecryptSigningKeyPEM(keyPEM, "")
+	if err != nil {
+		return nil, fmt.Errorf("certificate %s in the store: %w", id, err)
+	}
+	if err := shared.CheckSigningKeyMatchesCertificate(key, cert); err != nil {
+		return nil, fmt.Errorf("certificate %s in the store: %w", id, err)
+	}
+	return &signingIdentity{id: id, der: der, cert: cert, key: key}, nil
+}
+
+func (s *signingSyncer) liveCertificateIDs(ctx context.Context) (map[string]bool, error) {
+	live := map[string]bool{}
+	next := ""
+	for {
+		resp, err := s.client.GetCertificates(ctx,
+			asc.WithCertificatesFilterType(s.certificateType),
+			asc.WithCertificatesNextURL(next),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("list certificates: %w", err)
+		}
+		for _, cert := range resp.Data {
+			live[cert.ID] = true
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return live, nil
+		}
+		next = resp.Links.Next
+	}
+}
+
+// createIdentity creates a certificate for a new local key and stores both.
+func (s *signingSyncer) createIdentity(ctx context.Context) (*signingIdentity, error) {
+	key, err := shared.GenerateSigningKey(shared.SigningKeyTypeRSA)
+	if err != nil {
+		return nil, fmt.Errorf("generate key: %w", err)
+	}
+	csr, err := shared.CreateSigningCSR(key, "App Store Connect CLI")
+	if err != nil {
+		return nil, fmt.Errorf("create CSR: %w", err)
+	}
+	resp, err := s.client.CreateCertificate(ctx, base64.StdEncoding.EncodeToString(csr), s.certificateType)
+	if err != nil {
+		return nil, fmt.Errorf("create %s certificate: %w", s.certificateType, err)
+	}
+	id := resp.Data.ID
+	der, err := decodeBase64Content("certificate", resp.Data.Attributes.CertificateContent)
+	if err != nil {
+		return nil, fmt.Errorf("certificate %s: %w", id, err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("certificate %s: parse: %w", id, err)
+	}
+	if err := shared.CheckSigningKeyMatchesCertificate(key, cert); err != nil {
+		return nil, fmt.Errorf("certificate %s: %w", id, err)
+	}
+
+	plain, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("certificate %s: encode key: %w", id, err)
+	}
+	base := path.Join(s.certificateDir(), id)
+	if err := s.store.Write(base+".key", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: plain})); err != nil {
+		return nil, fmt.Errorf("certificate %s: store key: %w", id, err)
+	}
+	if err := s.store.Write(base+".cer", der); err != nil {
+		return nil, fmt.Errorf("certificate %s: store certificate: %w", id, err)
+	}
+	return &signingIdentity{id: id, der: der, cert: cert, key: key}, nil
+}
+
+// syncProfile returns the profile for bundle that includes the identity's
+// certificate. Unless the store is read-only, the stored profile is replaced
+// by an active one from App Store Connect, which is created if needed.
+func (s *signingSyncer) syncProfile(ctx context.Context, bundle string, identity *signingIdentity) (asc.SigningSyncProfile, []byte, error) {
+	name := path.Join("profiles", s.profileType, safeFileName(bundle, "bundle")+".mobileprovision")
+	result := asc.SigningSyncProfile{BundleID: bundle, ProfileType: s.profileType, Status: asc.SigningSyncStatusCurrent}
+
+	content, stored, err := s.store.Read(name)
+	if err != nil {
+		return result, nil, err
+	}
+	var storedUUID string
+	if stored {
+		profile, err := shared.ParseProvisioningProfile(content)
+		if err != nil {
+			return result, nil, fmt.Errorf("profile in the store: %w", err)
+		}
+		storedUUID = profile.UUID
+		if s.client == nil {
+			switch {
+			case profile.Expired(s.now):
+				return result, nil, fmt.Errorf("the stored profile expired on %s; run asc signing sync without --readonly", profile.ExpirationDate.Format(time.DateOnly))
+			case !profile.HasCertificate(identity.der):
+				return result, nil, fmt.Errorf("the stored profile does not include certificate %s; run asc signing sync without --readonly", identity.id)
+			}
+			fillSyncProfile(&result, "", profile)
+			return result, content, nil
+		}
+	} else if s.client == nil {
+		return result, nil, fmt.Errorf("the store has no %s profile; run asc signing sync without --readonly", s.profileType)
+	}
+
+	resource, profile, content, err := s.findLiveProfile(ctx, bundle, identity, storedUUID)
+	if err != nil {
+		return result, nil, err
+	}
+	if resource == nil {
+		if resource, profile, content, err = s.createProfile(ctx, bundle, identity); err != nil {
+			return result, nil, err
+		}
+		result.Status = asc.SigningSyncStatusCreated
+	}
+	if stored && profile.UUID != storedUUID {
+		result.Status = asc.SigningSyncStatusRenewed
+	}
+	if err := s.store.Write(name, content); err != nil {
+		return result, nil, err
+	}
+	fillSyncProfile(&result, resource.ID, profile)
+	return result, content, nil
+}
+
+// findLiveProfile returns an active, unexpired profile for bundle that
+// includes the certificate, preferring the stored one and then the one that
+// expires last.
+func (s *signingSyncer) findLiveProfile(ctx context.Context, bundle string, identity *signingIdentity, storedUUID string) (*asc.Resource[asc.ProfileAttributes], *shared.ProvisioningProfile, []byte, error) {
+	if s.liveProfiles == nil {
+		next := ""
+		for {
+			resp, err := s.client.GetProfiles(ctx,
+				asc.WithProfilesFilterType(s.profileType),
+				asc.WithProfilesNextURL(next),
+			)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("list profiles: %w", err)
+			}
+			s.liveProfiles = append(s.liveProfiles, resp.Data...)
+			if strings.TrimSpace(resp.Links.Next) == "" {
+				break
+			}
+			next = resp.Links.Next
+		}
+	}
+
+	var (
+		best        *asc.Resource[asc.ProfileAttributes]
+		bestProfile *shared.ProvisioningProfile
+		bestContent []byte
+	)
+	for i := range s.liveProfiles {
+		resource := &s.liveProfiles[i]
+		if resource.Attributes.ProfileState != asc.ProfileStateActive || strings.TrimSpace(resource.Attributes.ProfileContent) == "" {
+			continue
+		}
+		content, err := decodeBase64Content("profile", resource.Attributes.ProfileContent)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("profile %s: %w", resource.ID, err)
+		}
+		profile, err := shared.ParseProvisioningProfile(content)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("profile %s: %w", resource.ID, err)
+		}
+		if profile.BundleID() != bundle || profile.Expired(s.now) || !profile.HasCertificate(identity.der) {
+			continue
+		}
+		if profile.UUID == storedUUID {
+			return resource, profile, content, nil
+		}
+		if best == nil || profile.ExpirationDate.After(bestProfile.ExpirationDate) {
+			best, bestProfile, bestContent = resource, profile, content
+		}
+	}
+	return best, bestProfile, bestContent, nil
+}
+
+func (s *signingSyncer) createProfile(ctx context.Context, bundle string, identity *signingIdentity) (*asc.Resource[asc.ProfileAttributes], *shared.ProvisioningProfile, []byte, error) {
+	if isDevelopmentProfile(s.profileType) && len(s.deviceIDs) == 0 {
+		return nil, nil, nil, fmt.Errorf("--device is required to create a %s profile", s.profileType)
+	}
+	bundleID, err := findBundleID(ctx, s.client, bundle)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	created, err := s.client.CreateProfile(ctx, asc.ProfileCreateAttributes{
+		Name:        fmt.Sprintf("asc %s %s %s", bundle, s.profileType, s.now.Format("20060102150405")),
+		ProfileType: s.profileType,
+	}, bundleID.Data.ID, []string{identity.id}, s.deviceIDs)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("create profile: %w", err)
+	}
+	content, err := decodeBase64Content("profile", created.Data.Attributes.ProfileContent)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("profile %s: %w", created.Data.ID, err)
+	}
+	profile, err := shared.ParseProvisioningProfile(content)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("profile %s: %w", created.Data.ID, err)
+	}
+	return &created.Data, profile, content, nil
+}
+
+func fillSyncProfile(result *asc.SigningSyncProfile, id string, profile *shared.ProvisioningProfile) {
+	result.ProfileID = id
+	result.Name = profile.Name
+	result.UUID = profile.UUID
+	if !profile.ExpirationDate.IsZero() {
+		result.ExpirationDate = profile.ExpirationDate.UTC().Format(time.RFC3339)
+	}
+}
+
+// exportSigningFiles writes the decrypted certificate, a .p12 bundle, and the
+// profiles to dir, replacing earlier exports.
+func exportSigningFiles(dir, password string, identity *signingIdentity, profiles map[string][]byte, result *asc.SigningSyncResult) error {
+	if !asc.SimulationActive() {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("create output dir: %w", err)
+		}
+	}
+	p12, err := shared.EncodeSigningP12(identity.key, identity.cert, password, false)
+	if err != nil {
+		return fmt.Errorf("encode .p12: %w", err)
+	}
+	result.CertificateFile = filepath.Join(dir, identity.id+".cer")
+	result.P12File = filepath.Join(dir, identity.id+".p12")
+	if err := writeExportFile(result.CertificateFile, identity.der, 0o600); err != nil {
+		return fmt.Errorf("write certificate: %w", err)
+	}
+	if err := writeExportFile(result.P12File, p12, 0o600); err != nil {
+		return fmt.Errorf("write .p12: %w", err)
+	}
+	for i := range result.Profiles {
+		profile := &result.Profiles[i]
+		profile.ProfileFile = filepath.Join(dir, safeFileName(profile.BundleID+"_"+profile.ProfileType, profile.UUID)+".mobileprovision")
+		if err := writeExportFile(profile.ProfileFile, profiles[profile.BundleID], 0o644); err != nil {
+			return fmt.Errorf("write profile: %w", err)
+		}
+	}
+	return nil
+}
+
+// writeExportFile writes an exported file, or only records it while
+// simulating.
+func writeExportFile(path string, data []byte, perm os.FileMode) error {
+	if asc.SimulateLocalChange("WRITE", path) {
+		return nil
+	}
+	return writeFileAtomic(path, data, perm)
+}
@@ -54,8 +54,10 @@ func SubscriptionsPricingCommand() *ffcli.Command {
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	subscriptionID := fs.String("subscription-id", "", "Subscription ID")
 	territory := fs.String("territory", "USA", "Territory for pricing (e.g., USA)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+	shared.ResolveNames(fs, shared.ResourceSubscription, "subscription-id")
 
 	return &ffcli.Command{
 		Name:       "pricing",
@@ -472,45 +474,30 @@ func territoryToCurrency(territory string) string {
 }
 
 func printSubscriptionPricingResult(result *subscriptionPricingResult, format string, pretty bool) error {
-	switch strings.ToLower(strings.TrimSpace(format)) {
-	case "json":
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "json" {
 		return shared.PrintOutput(result, "json", pretty)
+	}
+	if pretty {
+		return fmt.Errorf("--pretty is only valid with JSON output")
+	}
+	headers, rows := subscriptionPricingRows(result)
+	switch format {
 	case "table":
-		if pretty {
-			return fmt.Errorf("--pretty is only valid with JSON output")
-		}
-		return printSubscriptionPricingTable(result)
+		asc.RenderTable(headers, rows)
 	case "markdown", "md":
-		if pretty {
-			return fmt.Errorf("--pretty is only valid with JSON output")
-		}
-		return printSubscriptionPricingMarkdown(result)
+		asc.RenderMarkdown(headers, rows)
+	case "csv":
+		return asc.RenderCSV(headers, rows)
+	case "ndjson":
+		return asc.RenderNDJSON(headers, rows)
 	default:
 		return fmt.Errorf("unsupported format: %s", format)
 	}
-}
-
-func printSubscriptionPricingTable(result *subscriptionPricingResult) error {
-	headers := []string{"ID", "Name", "Product ID", "Period", "State", "Group", "Current Price", "Proceeds", "Proceeds Y2"}
-	rows := make([][]string, 0, len(result.Subscriptions))
-	for _, item := range result.Subscriptions {
-		rows = append(rows, []string{
-			item.ID,
-			compactSubText(item.Name),
-			item.ProductID,
-			item.SubscriptionPeriod,
-			item.State,
-			compactSubText(item.GroupName),
-			formatSubMoney(item.CurrentPrice),
-			formatSubMoney(item.Proceeds),
-			formatSubMoney(item.ProceedsYear2),
-		})
-	}
-	asc.RenderTable(headers, rows)
 	return nil
 }
 
-func printSubscriptionPricingMarkdown(result *subscriptionPricingResult) error {
+func subscriptionPricingRows(result *subscriptionPricingResult) ([]string, [][]string) {
 	headers := []string{"ID", "Name", "Product ID", "Period", "State", "Group", "Current Price", "Proceeds", "Proceeds Y2"}
 	rows := make([][]string, 0, len(result.Subscriptions))
 	for _, item := range result.Subscriptions {
@@ -526,8 +513,7 @@ func printSubscriptionPricingMarkdown(result *subscriptionPricingResult) error {
 			formatSubMoney(item.ProceedsYear2),
 		})
 	}
-	asc.RenderMarkdown(headers, rows)
-	return nil
+	return headers, rows
 }
 
 func formatSubMoney(value *subMoney) string {
@@ -39,8 +39,9 @@ func SubscriptionsPromotedPurchaseGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("promoted-purchase get", flag.ExitOnError)
 
 	subscriptionID := fs.String("id", "", "Subscription ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceSubscription, "id")
 
 	return &ffcli.Command{
 		Name:       "get",
@@ -58,11 +58,12 @@ func BetaGroupsListCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	global := fs.Bool("global", false, "List beta groups across all apps (top-level endpoint)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
 
 	return &ffcli.Command{
 		Name:       "list",
@@ -174,8 +175,9 @@ func BetaGroupsCreateCommand() *ffcli.Command {
 
 	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
 	name := fs.String("name", "", "Beta group name")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
 
 	return &ffcli.Command{
 		Name:       "create",
@@ -221,8 +223,9 @@ func BetaGroupsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
 	id := fs.String("id", "", "Beta group ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBetaGroup, "id")
 
 	return &ffcli.Command{
 		Name:       "get",
@@ -270,8 +273,9 @@ func BetaGroupsUpdateCommand() *ffcli.Command {
 	feedbackEnabled := fs.Bool("feedback-enabled", false, "Enable feedback")
 	internal := fs.Bool("internal", false, "Set as internal group")
 	allBuilds := fs.Bool("all-builds", false, "Grant access to all builds")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBetaGroup, "id")
 
 	return &ffcli.Command{
 		Name:       "update",
@@ -381,6 +385,7 @@ func BetaGroupsDeleteCommand() *ffcli.Command {
 
 	id := fs.String("id", "", "Beta group ID")
 	confirm := fs.Bool("confirm", false, "Confirm deletion")
+	shared.ResolveNames(fs, shared.ResourceBetaGroup, "id")
 
 	return &ffcli.Command{
 		Name:       "delete",
@@ -426,6 +431,8 @@ func BetaGroupsAddTestersCommand() *ffcli.Command {
 
 	group := fs.String("group", "", "Beta group ID")
 	tester := fs.String("tester", "", "Beta tester ID(s), comma-separated")
+	shared.ResolveNames(fs, shared.ResourceBetaGroup, "group")
+	shared.ResolveNameLists(fs, shared.ResourceBetaTester, "tester")
 
 	return &ffcli.Command{
 		Name:       "add-testers",
@@ -476,6 +483,8 @@ func BetaGroupsRemoveTestersCommand() *ffcli.Command {
 	group := fs.String("group", "", "Beta group ID")
 	tester := fs.String("tester", "", "Beta tester ID(s), comma-separated")
 	confirm := fs.Bool("confirm", false, "Confirm removal")
+	shared.ResolveNames(fs, shared.ResourceBetaGroup, "group")
+	shared.ResolveNameLists(fs, shared.ResourceBetaTester, "tester")
 
 	return &ffcli.Command{
 		Name:       "remove-testers",
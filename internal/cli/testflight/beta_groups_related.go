@@ -40,8 +40,9 @@ func BetaGroupsAppGetCommand() *ffcli.Command {
 
 	groupID := fs.String("group-id", "", "Beta group ID")
 	aliasID := fs.String("id", "", "Beta group ID (alias of --group-id)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBetaGroup, "group-id", "id")
 
 	return &ffcli.Command{
 		Name:       "get",
@@ -113,8 +114,9 @@ func BetaGroupsRecruitmentCriteriaGetCommand() *ffcli.Command {
 
 	groupID := fs.String("group-id", "", "Beta group ID")
 	aliasID := fs.String("id", "", "Beta group ID (alias of --group-id)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBetaGroup, "group-id", "id")
 
 	return &ffcli.Command{
 		Name:       "get",
@@ -186,8 +188,9 @@ func BetaGroupsRecruitmentCriterionCompatibleBuildCheckGetCommand() *ffcli.Comma
 
 	groupID := fs.String("group-id", "", "Beta group ID")
 	aliasID := fs.String("id", "", "Beta group ID (alias of --group-id)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceBetaGroup, "group-id", "id")
 
 	return &ffcli.Command{
 		Name:       "get",
@@ -94,11 +94,13 @@ func TestFlightSyncCommand() *ffcli.Command {
 		LongHelp: `Sync TestFlight configuration.
 
 Examples:
-  asc testflight sync pull --app "APP_ID" --output "./testflight.yaml"`,
+  asc testflight sync pull --app "APP_ID" --output "./testflight.yaml"
+  asc testflight sync push --app "APP_ID" --file "./testflight.yaml" --dry-run`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			TestFlightSyncPullCommand(),
+			TestFlightSyncPushCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -118,6 +120,8 @@ func TestFlightSyncPullCommand() *ffcli.Command {
 	buildFilter := fs.String("build", "", "Filter to build ID(s), comma-separated")
 	testerFilter := fs.String("tester", "", "Filter to tester ID(s) or emails, comma-separated")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+	shared.ResolveNameLists(fs, shared.ResourceBuild, "build")
 
 	return &ffcli.Command{
 		Name:       "pull",
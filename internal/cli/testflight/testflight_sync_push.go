@@ -0,0 +1,792 @@
+package testflight
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	testFlightSyncActionCreate = "create"
+	testFlightSyncActionUpdate = "update"
+	testFlightSyncActionDelete = "delete"
+	testFlightSyncActionAdd    = "add"
+	testFlightSyncActionRemove = "remove"
+
+	testFlightSyncResourceGroup  = "betaGroup"
+	testFlightSyncResourceBuild  = "betaGroupBuild"
+	testFlightSyncResourceTester = "betaGroupTester"
+)
+
+// testFlightSyncAction describes a single change applied by sync push.
+type testFlightSyncAction struct {
+	Action   string
+	Resource string
+	Group    string
+	GroupID  string
+	BuildID  string
+	Tester   string
+	TesterID string
+	Details  string
+
+	groupKey    string
+	groupKeys   []string
+	createAttrs *asc.BetaGroupAttributes
+	updateAttrs *asc.BetaGroupUpdateAttributes
+	testerCfg   *TestFlightTesterConfig
+}
+
+func (a testFlightSyncAction) report() asc.TestFlightSyncAction {
+	return asc.TestFlightSyncAction{
+		Action:   a.Action,
+		Resource: a.Resource,
+		Group:    a.Group,
+		GroupID:  a.GroupID,
+		BuildID:  a.BuildID,
+		Tester:   a.Tester,
+		TesterID: a.TesterID,
+		Details:  a.Details,
+	}
+}
+
+type testFlightSyncPushClient interface {
+	testFlightSyncClient
+	CreateBetaGroupWithAttributes(ctx context.Context, appID string, attrs asc.BetaGroupAttributes) (*asc.BetaGroupResponse, error)
+	UpdateBetaGroup(ctx context.Context, groupID string, req asc.BetaGroupUpdateRequest) (*asc.BetaGroupResponse, error)
+	DeleteBetaGroup(ctx context.Context, groupID string) error
+	AddBetaGroupsToBuild(ctx context.Context, buildID string, groupIDs []string) error
+	RemoveBetaGroupsFromBuild(ctx context.Context, buildID string, groupIDs []string) error
+	GetBetaTesters(ctx context.Context, appID string, opts ...asc.BetaTestersOption) (*asc.BetaTestersResponse, error)
+	CreateBetaTester(ctx context.Context, email, firstName, lastName string, groupIDs []string) (*asc.BetaTesterResponse, error)
+	AddBetaTesterToGroups(ctx context.Context, testerID string, groupIDs []string) error
+	RemoveBetaTestersFromGroup(ctx context.Context, groupID string, testerIDs []string) error
+}
+
+// TestFlightSyncPushCommand applies a TestFlight YAML config to App Store Connect.
+func TestFlightSyncPushCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("push", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	file := fs.String("file", "", "Input YAML file produced by sync pull (required)")
+	dryRun := fs.Bool("dry-run", false, "Print the plan without applying changes")
+	prune := fs.Bool("prune", false, "Remove groups, build assignments and tester memberships not in the file")
+	confirm := fs.Bool("confirm", false, "Confirm deletions and removals planned by --prune")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+
+	return &ffcli.Command{
+		Name:       "push",
+		ShortUsage: "asc testflight sync push [flags]",
+		ShortHelp:  "Apply a TestFlight YAML configuration.",
+		LongHelp: `Apply a TestFlight YAML configuration.
+
+Compares the file against the live TestFlight state and creates or updates
+beta groups, public link settings, group build assignments and tester
+memberships. Groups are matched by ID, then by name. Groups without an ID
+are created. Builds and testers may reference groups by ID or name.
+
+Build assignments are only managed when the file lists builds, and tester
+memberships are only managed when the file has a testers section. Use
+--prune to remove groups, build assignments and tester memberships that
+are not in the file; plans that delete or remove anything also require
+--confirm. Use --dry-run to print the plan without applying it.
+
+Examples:
+  asc testflight sync push --app "APP_ID" --file "./testflight.yaml" --dry-run
+  asc testflight sync push --app "APP_ID" --file "./testflight.yaml"
+  asc testflight sync push --app "APP_ID" --file "./testflight.yaml" --prune --confirm --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintf(os.Stderr, "Error: --app is required (or set ASC_APP_ID)\n\n")
+				return flag.ErrHelp
+			}
+
+			fileValue := strings.TrimSpace(*file)
+			if fileValue == "" {
+				fmt.Fprintf(os.Stderr, "Error: --file is required\n\n")
+				return flag.ErrHelp
+			}
+
+			desired, err := readTestFlightConfigYAML(fileValue)
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+			if desired.App.ID != "" && desired.App.ID != resolvedAppID {
+				return fmt.Errorf("testflight sync push: file is for app %q, not %q", desired.App.ID, resolvedAppID)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			live, err := pullTestFlightConfig(requestCtx, client, resolvedAppID, testFlightPullOptions{
+				includeBuilds:  testFlightConfigManagesBuilds(desired),
+				includeTesters: testFlightConfigManagesTesters(desired),
+			})
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			actions, err := planTestFlightSync(desired, live, *prune)
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			if !*dryRun && !*confirm {
+				if removals := countTestFlightSyncRemovals(actions); removals > 0 {
+					return shared.UsageErrorf("--confirm is required to apply %d deletion(s) or removal(s); review them with --dry-run", removals)
+				}
+			}
+
+			applied := 0
+			if !*dryRun {
+				applied, err = applyTestFlightSync(requestCtx, client, resolvedAppID, actions)
+				if err != nil {
+					return fmt.Errorf("testflight sync push: %w", err)
+				}
+			}
+
+			result := &asc.TestFlightSyncPushResult{
+				File:    filepath.Clean(fileValue),
+				AppID:   resolvedAppID,
+				DryRun:  *dryRun,
+				Prune:   *prune,
+				Actions: make([]asc.TestFlightSyncAction, 0, len(actions)),
+				Applied: applied,
+			}
+			for _, action := range actions {
+				result.Actions = append(result.Actions, action.report())
+			}
+			return shared.PrintOutput(result, *output, *pretty)
+		},
+	}
+}
+
+// countTestFlightSyncRemovals returns how many planned actions delete groups
+// or remove build assignments and tester memberships.
+func countTestFlightSyncRemovals(actions []testFlightSyncAction) int {
+	count := 0
+	for _, action := range actions {
+		if action.Action == testFlightSyncActionDelete || action.Action == testFlightSyncActionRemove {
+			count++
+		}
+	}
+	return count
+}
+
+func readTestFlightConfigYAML(path string) (*TestFlightConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+	var config TestFlightConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("parse config: %w", err)
+	}
+	return &config, nil
+}
+
+func testFlightConfigManagesBuilds(config *TestFlightConfig) bool {
+	if config == nil {
+		return false
+	}
+	if len(config.Builds) > 0 {
+		return true
+	}
+	for _, group := range config.Groups {
+		if len(group.Builds) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func testFlightConfigManagesTesters(config *TestFlightConfig) bool {
+	return config != nil && config.Testers != nil
+}
+
+// testFlightSyncGroupState tracks a desired group and its live counterpart.
+type testFlightSyncGroupState struct {
+	key     string
+	desired TestFlightGroupConfig
+	live    *TestFlightGroupConfig
+}
+
+func (g *testFlightSyncGroupState) liveID() string {
+	if g.live == nil {
+		return ""
+	}
+	return g.live.ID
+}
+
+// planTestFlightSync computes the ordered list of actions needed to make the
+// live configuration match the desired configuration.
+func planTestFlightSync(desired, live *TestFlightConfig, prune bool) ([]testFlightSyncAction, error) {
+	if desired == nil {
+		return nil, fmt.Errorf("config is required")
+	}
+	if live == nil {
+		live = &TestFlightConfig{}
+	}
+
+	liveByID := make(map[string]*TestFlightGroupConfig, len(live.Groups))
+	liveByName := make(map[string][]*TestFlightGroupConfig, len(live.Groups))
+	for i := range live.Groups {
+		group := &live.Groups[i]
+		liveByID[group.ID] = group
+		name := strings.ToLower(strings.TrimSpace(group.Name))
+		liveByName[name] = append(liveByName[name], group)
+	}
+
+	groups := make([]*testFlightSyncGroupState, 0, len(desired.Groups))
+	refs := make(map[string]*testFlightSyncGroupState)
+	matchedLive := make(map[string]struct{})
+	for _, group := range desired.Groups {
+		id := strings.TrimSpace(group.ID)
+		name := strings.TrimSpace(group.Name)
+		if name == "" && id == "" {
+			return nil, fmt.Errorf("beta group entries require a name or id")
+		}
+
+		state := &testFlightSyncGroupState{desired: group}
+		if id != "" {
+			match, ok := liveByID[id]
+			if !ok {
+				return nil, fmt.Errorf("beta group %q not found; remove the id to create it", id)
+			}
+			state.live = match
+		} else {
+			matches := liveByName[strings.ToLower(name)]
+			if len(matches) > 1 {
+				return nil, fmt.Errorf("multiple beta groups named %q; use group ID", name)
+			}
+			if len(matches) == 1 {
+				state.live = matches[0]
+			}
+		}
+		if name == "" {
+			state.desired.Name = state.live.Name
+		}
+
+		if state.live != nil {
+			if _, ok := matchedLive[state.live.ID]; ok {
+				return nil, fmt.Errorf("beta group %q is listed more than once", state.live.Name)
+			}
+			matchedLive[state.live.ID] = struct{}{}
+			state.key = state.live.ID
+			refs[state.live.ID] = state
+		} else {
+			state.key = "new:" + strings.ToLower(name)
+		}
+
+		nameKey := strings.ToLower(strings.TrimSpace(state.desired.Name))
+		if existing, ok := refs[nameKey]; ok && existing != state {
+			return nil, fmt.Errorf("beta group %q is listed more than once", state.desired.Name)
+		}
+		refs[nameKey] = state
+		groups = append(groups, state)
+	}
+
+	resolveRef := func(ref string) (*testFlightSyncGroupState, error) {
+		trimmed := strings.TrimSpace(ref)
+		if state, ok := refs[trimmed]; ok {
+			return state, nil
+		}
+		if state, ok := refs[strings.ToLower(trimmed)]; ok {
+			return state, nil
+		}
+		return nil, fmt.Errorf("beta group %q is referenced but not defined in groups", trimmed)
+	}
+
+	actions := make([]testFlightSyncAction, 0)
+
+	// Group creates and updates.
+	for _, state := range groups {
+		if state.live == nil {
+			if state.desired.PublicLinkLimit != nil && (*state.desired.PublicLinkLimit < 1 || *state.desired.PublicLinkLimit > 10000) {
+				return nil, fmt.Errorf("beta group %q: publicLinkLimit must be between 1 and 10000", state.desired.Name)
+			}
+			attrs := asc.BetaGroupAttributes{
+				Name:              state.desired.Name,
+				IsInternalGroup:   state.desired.IsInternalGroup,
+				PublicLinkEnabled: state.desired.PublicLinkEnabled,
+				FeedbackEnabled:   state.desired.FeedbackEnabled,
+			}
+			if state.desired.PublicLinkLimit != nil {
+				attrs.PublicLinkLimitEnabled = true
+				attrs.PublicLinkLimit = *state.desired.PublicLinkLimit
+			}
+			actions = append(actions, testFlightSyncAction{
+				Action:      testFlightSyncActionCreate,
+				Resource:    testFlightSyncResourceGroup,
+				Group:       state.desired.Name,
+				Details:     describeTestFlightGroupCreate(state.desired),
+				groupKey:    state.key,
+				createAttrs: &attrs,
+			})
+			continue
+		}
+
+		if state.desired.IsInternalGroup != state.live.IsInternalGroup {
+			return nil, fmt.Errorf("beta group %q: isInternalGroup cannot be changed after creation", state.live.Name)
+		}
+		attrs, changes, err := diffTestFlightGroup(state.desired, *state.live)
+		if err != nil {
+			return nil, err
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		actions = append(actions, testFlightSyncAction{
+			Action:      testFlightSyncActionUpdate,
+			Resource:    testFlightSyncResourceGroup,
+			Group:       state.desired.Name,
+			GroupID:     state.live.ID,
+			Details:     strings.Join(changes, "; "),
+			groupKey:    state.key,
+			updateAttrs: attrs,
+		})
+	}
+
+	// Group build assignments.
+	if testFlightConfigManagesBuilds(desired) {
+		desiredBuilds := make(map[*testFlightSyncGroupState]map[string]struct{}, len(groups))
+		addBuild := func(state *testFlightSyncGroupState, buildID string) {
+			buildID = strings.TrimSpace(buildID)
+			if buildID == "" {
+				return
+			}
+			if desiredBuilds[state] == nil {
+				desiredBuilds[state] = make(map[string]struct{})
+			}
+			desiredBuilds[state][buildID] = struct{}{}
+		}
+		for _, state := range groups {
+			for _, buildID := range state.desired.Builds {
+				addBuild(state, buildID)
+			}
+		}
+		for _, build := range desired.Builds {
+			for _, ref := range build.Groups {
+				state, err := resolveRef(ref)
+				if err != nil {
+					return nil, fmt.Errorf("build %s: %w", build.ID, err)
+				}
+				addBuild(state, build.ID)
+			}
+		}
+
+		for _, state := range groups {
+			current := make(map[string]struct{})
+			if state.live != nil {
+				for _, buildID := range state.live.Builds {
+					current[buildID] = struct{}{}
+				}
+			}
+			for _, buildID := range sortedKeys(desiredBuilds[state]) {
+				if _, ok := current[buildID]; ok {
+					continue
+				}
+				actions = append(actions, testFlightSyncAction{
+					Action:   testFlightSyncActionAdd,
+					Resource: testFlightSyncResourceBuild,
+					Group:    state.desired.Name,
+					GroupID:  state.liveID(),
+					BuildID:  buildID,
+					groupKey: state.key,
+				})
+			}
+			if !prune {
+				continue
+			}
+			for _, buildID := range sortedKeys(current) {
+				if _, ok := desiredBuilds[state][buildID]; ok {
+					continue
+				}
+				actions = append(actions, testFlightSyncAction{
+					Action:   testFlightSyncActionRemove,
+					Resource: testFlightSyncResourceBuild,
+					Group:    state.desired.Name,
+					GroupID:  state.liveID(),
+					BuildID:  buildID,
+					groupKey: state.key,
+				})
+			}
+		}
+	}
+
+	// Tester memberships.
+	if testFlightConfigManagesTesters(desired) {
+		liveTesters := make(map[string]*TestFlightTesterConfig, len(live.Testers))
+		for i := range live.Testers {
+			tester := &live.Testers[i]
+			liveTesters[tester.ID] = tester
+			if tester.Email != "" {
+				liveTesters[strings.ToLower(tester.Email)] = tester
+			}
+		}
+
+		seenLive := make(map[string]struct{})
+		for i := range desired.Testers {
+			tester := desired.Testers[i]
+			id := strings.TrimSpace(tester.ID)
+			email := strings.TrimSpace(tester.Email)
+			if id == "" && email == "" {
+				return nil, fmt.Errorf("tester entries require an email or id")
+			}
+			label := email
+			if label == "" {
+				label = id
+			}
+
+			var match *TestFlightTesterConfig
+			if id != "" {
+				match = liveTesters[id]
+			}
+			if match == nil && email != "" {
+				match = liveTesters[strings.ToLower(email)]
+			}
+
+			wanted := make(map[*testFlightSyncGroupState]struct{}, len(tester.Groups))
+			for _, ref := range tester.Groups {
+				state, err := resolveRef(ref)
+				if err != nil {
+					return nil, fmt.Errorf("tester %s: %w", label, err)
+				}
+				wanted[state] = struct{}{}
+			}
+
+			current := make(map[string]struct{})
+			testerID := id
+			if match != nil {
+				seenLive[match.ID] = struct{}{}
+				testerID = match.ID
+				for _, groupID := range match.Groups {
+					current[groupID] = struct{}{}
+				}
+			}
+
+			missing := make([]*testFlightSyncGroupState, 0, len(wanted))
+			for _, state := range groups {
+				if _, ok := wanted[state]; !ok {
+					continue
+				}
+				if _, ok := current[state.liveID()]; ok && state.live != nil {
+					continue
+				}
+				missing = append(missing, state)
+			}
+			if len(missing) > 0 {
+				names := make([]string, 0, len(missing))
+				keys := make([]string, 0, len(missing))
+				for _, state := range missing {
+					names = append(names, state.desired.Name)
+					keys = append(keys, state.key)
+				}
+				testerCfg := tester
+				actions = append(actions, testFlightSyncAction{
+					Action:    testFlightSyncActionAdd,
+					Resource:  testFlightSyncResourceTester,
+					Group:     strings.Join(names, ", "),
+					Tester:    label,
+					TesterID:  testerID,
+					groupKeys: keys,
+					testerCfg: &testerCfg,
+				})
+			}
+
+			if !prune || match == nil {
+				continue
+			}
+			for _, state := range groups {
+				if state.live == nil {
+					continue
+				}
+				if _, ok := current[state.live.ID]; !ok {
+					continue
+				}
+				if _, ok := wanted[state]; ok {
+					continue
+				}
+				actions = append(actions, testFlightSyncAction{
+					Action:   testFlightSyncActionRemove,
+					Resource: testFlightSyncResourceTester,
+					Group:    state.desired.Name,
+					GroupID:  state.live.ID,
+					Tester:   label,
+					TesterID: match.ID,
+					groupKey: state.key,
+				})
+			}
+		}
+
+		if prune {
+			for _, tester := range live.Testers {
+				if _, ok := seenLive[tester.ID]; ok {
+					continue
+				}
+				label := tester.Email
+				if label == "" {
+					label = tester.ID
+				}
+				for _, state := range groups {
+					if state.live == nil || !containsString(tester.Groups, state.live.ID) {
+						continue
+					}
+					actions = append(actions, testFlightSyncAction{
+						Action:   testFlightSyncActionRemove,
+						Resource: testFlightSyncResourceTester,
+						Group:    state.desired.Name,
+						GroupID:  state.live.ID,
+						Tester:   label,
+						TesterID: tester.ID,
+						groupKey: state.key,
+					})
+				}
+			}
+		}
+	}
+
+	// Group deletes run last so memberships are not touched twice.
+	if prune {
+		for _, group := range live.Groups {
+			if _, ok := matchedLive[group.ID]; ok {
+				continue
+			}
+			actions = append(actions, testFlightSyncAction{
+				Action:   testFlightSyncActionDelete,
+				Resource: testFlightSyncResourceGroup,
+				Group:    group.Name,
+				GroupID:  group.ID,
+			})
+		}
+	}
+
+	return actions, nil
+}
+
+func diffTestFlightGroup(desired, live TestFlightGroupConfig) (*asc.BetaGroupUpdateAttributes, []string, error) {
+	attrs := &asc.BetaGroupUpdateAttributes{}
+	changes := make([]string, 0)
+
+	if name := strings.TrimSpace(desired.Name); name != "" && name != live.Name {
+		attrs.Name = name
+		changes = append(changes, fmt.Sprintf("name: %q -> %q", live.Name, name))
+	}
+	if desired.PublicLinkEnabled != live.PublicLinkEnabled {
+		value := desired.PublicLinkEnabled
+		attrs.PublicLinkEnabled = &value
+		changes = append(changes, fmt.Sprintf("publicLinkEnabled: %t -> %t", live.PublicLinkEnabled, value))
+	}
+	if !equalIntPtr(desired.PublicLinkLimit, live.PublicLinkLimit) {
+		enabled := desired.PublicLinkLimit != nil
+		attrs.PublicLinkLimitEnabled = &enabled
+		if enabled {
+			if *desired.PublicLinkLimit < 1 || *desired.PublicLinkLimit > 10000 {
+				return nil, nil, fmt.Errorf("beta group %q: publicLinkLimit must be between 1 and 10000", live.Name)
+			}
+			attrs.PublicLinkLimit = *desired.PublicLinkLimit
+		}
+		changes = append(changes, fmt.Sprintf("publicLinkLimit: %s -> %s", formatOptionalLimit(live.PublicLinkLimit), formatOptionalLimit(desired.PublicLinkLimit)))
+	}
+	if desired.FeedbackEnabled != live.FeedbackEnabled {
+		value := desired.FeedbackEnabled
+		attrs.FeedbackEnabled = &value
+		changes = append(changes, fmt.Sprintf("feedbackEnabled: %t -> %t", live.FeedbackEnabled, value))
+	}
+
+	return attrs, changes, nil
+}
+
+func describeTestFlightGroupCreate(group TestFlightGroupConfig) string {
+	parts := []string{fmt.Sprintf("isInternalGroup: %t", group.IsInternalGroup)}
+	if group.PublicLinkEnabled {
+		parts = append(parts, "publicLinkEnabled: true")
+	}
+	if group.PublicLinkLimit != nil {
+		parts = append(parts, fmt.Sprintf("publicLinkLimit: %d", *group.PublicLinkLimit))
+	}
+	parts = append(parts, fmt.Sprintf("feedbackEnabled: %t", group.FeedbackEnabled))
+	return strings.Join(parts, "; ")
+}
+
+// applyTestFlightSync executes the planned actions in order and returns the
+// number of actions applied. It stops at the first failure.
+func applyTestFlightSync(ctx context.Context, client testFlightSyncPushClient, appID string, actions []testFlightSyncAction) (int, error) {
+	groupIDs := make(map[string]string)
+	lookupGroup := func(key string) (string, error) {
+		if id, ok := groupIDs[key]; ok {
+			return id, nil
+		}
+		// Keys for existing groups are their IDs.
+		if !strings.HasPrefix(key, "new:") {
+			return key, nil
+		}
+		return "", fmt.Errorf("beta group was not created")
+	}
+
+	applied := 0
+	for i := range actions {
+		action := &actions[i]
+		switch {
+		case action.Resource == testFlightSyncResourceGroup && action.Action == testFlightSyncActionCreate:
+			resp, err := client.CreateBetaGroupWithAttributes(ctx, appID, *action.createAttrs)
+			if err != nil {
+				return applied, fmt.Errorf("create beta group %q: %w", action.Group, err)
+			}
+			action.GroupID = resp.Data.ID
+			groupIDs[action.groupKey] = resp.Data.ID
+		case action.Resource == testFlightSyncResourceGroup && action.Action == testFlightSyncActionUpdate:
+			req := asc.BetaGroupUpdateRequest{
+				Data: asc.BetaGroupUpdateData{
+					Type:       asc.ResourceTypeBetaGroups,
+					ID:         action.GroupID,
+					Attributes: action.updateAttrs,
+				},
+			}
+			if _, err := client.UpdateBetaGroup(ctx, action.GroupID, req); err != nil {
+				return applied, fmt.Errorf("update beta group %q: %w", action.Group, err)
+			}
+		case action.Resource == testFlightSyncResourceGroup && action.Action == testFlightSyncActionDelete:
+			if err := client.DeleteBetaGroup(ctx, action.GroupID); err != nil {
+				return applied, fmt.Errorf("delete beta group %q: %w", action.Group, err)
+			}
+		case action.Resource == testFlightSyncResourceBuild:
+			groupID, err := lookupGroup(action.groupKey)
+			if err != nil {
+				return applied, fmt.Errorf("build %s: %w", action.BuildID, err)
+			}
+			action.GroupID = groupID
+			if action.Action == testFlightSyncActionAdd {
+				err = client.AddBetaGroupsToBuild(ctx, action.BuildID, []string{groupID})
+			} else {
+				err = client.RemoveBetaGroupsFromBuild(ctx, action.BuildID, []string{groupID})
+			}
+			if err != nil {
+				return applied, fmt.Errorf("%s build %s for beta group %q: %w", action.Action, action.BuildID, action.Group, err)
+			}
+		case action.Resource == testFlightSyncResourceTester && action.Action == testFlightSyncActionAdd:
+			ids := make([]string, 0, len(action.groupKeys))
+			for _, key := range action.groupKeys {
+				groupID, err := lookupGroup(key)
+				if err != nil {
+					return applied, fmt.Errorf("tester %s: %w", action.Tester, err)
+				}
+				ids = append(ids, groupID)
+			}
+			action.GroupID = strings.Join(ids, ",")
+			testerID, err := applyTestFlightTesterAdd(ctx, client, appID, action, ids)
+			if err != nil {
+				return applied, fmt.Errorf("add tester %s: %w", action.Tester, err)
+			}
+			action.TesterID = testerID
+		case action.Resource == testFlightSyncResourceTester && action.Action == testFlightSyncActionRemove:
+			if err := client.RemoveBetaTestersFromGroup(ctx, action.GroupID, []string{action.TesterID}); err != nil {
+				return applied, fmt.Errorf("remove tester %s from beta group %q: %w", action.Tester, action.Group, err)
+			}
+		default:
+			return applied, fmt.Errorf("unsupported action %s %s", action.Action, action.Resource)
+		}
+		applied++
+	}
+	return applied, nil
+}
+
+func applyTestFlightTesterAdd(ctx context.Context, client testFlightSyncPushClient, appID string, action *testFlightSyncAction, groupIDs []string) (string, error) {
+	testerID := strings.TrimSpace(action.TesterID)
+	email := ""
+	if action.testerCfg != nil {
+		email = strings.TrimSpace(action.testerCfg.Email)
+	}
+
+	if testerID == "" && email != "" {
+		testers, err := client.GetBetaTesters(ctx, appID, asc.WithBetaTestersEmail(email))
+		if err != nil {
+			return "", err
+		}
+		switch len(testers.Data) {
+		case 0:
+		case 1:
+			testerID = testers.Data[0].ID
+		default:
+			return "", fmt.Errorf("multiple beta testers found for %q", email)
+		}
+	}
+
+	if testerID != "" {
+		if err := client.AddBetaTesterToGroups(ctx, testerID, groupIDs); err != nil {
+			return "", err
+		}
+		return testerID, nil
+	}
+
+	if email == "" {
+		return "", errors.New("email is required to create a tester")
+	}
+	firstName, lastName := splitTesterName(action.testerCfg.Name)
+	resp, err := client.CreateBetaTester(ctx, email, firstName, lastName, groupIDs)
+	if err != nil {
+		return "", err
+	}
+	return resp.Data.ID, nil
+}
+
+func splitTesterName(name string) (string, string) {
+	first, last, _ := strings.Cut(strings.TrimSpace(name), " ")
+	return strings.TrimSpace(first), strings.TrimSpace(last)
+}
+
+func equalIntPtr(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func formatOptionalLimit(value *int) string {
+	if value == nil {
+		return "none"
+	}
+	return strconv.Itoa(*value)
+}
+
+func sortedKeys(values map[string]struct{}) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,305 @@
+package testflight
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type testFlightSyncPushStub struct {
+	testFlightSyncStub
+	calls          []string
+	existingByMail map[string]string
+}
+
+func (s *testFlightSyncPushStub) CreateBetaGroupWithAttributes(ctx context.Context, appID string, attrs asc.BetaGroupAttributes) (*asc.BetaGroupResponse, error) {
+	s.calls = append(s.calls, fmt.Sprintf("create-group %s internal=%t", attrs.Name, attrs.IsInternalGroup))
+	return &asc.BetaGroupResponse{Data: asc.Resource[asc.BetaGroupAttributes]{ID: "new-" + strings.ToLower(attrs.Name), Attributes: attrs}}, nil
+}
+
+func (s *testFlightSyncPushStub) UpdateBetaGroup(ctx context.Context, groupID string, req asc.BetaGroupUpdateRequest) (*asc.BetaGroupResponse, error) {
+	s.calls = append(s.calls, "update-group "+groupID)
+	return &asc.BetaGroupResponse{}, nil
+}
+
+func (s *testFlightSyncPushStub) DeleteBetaGroup(ctx context.Context, groupID string) error {
+	s.calls = append(s.calls, "delete-group "+groupID)
+	return nil
+}
+
+func (s *testFlightSyncPushStub) AddBetaGroupsToBuild(ctx context.Context, buildID string, groupIDs []string) error {
+	s.calls = append(s.calls, fmt.Sprintf("add-build %s %s", buildID, strings.Join(groupIDs, ",")))
+	return nil
+}
+
+func (s *testFlightSyncPushStub) RemoveBetaGroupsFromBuild(ctx context.Context, buildID string, groupIDs []string) error {
+	s.calls = append(s.calls, fmt.Sprintf("remove-build %s %s", buildID, strings.Join(groupIDs, ",")))
+	return nil
+}
+
+func (s *testFlightSyncPushStub) GetBetaTesters(ctx context.Context, appID string, opts ...asc.BetaTestersOption) (*asc.BetaTestersResponse, error) {
+	resp := &asc.BetaTestersResponse{}
+	for _, id := range s.existingByMail {
+		resp.Data = append(resp.Data, asc.Resource[asc.BetaTesterAttributes]{ID: id})
+	}
+	return resp, nil
+}
+
+func (s *testFlightSyncPushStub) CreateBetaTester(ctx context.Context, email, firstName, lastName string, groupIDs []string) (*asc.BetaTesterResponse, error) {
+	s.calls = append(s.calls, fmt.Sprintf("create-tester %s %s/%s %s", email, firstName, lastName, strings.Join(groupIDs, ",")))
+	return &asc.BetaTesterResponse{Data: asc.Resource[asc.BetaTesterAttributes]{ID: "tester-new"}}, nil
+}
+
+func (s *testFlightSyncPushStub) AddBetaTesterToGroups(ctx context.Context, testerID string, groupIDs []string) error {
+	s.calls = append(s.calls, fmt.Sprintf("add-tester %s %s", testerID, strings.Join(groupIDs, ",")))
+	return nil
+}
+
+func (s *testFlightSyncPushStub) RemoveBetaTestersFromGroup(ctx context.Context, groupID string, testerIDs []string) error {
+	s.calls = append(s.calls, fmt.Sprintf("remove-tester %s %s", strings.Join(testerIDs, ","), groupID))
+	return nil
+}
+
+func testFlightSyncLiveConfig() *TestFlightConfig {
+	return &TestFlightConfig{
+		App: TestFlightAppConfig{ID: "app-1", Name: "Demo"},
+		Groups: []TestFlightGroupConfig{
+			{ID: "group-1", Name: "Alpha", IsInternalGroup: true, FeedbackEnabled: true, Builds: []string{"build-1"}},
+			{ID: "group-2", Name: "Beta", FeedbackEnabled: true, Builds: []string{"build-1", "build-2"}},
+			{ID: "group-3", Name: "Legacy"},
+		},
+		Testers: []TestFlightTesterConfig{
+			{ID: "tester-1", Email: "ada@example.com", Groups: []string{"group-1", "group-2"}},
+			{ID: "tester-2", Email: "grace@example.com", Groups: []string{"group-2"}},
+		},
+	}
+}
+
+func actionSummaries(actions []testFlightSyncAction) []string {
+	result := make([]string, 0, len(actions))
+	for _, action := range actions {
+		target := action.BuildID
+		if action.Resource == testFlightSyncResourceTester {
+			target = action.Tester
+		}
+		result = append(result, strings.TrimSpace(fmt.Sprintf("%s %s %s %s", action.Action, action.Resource, action.Group, target)))
+	}
+	return result
+}
+
+func TestPlanTestFlightSync_NoChanges(t *testing.T) {
+	live := testFlightSyncLiveConfig()
+	desired := testFlightSyncLiveConfig()
+
+	actions, err := planTestFlightSync(desired, live, true)
+	if err != nil {
+		t.Fatalf("planTestFlightSync() error: %v", err)
+	}
+	if len(actions) != 0 {
+		t.Fatalf("expected no actions, got %v", actionSummaries(actions))
+	}
+}
+
+func TestPlanTestFlightSync_CreatesUpdatesAndAssigns(t *testing.T) {
+	limit := 50
+	desired := &TestFlightConfig{
+		Groups: []TestFlightGroupConfig{
+			{Name: "Alpha", IsInternalGroup: true, FeedbackEnabled: true},
+			{ID: "group-2", Name: "Beta", PublicLinkEnabled: true, PublicLinkLimit: &limit, FeedbackEnabled: true},
+			{Name: "Gamma", FeedbackEnabled: true},
+		},
+		Builds: []TestFlightBuildConfig{
+			{ID: "build-3", Groups: []string{"Gamma", "group-2"}},
+		},
+		Testers: []TestFlightTesterConfig{
+			{Email: "ADA@example.com", Groups: []string{"Alpha", "Gamma"}},
+			{Email: "linus@example.com", Name: "Linus Torvalds", Groups: []string{"Gamma"}},
+		},
+	}
+
+	actions, err := planTestFlightSync(desired, testFlightSyncLiveConfig(), false)
+	if err != nil {
+		t.Fatalf("planTestFlightSync() error: %v", err)
+	}
+
+	got := strings.Join(actionSummaries(actions), "\n")
+	want := strings.Join([]string{
+		"update betaGroup Beta",
+		"create betaGroup Gamma",
+		"add betaGroupBuild Beta build-3",
+		"add betaGroupBuild Gamma build-3",
+		"add betaGroupTester Gamma ADA@example.com",
+		"add betaGroupTester Gamma linus@example.com",
+	}, "\n")
+	if got != want {
+		t.Fatalf("unexpected plan:\n%s\nwant:\n%s", got, want)
+	}
+
+	update := actions[0]
+	if update.updateAttrs == nil || update.updateAttrs.PublicLinkEnabled == nil || !*update.updateAttrs.PublicLinkEnabled {
+		t.Fatalf("expected publicLinkEnabled update, got %+v", update.updateAttrs)
+	}
+	if update.updateAttrs.PublicLinkLimitEnabled == nil || !*update.updateAttrs.PublicLinkLimitEnabled || update.updateAttrs.PublicLinkLimit != 50 {
+		t.Fatalf("expected public link limit update, got %+v", update.updateAttrs)
+	}
+	if update.updateAttrs.FeedbackEnabled != nil {
+		t.Fatalf("expected feedbackEnabled to be unchanged, got %v", *update.updateAttrs.FeedbackEnabled)
+	}
+}
+
+func TestPlanTestFlightSync_Prune(t *testing.T) {
+	desired := &TestFlightConfig{
+		Groups: []TestFlightGroupConfig{
+			{ID: "group-1", Name: "Alpha", IsInternalGroup: true, FeedbackEnabled: true, Builds: []string{"build-1"}},
+			{ID: "group-2", Name: "Beta", FeedbackEnabled: true, Builds: []string{"build-2"}},
+		},
+		Testers: []TestFlightTesterConfig{
+			{ID: "tester-1", Groups: []string{"group-1"}},
+		},
+	}
+
+	actions, err := planTestFlightSync(desired, testFlightSyncLiveConfig(), true)
+	if err != nil {
+		t.Fatalf("planTestFlightSync() error: %v", err)
+	}
+
+	got := strings.Join(actionSummaries(actions), "\n")
+	want := strings.Join([]string{
+		"remove betaGroupBuild Beta build-1",
+		"remove betaGroupTester Beta tester-1",
+		"remove betaGroupTester Beta grace@example.com",
+		"delete betaGroup Legacy",
+	}, "\n")
+	if got != want {
+		t.Fatalf("unexpected plan:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPlanTestFlightSync_WithoutPruneKeepsExtras(t *testing.T) {
+	desired := &TestFlightConfig{
+		Groups: []TestFlightGroupConfig{
+			{ID: "group-1", Name: "Alpha", IsInternalGroup: true, FeedbackEnabled: true},
+		},
+	}
+
+	actions, err := planTestFlightSync(desired, testFlightSyncLiveConfig(), false)
+	if err != nil {
+		t.Fatalf("planTestFlightSync() error: %v", err)
+	}
+	if len(actions) != 0 {
+		t.Fatalf("expected no actions, got %v", actionSummaries(actions))
+	}
+}
+
+func TestPlanTestFlightSync_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		desired *TestFlightConfig
+	}{
+		{
+			name:    "unknown group id",
+			desired: &TestFlightConfig{Groups: []TestFlightGroupConfig{{ID: "missing", Name: "Missing"}}},
+		},
+		{
+			name:    "internal flag change",
+			desired: &TestFlightConfig{Groups: []TestFlightGroupConfig{{ID: "group-2", Name: "Beta", IsInternalGroup: true}}},
+		},
+		{
+			name: "undefined group reference",
+			desired: &TestFlightConfig{
+				Groups:  []TestFlightGroupConfig{{ID: "group-1", Name: "Alpha", IsInternalGroup: true}},
+				Testers: []TestFlightTesterConfig{{Email: "ada@example.com", Groups: []string{"Nope"}}},
+			},
+		},
+		{
+			name: "duplicate group",
+			desired: &TestFlightConfig{Groups: []TestFlightGroupConfig{
+				{Name: "New"},
+				{Name: "new"},
+			}},
+		},
+		{
+			name: "tester without identity",
+			desired: &TestFlightConfig{
+				Groups:  []TestFlightGroupConfig{{ID: "group-1", Name: "Alpha", IsInternalGroup: true}},
+				Testers: []TestFlightTesterConfig{{Groups: []string{"Alpha"}}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := planTestFlightSync(test.desired, testFlightSyncLiveConfig(), false); err == nil {
+				t.Fatal("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestApplyTestFlightSync_ResolvesCreatedGroups(t *testing.T) {
+	desired := &TestFlightConfig{
+		Groups: []TestFlightGroupConfig{
+			{ID: "group-1", Name: "Alpha", IsInternalGroup: true, FeedbackEnabled: true},
+			{Name: "Gamma", Builds: []string{"build-9"}},
+		},
+		Testers: []TestFlightTesterConfig{
+			{Email: "ada@example.com", Groups: []string{"Gamma"}},
+			{Email: "linus@example.com", Name: "Linus Torvalds", Groups: []string{"Gamma", "Alpha"}},
+		},
+	}
+	live := testFlightSyncLiveConfig()
+	live.Groups = live.Groups[:1]
+	live.Testers = live.Testers[:1]
+
+	actions, err := planTestFlightSync(desired, live, false)
+	if err != nil {
+		t.Fatalf("planTestFlightSync() error: %v", err)
+	}
+
+	stub := &testFlightSyncPushStub{}
+	applied, err := applyTestFlightSync(context.Background(), stub, "app-1", actions)
+	if err != nil {
+		t.Fatalf("applyTestFlightSync() error: %v", err)
+	}
+	if applied != len(actions) {
+		t.Fatalf("expected %d applied actions, got %d", len(actions), applied)
+	}
+
+	got := strings.Join(stub.calls, "\n")
+	want := strings.Join([]string{
+		"create-group Gamma internal=false",
+		"add-build build-9 new-gamma",
+		"add-tester tester-1 new-gamma",
+		"create-tester linus@example.com Linus/Torvalds group-1,new-gamma",
+	}, "\n")
+	if got != want {
+		t.Fatalf("unexpected calls:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestReadTestFlightConfigYAML_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testflight.yaml")
+	data, err := marshalTestFlightConfigYAML(testFlightSyncLiveConfig())
+	if err != nil {
+		t.Fatalf("marshalTestFlightConfigYAML() error: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+
+	config, err := readTestFlightConfigYAML(path)
+	if err != nil {
+		t.Fatalf("readTestFlightConfigYAML() error: %v", err)
+	}
+	if len(config.Groups) != 3 || config.Groups[1].Name != "Beta" {
+		t.Fatalf("unexpected groups: %+v", config.Groups)
+	}
+	if !testFlightConfigManagesBuilds(config) || !testFlightConfigManagesTesters(config) {
+		t.Fatal("expected builds and testers to be managed")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/peterbourgon/ff/v3/ffcli"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
 )
 
 // ValidateCommand returns the asc validate command.
@@ -20,8 +21,11 @@ func ValidateCommand() *ffcli.Command {
 	versionID := fs.String("version-id", "", "App Store version ID (required)")
 	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
 	strict := fs.Bool("strict", false, "Treat warnings as errors (exit non-zero)")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	rulesPath := fs.String("rules", "", "Custom rules file (default: "+validation.DefaultRulesPath+" when present)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+	shared.ResolveNames(fs, shared.ResourceVersion, "version-id")
 
 	return &ffcli.Command{
 		Name:       "validate",
@@ -34,13 +38,36 @@ Checks:
   - Required fields and localizations
   - Screenshot size compatibility
   - Age rating completeness
+  - Custom rules from .asc/validate.yaml (or --rules)
+
+Use "asc validate iap" and "asc validate subscriptions" to check in-app
+purchases and subscriptions.
+
+Custom rules file:
+  rules:
+    - id: no-competitors
+      type: banned_words          # banned_words, required_keywords, url_pattern,
+      severity: error             # whats_new_mentions_version, min_screenshots
+      locales: [en-US]
+      fields: [description, promotionalText]
+      words: [android]
+      remediation: Remove competitor platform names
+    - id: iphone-screenshots
+      type: min_screenshots
+      display_types: [APP_IPHONE_67]
+      min: 3
 
 Examples:
   asc validate --app "APP_ID" --version-id "VERSION_ID"
   asc validate --app "APP_ID" --version-id "VERSION_ID" --platform IOS --output table
-  asc validate --app "APP_ID" --version-id "VERSION_ID" --strict`,
+  asc validate --app "APP_ID" --version-id "VERSION_ID" --strict
+  asc validate --app "APP_ID" --version-id "VERSION_ID" --rules ./ci/validate.yaml`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			ValidateIAPCommand(),
+			ValidateSubscriptionsCommand(),
+		},
 		Exec: func(ctx context.Context, args []string) error {
 			if strings.TrimSpace(*versionID) == "" {
 				fmt.Fprintln(os.Stderr, "Error: --version-id is required")
@@ -62,11 +89,17 @@ Examples:
 				normalizedPlatform = value
 			}
 
+			rules, err := loadRules(*rulesPath)
+			if err != nil {
+				return fmt.Errorf("validate: %w", err)
+			}
+
 			return runValidate(ctx, validateOptions{
 				AppID:     resolvedAppID,
 				VersionID: strings.TrimSpace(*versionID),
 				Platform:  normalizedPlatform,
 				Strict:    *strict,
+				Rules:     rules,
 				Output:    *output,
 				Pretty:    *pretty,
 			})
@@ -0,0 +1,362 @@
+package validate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+// ValidateIAPCommand returns the asc validate iap subcommand.
+func ValidateIAPCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("iap", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	iapID := fs.String("iap-id", "", "Validate a single in-app purchase ID")
+	strict := fs.Bool("strict", false, "Treat warnings as errors (exit non-zero)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+	shared.ResolveNames(fs, shared.ResourceInAppPurchase, "iap-id")
+
+	return &ffcli.Command{
+		Name:       "iap",
+		ShortUsage: "asc validate iap --app \"APP_ID\" [flags]",
+		ShortHelp:  "Validate in-app purchases before submission.",
+		LongHelp: `Validate that in-app purchases have everything App Review requires.
+
+Checks:
+  - Localizations (display name and description, primary locale)
+  - App Review screenshot
+  - Price schedule with a base price
+  - Availability in at least one territory
+
+Examples:
+  asc validate iap --app "APP_ID"
+  asc validate iap --app "APP_ID" --iap-id "IAP_ID" --output table
+  asc validate iap --app "APP_ID" --strict`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+
+			client, err := clientFactory()
+			if err != nil {
+				return fmt.Errorf("validate iap: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			primaryLocale, err := fetchPrimaryLocale(requestCtx, client, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("validate iap: %w", err)
+			}
+
+			var iaps []asc.Resource[asc.InAppPurchaseV2Attributes]
+			if id := strings.TrimSpace(*iapID); id != "" {
+				resp, err := client.GetInAppPurchaseV2(requestCtx, id)
+				if err != nil {
+					return fmt.Errorf("validate iap: failed to fetch in-app purchase: %w", err)
+				}
+				iaps = append(iaps, resp.Data)
+			} else {
+				firstPage, err := client.GetInAppPurchasesV2(requestCtx, resolvedAppID, asc.WithIAPLimit(200))
+				if err != nil {
+					return fmt.Errorf("validate iap: failed to fetch in-app purchases: %w", err)
+				}
+				all, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetInAppPurchasesV2(ctx, resolvedAppID, asc.WithIAPNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("validate iap: %w", err)
+				}
+				resp, ok := all.(*asc.InAppPurchasesV2Response)
+				if !ok {
+					return fmt.Errorf("validate iap: unexpected in-app purchases response")
+				}
+				iaps = resp.Data
+			}
+
+			input := validation.IAPInput{AppID: resolvedAppID, PrimaryLocale: primaryLocale}
+			for _, iap := range iaps {
+				item, err := fetchIAPValidation(requestCtx, client, iap)
+				if err != nil {
+					return fmt.Errorf("validate iap: %w", err)
+				}
+				input.InAppPurchases = append(input.InAppPurchases, item)
+			}
+
+			report := validation.ValidateIAPs(input, *strict)
+			return printProductReport(&report, *output, *pretty, "validate iap")
+		},
+	}
+}
+
+// ValidateSubscriptionsCommand returns the asc validate subscriptions subcommand.
+func ValidateSubscriptionsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("subscriptions", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	groupID := fs.String("group-id", "", "Validate a single subscription group ID")
+	strict := fs.Bool("strict", false, "Treat warnings as errors (exit non-zero)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+
+	return &ffcli.Command{
+		Name:       "subscriptions",
+		ShortUsage: "asc validate subscriptions --app \"APP_ID\" [flags]",
+		ShortHelp:  "Validate subscription groups and subscriptions before submission.",
+		LongHelp: `Validate that subscriptions have everything App Review requires.
+
+Checks:
+  - Group localizations and empty groups
+  - Subscription localizations (display name and description, primary locale)
+  - Subscription duration
+  - App Review screenshot
+  - Prices
+  - Availability in at least one territory
+
+Examples:
+  asc validate subscriptions --app "APP_ID"
+  asc validate subscriptions --app "APP_ID" --group-id "GROUP_ID" --output table
+  asc validate subscriptions --app "APP_ID" --strict`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+
+			client, err := clientFactory()
+			if err != nil {
+				return fmt.Errorf("validate subscriptions: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			primaryLocale, err := fetchPrimaryLocale(requestCtx, client, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("validate subscriptions: %w", err)
+			}
+
+			var groups []asc.Resource[asc.SubscriptionGroupAttributes]
+			if id := strings.TrimSpace(*groupID); id != "" {
+				resp, err := client.GetSubscriptionGroup(requestCtx, id)
+				if err != nil {
+					return fmt.Errorf("validate subscriptions: failed to fetch subscription group: %w", err)
+				}
+				groups = append(groups, resp.Data)
+			} else {
+				firstPage, err := client.GetSubscriptionGroups(requestCtx, resolvedAppID, asc.WithSubscriptionGroupsLimit(200))
+				if err != nil {
+					return fmt.Errorf("validate subscriptions: failed to fetch subscription groups: %w", err)
+				}
+				all, err := asc.PaginateAll(requestCtx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+					return client.GetSubscriptionGroups(ctx, resolvedAppID, asc.WithSubscriptionGroupsNextURL(nextURL))
+				})
+				if err != nil {
+					return fmt.Errorf("validate subscriptions: %w", err)
+				}
+				resp, ok := all.(*asc.SubscriptionGroupsResponse)
+				if !ok {
+					return fmt.Errorf("validate subscriptions: unexpected subscription groups response")
+				}
+				groups = resp.Data
+			}
+
+			input := validation.SubscriptionInput{AppID: resolvedAppID, PrimaryLocale: primaryLocale}
+			for _, group := range groups {
+				item, err := fetchSubscriptionGroupValidation(requestCtx, client, group)
+				if err != nil {
+					return fmt.Errorf("validate subscriptions: %w", err)
+				}
+				input.Groups = append(input.Groups, item)
+			}
+
+			report := validation.ValidateSubscriptions(input, *strict)
+			return printProductReport(&report, *output, *pretty, "validate subscriptions")
+		},
+	}
+}
+
+func printProductReport(report *validation.ProductReport, output string, pretty bool, command string) error {
+	if err := shared.PrintOutput(report, output, pretty); err != nil {
+		return err
+	}
+	if report.Summary.Blocking > 0 {
+		return shared.NewReportedError(fmt.Errorf("%s: found %d blocking issue(s)", command, report.Summary.Blocking))
+	}
+	return nil
+}
+
+func fetchPrimaryLocale(ctx context.Context, client *asc.Client, appID string) (string, error) {
+	resp, err := client.GetApp(ctx, appID)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch app: %w", err)
+	}
+	return resp.Data.Attributes.PrimaryLocale, nil
+}
+
+func fetchIAPValidation(ctx context.Context, client *asc.Client, iap asc.Resource[asc.InAppPurchaseV2Attributes]) (validation.InAppPurchase, error) {
+	item := validation.InAppPurchase{
+		ID:        iap.ID,
+		Name:      iap.Attributes.Name,
+		ProductID: iap.Attributes.ProductID,
+		Type:      iap.Attributes.InAppPurchaseType,
+		State:     iap.Attributes.State,
+	}
+
+	locs, err := client.GetInAppPurchaseLocalizations(ctx, iap.ID, asc.WithIAPLocalizationsLimit(200))
+	if err != nil {
+		return item, fmt.Errorf("failed to fetch localizations for %s: %w", iap.ID, err)
+	}
+	for _, loc := range locs.Data {
+		item.Localizations = append(item.Localizations, validation.ProductLocalization{
+			ID:          loc.ID,
+			Locale:      loc.Attributes.Locale,
+			Name:        loc.Attributes.Name,
+			Description: loc.Attributes.Description,
+		})
+	}
+
+	screenshot, err := client.GetInAppPurchaseAppStoreReviewScreenshotForIAP(ctx, iap.ID)
+	if err != nil && !asc.IsNotFound(err) {
+		return item, fmt.Errorf("failed to fetch review screenshot for %s: %w", iap.ID, err)
+	}
+	item.HasReviewScreenshot = err == nil && screenshot.Data.ID != ""
+
+	schedule, err := client.GetInAppPurchasePriceSchedule(ctx, iap.ID)
+	if err != nil && !asc.IsNotFound(err) {
+		return item, fmt.Errorf("failed to fetch price schedule for %s: %w", iap.ID, err)
+	}
+	if err == nil && schedule.Data.ID != "" {
+		item.PriceScheduleID = schedule.Data.ID
+		prices, err := client.GetInAppPurchasePriceScheduleManualPrices(ctx, schedule.Data.ID, asc.WithIAPPriceSchedulePricesLimit(1))
+		if err != nil && !asc.IsNotFound(err) {
+			return item, fmt.Errorf("failed to fetch prices for %s: %w", iap.ID, err)
+		}
+		if err == nil {
+			item.ManualPriceCount = len(prices.Data)
+		}
+	}
+
+	availability, err := client.GetInAppPurchaseAvailability(ctx, iap.ID)
+	if err != nil && !asc.IsNotFound(err) {
+		return item, fmt.Errorf("failed to fetch availability for %s: %w", iap.ID, err)
+	}
+	if err == nil && availability.Data.ID != "" {
+		territories, err := client.GetInAppPurchaseAvailabilityAvailableTerritories(ctx, availability.Data.ID, asc.WithIAPAvailabilityTerritoriesLimit(200))
+		if err != nil {
+			return item, fmt.Errorf("failed to fetch available territories for %s: %w", iap.ID, err)
+		}
+		item.Availability = &validation.ProductAvailability{ID: availability.Data.ID, TerritoryCount: len(territories.Data)}
+	}
+
+	return item, nil
+}
+
+func fetchSubscriptionGroupValidation(ctx context.Context, client *asc.Client, group asc.Resource[asc.SubscriptionGroupAttributes]) (validation.SubscriptionGroup, error) {
+	item := validation.SubscriptionGroup{ID: group.ID, ReferenceName: group.Attributes.ReferenceName}
+
+	locs, err := client.GetSubscriptionGroupLocalizations(ctx, group.ID, asc.WithSubscriptionGroupLocalizationsLimit(200))
+	if err != nil {
+		return item, fmt.Errorf("failed to fetch localizations for group %s: %w", group.ID, err)
+	}
+	for _, loc := range locs.Data {
+		item.Localizations = append(item.Localizations, validation.ProductLocalization{
+			ID:     loc.ID,
+			Locale: loc.Attributes.Locale,
+			Name:   loc.Attributes.Name,
+		})
+	}
+
+	firstPage, err := client.GetSubscriptions(ctx, group.ID, asc.WithSubscriptionsLimit(200))
+	if err != nil {
+		return item, fmt.Errorf("failed to fetch subscriptions for group %s: %w", group.ID, err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetSubscriptions(ctx, group.ID, asc.WithSubscriptionsNextURL(nextURL))
+	})
+	if err != nil {
+		return item, err
+	}
+	subs, ok := all.(*asc.SubscriptionsResponse)
+	if !ok {
+		return item, fmt.Errorf("unexpected subscriptions response for group %s", group.ID)
+	}
+	for _, sub := range subs.Data {
+		subItem, err := fetchSubscriptionValidation(ctx, client, sub)
+		if err != nil {
+			return item, err
+		}
+		item.Subscriptions = append(item.Subscriptions, subItem)
+	}
+	return item, nil
+}
+
+func fetchSubscriptionValidation(ctx context.Context, client *asc.Client, sub asc.Resource[asc.SubscriptionAttributes]) (validation.Subscription, error) {
+	item := validation.Subscription{
+		ID:        sub.ID,
+		Name:      sub.Attributes.Name,
+		ProductID: sub.Attributes.ProductID,
+		State:     sub.Attributes.State,
+		Period:    sub.Attributes.SubscriptionPeriod,
+	}
+
+	locs, err := client.GetSubscriptionLocalizations(ctx, sub.ID, asc.WithSubscriptionLocalizationsLimit(200))
+	if err != nil {
+		return item, fmt.Errorf("failed to fetch localizations for %s: %w", sub.ID, err)
+	}
+	for _, loc := range locs.Data {
+		item.Localizations = append(item.Localizations, validation.ProductLocalization{
+			ID:          loc.ID,
+			Locale:      loc.Attributes.Locale,
+			Name:        loc.Attributes.Name,
+			Description: loc.Attributes.Description,
+		})
+	}
+
+	screenshot, err := client.GetSubscriptionAppStoreReviewScreenshotForSubscription(ctx, sub.ID)
+	if err != nil && !asc.IsNotFound(err) {
+		return item, fmt.Errorf("failed to fetch review screenshot for %s: %w", sub.ID, err)
+	}
+	item.HasReviewScreenshot = err == nil && screenshot.Data.ID != ""
+
+	prices, err := client.GetSubscriptionPrices(ctx, sub.ID, asc.WithSubscriptionPricesLimit(1))
+	if err != nil && !asc.IsNotFound(err) {
+		return item, fmt.Errorf("failed to fetch prices for %s: %w", sub.ID, err)
+	}
+	if err == nil {
+		item.PriceCount = len(prices.Data)
+	}
+
+	availability, err := client.GetSubscriptionAvailabilityForSubscription(ctx, sub.ID)
+	if err != nil && !asc.IsNotFound(err) {
+		return item, fmt.Errorf("failed to fetch availability for %s: %w", sub.ID, err)
+	}
+	if err == nil && availability.Data.ID != "" {
+		territories, err := client.GetSubscriptionAvailabilityAvailableTerritories(ctx, availability.Data.ID, asc.WithSubscriptionAvailabilityTerritoriesLimit(200))
+		if err != nil {
+			return item, fmt.Errorf("failed to fetch available territories for %s: %w", sub.ID, err)
+		}
+		item.Availability = &validation.ProductAvailability{ID: availability.Data.ID, TerritoryCount: len(territories.Data)}
+	}
+
+	return item, nil
+}
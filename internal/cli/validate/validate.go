@@ -2,8 +2,12 @@ package validate
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
@@ -15,12 +19,15 @@ type validateOptions struct {
 	VersionID string
 	Platform  string
 	Strict    bool
+	Rules     []validation.Rule
 	Output    string
 	Pretty    bool
 }
 
 var clientFactory = shared.GetASCClient
 
+const urlProbeTimeout = 10 * time.Second
+
 func runValidate(ctx context.Context, opts validateOptions) error {
 	client, err := clientFactory()
 	if err != nil {
@@ -116,6 +123,8 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 		AppInfoLocalizations: appInfoLocalizations,
 		ScreenshotSets:       screenshotSets,
 		AgeRatingDeclaration: ageRatingDecl,
+		Rules:                opts.Rules,
+		ProbeURL:             probeURL(ctx),
 	}, opts.Strict)
 
 	if err := shared.PrintOutput(&report, opts.Output, opts.Pretty); err != nil {
@@ -129,6 +138,51 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 	return nil
 }
 
+// loadRules reads custom rules from path, or from the default location when
+// path is empty and the file exists.
+func loadRules(path string) ([]validation.Rule, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		if _, err := os.Stat(validation.DefaultRulesPath); errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		path = validation.DefaultRulesPath
+	}
+	file, err := validation.LoadRuleFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rules: %w", err)
+	}
+	return file.Rules, nil
+}
+
+// probeURL returns a reachability check for url_pattern rules. Servers that
+// reject HEAD are retried with GET.
+func probeURL(ctx context.Context) func(string) error {
+	client := &http.Client{Timeout: urlProbeTimeout}
+	return func(rawURL string) error {
+		var lastErr error
+		for _, method := range []string{http.MethodHead, http.MethodGet} {
+			req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+			if err != nil {
+				return err
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				return err
+			}
+			resp.Body.Close()
+			if resp.StatusCode < 400 {
+				return nil
+			}
+			lastErr = fmt.Errorf("HTTP %d", resp.StatusCode)
+			if resp.StatusCode != http.StatusMethodNotAllowed {
+				break
+			}
+		}
+		return lastErr
+	}
+}
+
 func fetchScreenshotSets(ctx context.Context, client *asc.Client, localizations []asc.Resource[asc.AppStoreVersionLocalizationAttributes]) ([]validation.ScreenshotSet, error) {
 	var sets []validation.ScreenshotSet
 	for _, loc := range localizations {
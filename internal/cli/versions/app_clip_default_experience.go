@@ -39,8 +39,9 @@ func VersionsAppClipDefaultExperienceGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app-clip-default-experience get", flag.ExitOnError)
 
 	versionID := fs.String("version-id", "", "App Store version ID")
-	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
 	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+	shared.ResolveNames(fs, shared.ResourceVersion, "version-id")
 
 	return &ffcli.Command{
 		Name:       "get",
@@ -0,0 +1,177 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	trackBuilds            = "builds"
+	trackVersions          = "versions"
+	trackReviewSubmissions = "review-submissions"
+	trackBetaReviews       = "beta-reviews"
+	trackXcodeCloud        = "xcode-cloud"
+)
+
+// watchTracks lists every supported track in poll order.
+var watchTracks = []string{
+	trackBuilds,
+	trackVersions,
+	trackReviewSubmissions,
+	trackBetaReviews,
+	trackXcodeCloud,
+}
+
+// watchFetcher returns the current items for one track.
+type watchFetcher func(ctx context.Context, track string) ([]watchItem, error)
+
+// newClientFetcher returns a fetcher backed by the App Store Connect API.
+func newClientFetcher(client *asc.Client, appID string, limit int) watchFetcher {
+	return func(ctx context.Context, track string) ([]watchItem, error) {
+		switch track {
+		case trackBuilds:
+			return fetchBuilds(ctx, client, appID, limit)
+		case trackVersions:
+			return fetchVersions(ctx, client, appID, limit)
+		case trackReviewSubmissions:
+			return fetchReviewSubmissions(ctx, client, appID, limit)
+		case trackBetaReviews:
+			return fetchBetaReviews(ctx, client, appID, limit)
+		case trackXcodeCloud:
+			return fetchXcodeCloudRuns(ctx, client, appID, limit)
+		default:
+			return nil, fmt.Errorf("unsupported track %q", track)
+		}
+	}
+}
+
+func fetchBuilds(ctx context.Context, client *asc.Client, appID string, limit int) ([]watchItem, error) {
+	resp, err := client.GetBuilds(ctx, appID, asc.WithBuildsSort("-uploadedDate"), asc.WithBuildsLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	items := make([]watchItem, 0, len(resp.Data))
+	for _, build := range resp.Data {
+		state := build.Attributes.ProcessingState
+		if build.Attributes.Expired {
+			state = "EXPIRED"
+		}
+		items = append(items, watchItem{
+			Track: trackBuilds,
+			ID:    build.ID,
+			Label: "build " + build.Attributes.Version,
+			State: state,
+		})
+	}
+	return items, nil
+}
+
+func fetchVersions(ctx context.Context, client *asc.Client, appID string, limit int) ([]watchItem, error) {
+	resp, err := client.GetAppStoreVersions(ctx, appID, asc.WithAppStoreVersionsLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	items := make([]watchItem, 0, len(resp.Data))
+	for _, version := range resp.Data {
+		state := version.Attributes.AppVersionState
+		if state == "" {
+			state = version.Attributes.AppStoreState
+		}
+		items = append(items, watchItem{
+			Track: trackVersions,
+			ID:    version.ID,
+			Label: strings.TrimSpace(string(version.Attributes.Platform) + " " + version.Attributes.VersionString),
+			State: state,
+		})
+	}
+	return items, nil
+}
+
+func fetchReviewSubmissions(ctx context.Context, client *asc.Client, appID string, limit int) ([]watchItem, error) {
+	resp, err := client.GetReviewSubmissions(ctx, appID, asc.WithReviewSubmissionsLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	items := make([]watchItem, 0, len(resp.Data))
+	for _, submission := range resp.Data {
+		items = append(items, watchItem{
+			Track: trackReviewSubmissions,
+			ID:    submission.ID,
+			Label: strings.TrimSpace(string(submission.Attributes.Platform) + " submission"),
+			State: string(submission.Attributes.SubmissionState),
+		})
+	}
+	return items, nil
+}
+
+// fetchBetaReviews reports beta app review submissions for the most recent
+// builds, since submissions can only be listed by build.
+func fetchBetaReviews(ctx context.Context, client *asc.Client, appID string, limit int) ([]watchItem, error) {
+	builds, err := client.GetBuilds(ctx, appID, asc.WithBuildsSort("-uploadedDate"), asc.WithBuildsLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	if len(builds.Data) == 0 {
+		return nil, nil
+	}
+	buildIDs := make([]string, 0, len(builds.Data))
+	buildLabels := make(map[string]string, len(builds.Data))
+	for _, build := range builds.Data {
+		buildIDs = append(buildIDs, build.ID)
+		buildLabels[build.ID] = "build " + build.Attributes.Version
+	}
+
+	resp, err := client.GetBetaAppReviewSubmissions(ctx,
+		asc.WithBetaAppReviewSubmissionsBuildIDs(buildIDs),
+		asc.WithBetaAppReviewSubmissionsLimit(limit),
+	)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]watchItem, 0, len(resp.Data))
+	for _, submission := range resp.Data {
+		label := buildLabels[submission.ID]
+		if label == "" {
+			label = "beta review " + submission.ID
+		}
+		items = append(items, watchItem{
+			Track: trackBetaReviews,
+			ID:    submission.ID,
+			Label: label,
+			State: submission.Attributes.BetaReviewState,
+		})
+	}
+	return items, nil
+}
+
+// fetchXcodeCloudRuns reports build runs of the app's Xcode Cloud product.
+// Apps without a product have nothing to report.
+func fetchXcodeCloudRuns(ctx context.Context, client *asc.Client, appID string, limit int) ([]watchItem, error) {
+	products, err := client.GetCiProducts(ctx, asc.WithCiProductsAppID(appID))
+	if err != nil {
+		return nil, err
+	}
+	var items []watchItem
+	for _, product := range products.Data {
+		runs, err := client.GetCiProductBuildRuns(ctx, product.ID, asc.WithCiBuildRunsLimit(limit))
+		if err != nil {
+			return nil, err
+		}
+		for _, run := range runs.Data {
+			state := string(run.Attributes.ExecutionProgress)
+			if asc.IsBuildRunComplete(run.Attributes.ExecutionProgress) && run.Attributes.CompletionStatus != "" {
+				state = string(run.Attributes.CompletionStatus)
+			}
+			items = append(items, watchItem{
+				Track: trackXcodeCloud,
+				ID:    run.ID,
+				Label: fmt.Sprintf("run #%d", run.Attributes.Number),
+				State: state,
+			})
+		}
+	}
+	return items, nil
+}
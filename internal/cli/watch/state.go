@@ -0,0 +1,154 @@
+package watch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+const (
+	watchStateDirName = "watch"
+	watchStateVersion = 1
+)
+
+// watchItem is one observed resource and its current state.
+type watchItem struct {
+	Track string `json:"track"`
+	ID    string `json:"id"`
+	Label string `json:"label,omitempty"`
+	State string `json:"state"`
+}
+
+func (i watchItem) key() string {
+	return i.Track + "/" + i.ID
+}
+
+// watchState is the persisted last-seen state for one app.
+type watchState struct {
+	Version   int                  `json:"version"`
+	AppID     string               `json:"appId"`
+	UpdatedAt time.Time            `json:"updatedAt"`
+	Tracks    map[string]time.Time `json:"tracks"`
+	Items     map[string]watchItem `json:"items"`
+
+	path string
+}
+
+// defaultStatePath returns the state file used when --state-file is not set.
+func defaultStatePath(appID string) (string, error) {
+	path, err := config.GlobalPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(path), watchStateDirName, appID+".json"), nil
+}
+
+// loadWatchState reads a state file. A missing file yields an empty state.
+func loadWatchState(path, appID string) (*watchState, error) {
+	state := &watchState{
+		Version: watchStateVersion,
+		AppID:   appID,
+		Tracks:  map[string]time.Time{},
+		Items:   map[string]watchItem{},
+		path:    path,
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read state file: %w", err)
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("parse state file %s: %w", path, err)
+	}
+	if state.AppID != "" && state.AppID != appID {
+		return nil, fmt.Errorf("state file %s belongs to app %s, not %s", path, state.AppID, appID)
+	}
+	state.AppID = appID
+	if state.Tracks == nil {
+		state.Tracks = map[string]time.Time{}
+	}
+	if state.Items == nil {
+		state.Items = map[string]watchItem{}
+	}
+	return state, nil
+}
+
+// save writes the state file atomically so overlapping cron runs never see a
+// partial file.
+func (s *watchState) save() error {
+	s.Version = watchStateVersion
+	s.UpdatedAt = time.Now().UTC()
+
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create state directory: %w", err)
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode state file: %w", err)
+	}
+	tempFile, err := os.CreateTemp(dir, ".watch-state-*")
+	if err != nil {
+		return fmt.Errorf("write state file: %w", err)
+	}
+	tempName := tempFile.Name()
+	if _, err := tempFile.Write(data); err != nil {
+		_ = tempFile.Close()
+		_ = os.Remove(tempName)
+		return fmt.Errorf("write state file: %w", err)
+	}
+	if err := tempFile.Close(); err != nil {
+		_ = os.Remove(tempName)
+		return fmt.Errorf("write state file: %w", err)
+	}
+	if err := os.Rename(tempName, s.path); err != nil {
+		_ = os.Remove(tempName)
+		return fmt.Errorf("write state file: %w", err)
+	}
+	return nil
+}
+
+// apply records the items observed for a track and returns a transition for
+// every item whose state changed. A track seen for the first time only seeds
+// the state unless emitInitial is set; items that appear later are reported
+// with an empty From. Items of the track that were not observed are pruned,
+// so the state only holds what the latest poll returned.
+func (s *watchState) apply(track string, items []watchItem, now time.Time, emitInitial bool) []watchEvent {
+	_, seeded := s.Tracks[track]
+	observed := make(map[string]bool, len(items))
+	var events []watchEvent
+	for _, item := range items {
+		observed[item.key()] = true
+		previous, known := s.Items[item.key()]
+		s.Items[item.key()] = item
+		if known && previous.State == item.State {
+			continue
+		}
+		if !known && !seeded && !emitInitial {
+			continue
+		}
+		events = append(events, watchEvent{
+			AppID:      s.AppID,
+			Track:      track,
+			ID:         item.ID,
+			Label:      item.Label,
+			From:       previous.State,
+			To:         item.State,
+			ObservedAt: now.UTC().Format(time.RFC3339),
+		})
+	}
+	for key, item := range s.Items {
+		if item.Track == track && !observed[key] {
+			delete(s.Items, key)
+		}
+	}
+	s.Tracks[track] = now.UTC()
+	return events
+}
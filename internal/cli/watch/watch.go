@@ -0,0 +1,233 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	watchDefaultLimit = 10
+	watchMaxLimit     = 200
+)
+
+// watchEvent is one state transition, emitted as an NDJSON line.
+type watchEvent struct {
+	AppID      string `json:"appId"`
+	Track      string `json:"track"`
+	ID         string `json:"id"`
+	Label      string `json:"label,omitempty"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+	ObservedAt string `json:"observedAt"`
+}
+
+// WatchCommand returns the watch command.
+func WatchCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+
+	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	tracks := fs.String("track", strings.Join(watchTracks, ","), "What to watch (comma-separated): "+strings.Join(watchTracks, ", "))
+	stateFile := fs.String("state-file", "", "Path to the state file (default: ~/.asc/watch/<app-id>.json)")
+	execCommand := fs.String("exec", "", "Shell command to run per transition (event JSON on stdin)")
+	interval := fs.Duration("interval", 0, "Poll repeatedly at this interval (default: poll once and exit)")
+	limit := fs.Int("limit", watchDefaultLimit, "Most recent items to check per track (1-200)")
+	emitInitial := fs.Bool("emit-initial", false, "Emit events for items seen on the first poll of a track")
+	shared.ResolveNames(fs, shared.ResourceApp, "app")
+
+	return &ffcli.Command{
+		Name:       "watch",
+		ShortUsage: "asc watch --app APP_ID [flags]",
+		ShortHelp:  "Emit events when build, review, or version states change.",
+		LongHelp: `Emit events when build, review, or version states change.
+
+Polls the most recent builds, App Store versions, review submissions, beta
+app review submissions, and Xcode Cloud build runs of an app, compares them
+with the last seen states in a local state file, and emits one NDJSON event
+per transition to stdout. Nothing is emitted when no state changed, so the
+command can run from cron.
+
+The first poll of a track only records the current states unless
+--emit-initial is set. Items that appear later are reported with an empty
+"from". With --exec, the command runs through the shell with the event JSON
+on stdin and ASC_WATCH_APP_ID, ASC_WATCH_TRACK, ASC_WATCH_ID,
+ASC_WATCH_LABEL, ASC_WATCH_FROM, and ASC_WATCH_TO set.
+
+Without --interval the command polls once and exits non-zero if any track
+failed. With --interval it polls until interrupted and reports failures on
+stderr.
+
+Examples:
+  asc watch --app "123456789"
+  asc watch --app "123456789" --track builds,beta-reviews --exec ./on-change.sh
+  asc watch --app "123456789" --interval 5m --state-file ./watch-state.json
+  asc watch --app "123456789" --exec 'asc notify slack --message "$ASC_WATCH_LABEL: $ASC_WATCH_TO"'`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
+			}
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+			selectedTracks, err := parseWatchTracks(*tracks)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			if *limit < 1 || *limit > watchMaxLimit {
+				return shared.UsageErrorf("--limit must be between 1 and %d", watchMaxLimit)
+			}
+			if *interval < 0 {
+				return shared.UsageError("--interval must not be negative")
+			}
+
+			path := strings.TrimSpace(*stateFile)
+			if path == "" {
+				path, err = defaultStatePath(resolvedAppID)
+				if err != nil {
+					return fmt.Errorf("watch: %w", err)
+				}
+			}
+			state, err := loadWatchState(path, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("watch: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("watch: %w", err)
+			}
+
+			w := &watcher{
+				state:       state,
+				tracks:      selectedTracks,
+				fetch:       newClientFetcher(client, resolvedAppID, *limit),
+				execCommand: strings.TrimSpace(*execCommand),
+				emitInitial: *emitInitial,
+				out:         os.Stdout,
+			}
+			// Every poll must see fresh state, so bypass the response cache.
+			ctx = asc.WithoutResponseCache(ctx)
+			if *interval == 0 {
+				return w.poll(ctx)
+			}
+			return w.run(ctx, *interval)
+		},
+	}
+}
+
+// parseWatchTracks validates a comma-separated track list.
+func parseWatchTracks(value string) ([]string, error) {
+	supported := make(map[string]bool, len(watchTracks))
+	for _, track := range watchTracks {
+		supported[track] = true
+	}
+	var tracks []string
+	seen := map[string]bool{}
+	for _, track := range shared.SplitCSV(value) {
+		track = strings.ToLower(track)
+		if !supported[track] {
+			return nil, fmt.Errorf("--track must be one of: %s", strings.Join(watchTracks, ", "))
+		}
+		if !seen[track] {
+			seen[track] = true
+			tracks = append(tracks, track)
+		}
+	}
+	if len(tracks) == 0 {
+		return nil, fmt.Errorf("--track is required")
+	}
+	return tracks, nil
+}
+
+// watcher polls tracks and dispatches transitions.
+type watcher struct {
+	state       *watchState
+	tracks      []string
+	fetch       watchFetcher
+	execCommand string
+	emitInitial bool
+	out         io.Writer
+}
+
+// run polls at interval until ctx is canceled or the process is interrupted.
+func (w *watcher) run(ctx context.Context, interval time.Duration) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := w.poll(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll checks every track once, emits transitions, and saves the state.
+// Tracks that fail keep their previous state.
+func (w *watcher) poll(ctx context.Context) error {
+	var errs []error
+	for _, track := range w.tracks {
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		items, err := w.fetch(requestCtx, track)
+		cancel()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("watch: %s: %w", track, err))
+			continue
+		}
+		for _, event := range w.state.apply(track, items, time.Now(), w.emitInitial) {
+			w.emit(ctx, event)
+		}
+	}
+	if err := w.state.save(); err != nil {
+		errs = append(errs, fmt.Errorf("watch: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+func (w *watcher) emit(ctx context.Context, event watchEvent) {
+	line, err := json.Marshal(event)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to encode event for %s %s: %v\n", event.Track, event.ID, err)
+		return
+	}
+	fmt.Fprintln(w.out, string(line))
+	if w.execCommand == "" {
+		return
+	}
+	env := []string{
+		"ASC_WATCH_APP_ID=" + event.AppID,
+		"ASC_WATCH_TRACK=" + event.Track,
+		"ASC_WATCH_ID=" + event.ID,
+		"ASC_WATCH_LABEL=" + event.Label,
+		"ASC_WATCH_FROM=" + event.From,
+		"ASC_WATCH_TO=" + event.To,
+	}
+	if err := shared.RunHookCommand(ctx, w.execCommand, append(line, '\n'), env); err != nil {
+		fmt.Fprintf(os.Stderr, "%s %s: --exec failed: %v\n", event.Track, event.ID, err)
+	}
+}
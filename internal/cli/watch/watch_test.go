@@ -0,0 +1,182 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWatchStateApplySeedsFirstPollSilently(t *testing.T) {
+	state, err := loadWatchState(filepath.Join(t.TempDir(), "state.json"), "app-1")
+	if err != nil {
+		t.Fatalf("loadWatchState() error: %v", err)
+	}
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	events := state.apply(trackBuilds, []watchItem{
+		{Track: trackBuilds, ID: "b1", Label: "build 1", State: "PROCESSING"},
+	}, now, false)
+	if len(events) != 0 {
+		t.Fatalf("expected no events on first poll, got %+v", events)
+	}
+
+	events = state.apply(trackBuilds, []watchItem{
+		{Track: trackBuilds, ID: "b1", Label: "build 1", State: "PROCESSING"},
+	}, now, false)
+	if len(events) != 0 {
+		t.Fatalf("expected no events for unchanged state, got %+v", events)
+	}
+
+	events = state.apply(trackBuilds, []watchItem{
+		{Track: trackBuilds, ID: "b2", Label: "build 2", State: "PROCESSING"},
+		{Track: trackBuilds, ID: "b1", Label: "build 1", State: "VALID"},
+	}, now, false)
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %+v", events)
+	}
+	if events[0].ID != "b2" || events[0].From != "" || events[0].To != "PROCESSING" {
+		t.Fatalf("unexpected new item event: %+v", events[0])
+	}
+	if events[1].ID != "b1" || events[1].From != "PROCESSING" || events[1].To != "VALID" {
+		t.Fatalf("unexpected transition event: %+v", events[1])
+	}
+	if events[1].AppID != "app-1" || events[1].ObservedAt != "2026-03-01T12:00:00Z" {
+		t.Fatalf("unexpected event metadata: %+v", events[1])
+	}
+}
+
+func TestWatchStateApplyEmitInitial(t *testing.T) {
+	state, err := loadWatchState(filepath.Join(t.TempDir(), "state.json"), "app-1")
+	if err != nil {
+		t.Fatalf("loadWatchState() error: %v", err)
+	}
+	events := state.apply(trackVersions, []watchItem{
+		{Track: trackVersions, ID: "v1", State: "PREPARE_FOR_SUBMISSION"},
+	}, time.Now(), true)
+	if len(events) != 1 || events[0].From != "" || events[0].To != "PREPARE_FOR_SUBMISSION" {
+		t.Fatalf("expected initial event, got %+v", events)
+	}
+}
+
+func TestWatchStateApplyPrunesItemsNoLongerReturned(t *testing.T) {
+	state, err := loadWatchState(filepath.Join(t.TempDir(), "state.json"), "app-1")
+	if err != nil {
+		t.Fatalf("loadWatchState() error: %v", err)
+	}
+	state.apply(trackVersions, []watchItem{{Track: trackVersions, ID: "v1", State: "READY_FOR_SALE"}}, time.Now(), false)
+	state.apply(trackBuilds, []watchItem{
+		{Track: trackBuilds, ID: "b1", State: "VALID"},
+		{Track: trackBuilds, ID: "b2", State: "VALID"},
+	}, time.Now(), false)
+
+	state.apply(trackBuilds, []watchItem{{Track: trackBuilds, ID: "b2", State: "VALID"}}, time.Now(), false)
+
+	if _, ok := state.Items[trackBuilds+"/b1"]; ok {
+		t.Fatalf("expected b1 to be pruned, got %+v", state.Items)
+	}
+	if len(state.Items) != 2 {
+		t.Fatalf("expected b2 and the other track's item to remain, got %+v", state.Items)
+	}
+}
+
+func TestWatchStateSaveAndLoadRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "state.json")
+	state, err := loadWatchState(path, "app-1")
+	if err != nil {
+		t.Fatalf("loadWatchState() error: %v", err)
+	}
+	state.apply(trackBuilds, []watchItem{{Track: trackBuilds, ID: "b1", State: "VALID"}}, time.Now(), false)
+	if err := state.save(); err != nil {
+		t.Fatalf("save() error: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat state file: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Fatalf("expected state file mode 0600, got %o", perm)
+	}
+
+	loaded, err := loadWatchState(path, "app-1")
+	if err != nil {
+		t.Fatalf("loadWatchState() error: %v", err)
+	}
+	if loaded.Items["builds/b1"].State != "VALID" {
+		t.Fatalf("expected persisted item, got %+v", loaded.Items)
+	}
+	if _, ok := loaded.Tracks[trackBuilds]; !ok {
+		t.Fatalf("expected builds track to be marked seeded")
+	}
+
+	if _, err := loadWatchState(path, "app-2"); err == nil || !strings.Contains(err.Error(), "belongs to app app-1") {
+		t.Fatalf("expected app mismatch error, got %v", err)
+	}
+}
+
+func TestParseWatchTracks(t *testing.T) {
+	tracks, err := parseWatchTracks("Builds, beta-reviews,builds")
+	if err != nil {
+		t.Fatalf("parseWatchTracks() error: %v", err)
+	}
+	if strings.Join(tracks, ",") != "builds,beta-reviews" {
+		t.Fatalf("unexpected tracks: %v", tracks)
+	}
+	if _, err := parseWatchTracks("builds,unknown"); err == nil {
+		t.Fatal("expected error for unknown track")
+	}
+	if _, err := parseWatchTracks(" , "); err == nil {
+		t.Fatal("expected error for empty track list")
+	}
+}
+
+func TestWatcherPollKeepsStateOfFailedTracks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	state, err := loadWatchState(path, "app-1")
+	if err != nil {
+		t.Fatalf("loadWatchState() error: %v", err)
+	}
+	state.apply(trackBuilds, []watchItem{{Track: trackBuilds, ID: "b1", State: "PROCESSING"}}, time.Now(), false)
+	state.apply(trackVersions, []watchItem{{Track: trackVersions, ID: "v1", State: "READY_FOR_REVIEW"}}, time.Now(), false)
+
+	var out bytes.Buffer
+	w := &watcher{
+		state:  state,
+		tracks: []string{trackBuilds, trackVersions},
+		fetch: func(ctx context.Context, track string) ([]watchItem, error) {
+			if track == trackVersions {
+				return nil, errors.New("boom")
+			}
+			return []watchItem{{Track: trackBuilds, ID: "b1", Label: "build 1", State: "VALID"}}, nil
+		},
+		out: &out,
+	}
+	err = w.poll(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "watch: versions: boom") {
+		t.Fatalf("expected versions error, got %v", err)
+	}
+
+	var event watchEvent
+	if err := json.Unmarshal(out.Bytes(), &event); err != nil {
+		t.Fatalf("unmarshal event: %v (output %q)", err, out.String())
+	}
+	if event.Track != trackBuilds || event.From != "PROCESSING" || event.To != "VALID" || event.Label != "build 1" {
+		t.Fatalf("unexpected event: %+v", event)
+	}
+
+	loaded, err := loadWatchState(path, "app-1")
+	if err != nil {
+		t.Fatalf("loadWatchState() error: %v", err)
+	}
+	if loaded.Items["builds/b1"].State != "VALID" {
+		t.Fatalf("expected build state to be saved, got %+v", loaded.Items["builds/b1"])
+	}
+	if loaded.Items["versions/v1"].State != "READY_FOR_REVIEW" {
+		t.Fatalf("expected failed track to keep its state, got %+v", loaded.Items["versions/v1"])
+	}
+}
@@ -0,0 +1,310 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notify"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	webhookSecretEnvVar       = "ASC_WEBHOOK_SECRET"
+	webhookServeMaxBodyBytes  = 1 << 20
+	webhookServeShutdownGrace = 5 * time.Second
+	webhookServeQueueSize     = 64
+)
+
+// WebhooksServeCommand returns the webhooks serve subcommand.
+func WebhooksServeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+
+	addr := fs.String("addr", "127.0.0.1:8790", "Address to listen on")
+	path := fs.String("path", "/", "URL path that receives deliveries")
+	secret := fs.String("secret", "", "Webhook secret used to verify signatures (or "+webhookSecretEnvVar+" env var)")
+	events := fs.String("events", "", "Only dispatch these event types (comma-separated)")
+	execCommand := fs.String("exec", "", "Shell command to run per event (event JSON on stdin)")
+	slackWebhook := fs.String("slack-webhook", "", "Slack webhook URL to notify per event")
+	teamsWebhook := fs.String("teams-webhook", "", "Microsoft Teams webhook URL to notify per event")
+	discordWebhook := fs.String("discord-webhook", "", "Discord webhook URL to notify per event")
+	notifyURL := fs.String("notify-url", "", "Webhook URL that receives {\"title\",\"text\"} per event")
+	emailTo := fs.String("email-to", "", "Email recipients to notify per event, comma-separated (SMTP settings from ASC_SMTP_* or config)")
+	quiet := fs.Bool("quiet", false, "Do not stream events to stdout as NDJSON")
+
+	return &ffcli.Command{
+		Name:       "serve",
+		ShortUsage: "asc webhooks serve --secret SECRET [flags]",
+		ShortHelp:  "Receive webhook deliveries and dispatch events.",
+		LongHelp: `Receive webhook deliveries and dispatch events.
+
+Starts an HTTP server that verifies the X-Apple-Signature HMAC of each
+delivery with the webhook secret, decodes the event, and dispatches it.
+Events are streamed to stdout as NDJSON (one event per line) unless --quiet
+is set. With --exec, the command runs through the shell with the event JSON
+on stdin and ASC_WEBHOOK_EVENT_TYPE, ASC_WEBHOOK_EVENT_ID,
+ASC_WEBHOOK_RESOURCE_TYPE, ASC_WEBHOOK_RESOURCE_ID, ASC_WEBHOOK_OLD_VALUE,
+and ASC_WEBHOOK_NEW_VALUE set. With --slack-webhook, --teams-webhook,
+--discord-webhook, --notify-url, or --email-to, a summary is sent through the
+same providers as asc notify.
+
+Deliveries with a missing or invalid signature are rejected with 401.
+Accepted deliveries are answered with 200 and dispatched in order.
+
+Examples:
+  asc webhooks serve --secret "$WEBHOOK_SECRET"
+  asc webhooks serve --addr :8080 --path /asc --events BUILD_UPLOAD_STATE_UPDATED --exec ./on-build.sh
+  ASC_WEBHOOK_SECRET=secret asc webhooks serve --slack-webhook "$SLACK_WEBHOOK" --quiet
+  asc webhooks serve --secret "$WEBHOOK_SECRET" --teams-webhook "$TEAMS_WEBHOOK" --email-to team@example.com`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageErrorf("unexpected argument(s): %s", strings.Join(args, " "))
+			}
+			secretValue := strings.TrimSpace(*secret)
+			if secretValue == "" {
+				secretValue = strings.TrimSpace(os.Getenv(webhookSecretEnvVar))
+			}
+			if secretValue == "" {
+				return shared.UsageErrorf("--secret is required or set %s env var", webhookSecretEnvVar)
+			}
+			listenAddr := strings.TrimSpace(*addr)
+			if listenAddr == "" {
+				return shared.UsageError("--addr is required")
+			}
+			routePath := strings.TrimSpace(*path)
+			if !strings.HasPrefix(routePath, "/") {
+				return shared.UsageError("--path must start with /")
+			}
+			var filter map[asc.WebhookEventType]bool
+			if strings.TrimSpace(*events) != "" {
+				eventTypes, err := normalizeWebhookEvents(*events)
+				if err != nil {
+					return shared.UsageError(err.Error())
+				}
+				filter = make(map[asc.WebhookEventType]bool, len(eventTypes))
+				for _, eventType := range eventTypes {
+					filter[eventType] = true
+				}
+			}
+
+			targets := notify.Targets{
+				Slack:   strings.TrimSpace(*slackWebhook),
+				Teams:   strings.TrimSpace(*teamsWebhook),
+				Discord: strings.TrimSpace(*discordWebhook),
+				Webhook: strings.TrimSpace(*notifyURL),
+				Email:   shared.SplitCSV(*emailTo),
+			}
+			if err := targets.Validate(); err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			dispatcher := &webhookDispatcher{
+				filter:      filter,
+				execCommand: strings.TrimSpace(*execCommand),
+				targets:     targets,
+				stdout:      !*quiet,
+			}
+
+			listener, err := net.Listen("tcp", listenAddr)
+			if err != nil {
+				return fmt.Errorf("webhooks serve: %w", err)
+			}
+			fmt.Fprintf(os.Stderr, "Listening for webhook deliveries on http://%s%s\n", listener.Addr().String(), routePath)
+
+			return serveWebhooks(ctx, listener, routePath, secretValue, dispatcher)
+		},
+	}
+}
+
+// serveWebhooks serves until ctx is canceled or the process is interrupted,
+// then drains queued events.
+func serveWebhooks(ctx context.Context, listener net.Listener, routePath, secret string, dispatcher *webhookDispatcher) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	queue := make(chan *webhookDelivery, webhookServeQueueSize)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for delivery := range queue {
+			dispatcher.dispatch(context.WithoutCancel(ctx), delivery)
+		}
+	}()
+
+	mux := http.NewServeMux()
+	mux.Handle(routePath, newWebhookHandler(secret, queue))
+	httpServer := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- httpServer.Serve(listener)
+	}()
+
+	var serveErr error
+	select {
+	case err := <-errCh:
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErr = fmt.Errorf("webhooks serve: %w", err)
+		}
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), webhookServeShutdownGrace)
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			serveErr = fmt.Errorf("webhooks serve: shutdown: %w", err)
+		}
+		cancel()
+	}
+	close(queue)
+	wg.Wait()
+	return serveErr
+}
+
+type webhookDelivery struct {
+	event *asc.WebhookEvent
+	body  []byte
+}
+
+// newWebhookHandler verifies and decodes deliveries and queues them for
+// dispatch.
+func newWebhookHandler(secret string, queue chan<- *webhookDelivery) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, webhookServeMaxBodyBytes+1))
+		if err != nil {
+			http.Error(w, "failed to read body", http.StatusBadRequest)
+			return
+		}
+		if len(body) > webhookServeMaxBodyBytes {
+			http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		if err := asc.VerifyWebhookSignature(secret, body, r.Header.Get(asc.WebhookSignatureHeader)); err != nil {
+			fmt.Fprintf(os.Stderr, "Rejected delivery from %s: %v\n", r.RemoteAddr, err)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		event, err := asc.ParseWebhookEvent(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		select {
+		case queue <- &webhookDelivery{event: event, body: body}:
+			w.WriteHeader(http.StatusOK)
+		case <-r.Context().Done():
+			http.Error(w, "server busy", http.StatusServiceUnavailable)
+		}
+	})
+}
+
+// webhookDispatcher sends each accepted event to the configured targets.
+type webhookDispatcher struct {
+	filter      map[asc.WebhookEventType]bool
+	execCommand string
+	targets     notify.Targets
+	stdout      bool
+
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func (d *webhookDispatcher) dispatch(ctx context.Context, delivery *webhookDelivery) {
+	event := delivery.event
+	if d.filter != nil && !d.filter[event.Type] {
+		return
+	}
+	line, err := json.Marshal(event)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to encode event %s: %v\n", event.ID, err)
+		return
+	}
+	if d.stdout {
+		d.mu.Lock()
+		out := d.out
+		if out == nil {
+			out = os.Stdout
+		}
+		fmt.Fprintln(out, string(line))
+		d.mu.Unlock()
+	}
+	if d.execCommand != "" {
+		if err := shared.RunHookCommand(ctx, d.execCommand, append(line, '\n'), webhookEventEnv(event)); err != nil {
+			fmt.Fprintf(os.Stderr, "Event %s: --exec failed: %v\n", event.ID, err)
+		}
+	}
+	if !d.targets.Empty() {
+		if err := d.targets.Send(ctx, "App Store Connect: "+string(event.Type), webhookEventSummary(event)); err != nil {
+			fmt.Fprintf(os.Stderr, "Event %s: notification failed: %v\n", event.ID, err)
+		}
+	}
+}
+
+func webhookEventEnv(event *asc.WebhookEvent) []string {
+	env := []string{
+		"ASC_WEBHOOK_EVENT_TYPE=" + string(event.Type),
+		"ASC_WEBHOOK_EVENT_ID=" + event.ID,
+		"ASC_WEBHOOK_OLD_VALUE=" + event.OldValue,
+		"ASC_WEBHOOK_NEW_VALUE=" + event.NewValue,
+	}
+	if event.Instance != nil {
+		env = append(env,
+			"ASC_WEBHOOK_RESOURCE_TYPE="+string(event.Instance.Type),
+			"ASC_WEBHOOK_RESOURCE_ID="+event.Instance.ID,
+		)
+	}
+	return env
+}
+
+// webhookEventSummary renders a one-line human summary of an event.
+func webhookEventSummary(event *asc.WebhookEvent) string {
+	switch {
+	case event.BuildUpload != nil:
+		upload := event.BuildUpload
+		if upload.Processed() {
+			return fmt.Sprintf("App Store Connect: build upload %s finished processing", upload.BuildUploadID)
+		}
+		return "App Store Connect: build upload " + upload.BuildUploadID + stateChangeSummary(upload.OldState, upload.NewState)
+	case event.AppStoreVersion != nil:
+		version := event.AppStoreVersion
+		return "App Store Connect: version " + version.VersionID + stateChangeSummary(version.OldState, version.NewState)
+	case event.Feedback != nil:
+		return fmt.Sprintf("App Store Connect: new TestFlight %s feedback (%s)", event.Feedback.Kind, event.Feedback.SubmissionID)
+	}
+	summary := "App Store Connect: " + string(event.Type)
+	if event.Instance != nil {
+		summary += fmt.Sprintf(" (%s %s)", event.Instance.Type, event.Instance.ID)
+	}
+	return summary + stateChangeSummary(event.OldValue, event.NewValue)
+}
+
+func stateChangeSummary(oldValue, newValue string) string {
+	switch {
+	case oldValue != "" && newValue != "":
+		return fmt.Sprintf(": %s → %s", oldValue, newValue)
+	case newValue != "":
+		return ": " + newValue
+	}
+	return ""
+}
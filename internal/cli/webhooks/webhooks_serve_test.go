@@ -0,0 +1,142 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notify"
+)
+
+const testWebhookBody = `{"data":{"type":"buildUploadStateUpdated","id":"evt-1","attributes":{"oldValue":"PROCESSING","newValue":"COMPLETE"},"relationships":{"instance":{"data":{"type":"buildUploads","id":"upload-1"}}}}}`
+
+func TestWebhookHandlerVerifiesAndQueuesDeliveries(t *testing.T) {
+	queue := make(chan *webhookDelivery, 1)
+	handler := newWebhookHandler("secret", queue)
+
+	post := func(signature string) int {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(testWebhookBody))
+		if signature != "" {
+			req.Header.Set(asc.WebhookSignatureHeader, signature)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := post(""); code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for unsigned delivery, got %d", code)
+	}
+	if code := post(asc.SignWebhookPayload("wrong", []byte(testWebhookBody))); code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for bad signature, got %d", code)
+	}
+	if code := post(asc.SignWebhookPayload("secret", []byte(testWebhookBody))); code != http.StatusOK {
+		t.Fatalf("expected 200 for signed delivery, got %d", code)
+	}
+	delivery := <-queue
+	if delivery.event.Type != asc.WebhookEventBuildUploadStateUpdated || delivery.event.NewValue != "COMPLETE" {
+		t.Fatalf("unexpected queued event: %+v", delivery.event)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected 405 for GET, got %d", rec.Code)
+	}
+}
+
+func TestWebhookDispatcherStreamsFiltersAndExecs(t *testing.T) {
+	event, err := asc.ParseWebhookEvent([]byte(testWebhookBody))
+	if err != nil {
+		t.Fatalf("ParseWebhookEvent() error: %v", err)
+	}
+	var out bytes.Buffer
+	dispatcher := &webhookDispatcher{
+		filter: map[asc.WebhookEventType]bool{asc.WebhookEventBuildUploadStateUpdated: true},
+		stdout: true,
+		out:    &out,
+	}
+	outputPath := filepath.Join(t.TempDir(), "event.txt")
+	if runtime.GOOS != "windows" {
+		dispatcher.execCommand = `printf '%s %s ' "$ASC_WEBHOOK_EVENT_TYPE" "$ASC_WEBHOOK_RESOURCE_ID" > "` + outputPath + `"; cat >> "` + outputPath + `"`
+	}
+
+	dispatcher.dispatch(context.Background(), &webhookDelivery{event: event})
+	dispatcher.dispatch(context.Background(), &webhookDelivery{event: &asc.WebhookEvent{ID: "skip", Type: asc.WebhookEventPing}})
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected one NDJSON line, got %q", out.String())
+	}
+	var streamed asc.WebhookEvent
+	if err := json.Unmarshal([]byte(lines[0]), &streamed); err != nil || streamed.ID != "evt-1" {
+		t.Fatalf("unexpected NDJSON line %q: %v", lines[0], err)
+	}
+
+	if runtime.GOOS == "windows" {
+		return
+	}
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("expected --exec output: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "BUILD_UPLOAD_STATE_UPDATED upload-1 {") {
+		t.Fatalf("unexpected --exec output: %q", data)
+	}
+}
+
+func TestWebhookEventSummary(t *testing.T) {
+	tests := map[string]string{
+		testWebhookBody: "App Store Connect: build upload upload-1 finished processing",
+		`{"data":{"type":"appStoreVersionAppVersionStateUpdated","id":"evt-2","attributes":{"oldValue":"IN_REVIEW","newValue":"READY_FOR_SALE"},"relationships":{"instance":{"data":{"type":"appStoreVersions","id":"version-1"}}}}}`: "App Store Connect: version version-1: IN_REVIEW → READY_FOR_SALE",
+		`{"data":{"type":"betaFeedbackCrashSubmissionCreated","id":"evt-3","relationships":{"instance":{"data":{"type":"betaFeedbackCrashSubmissions","id":"crash-1"}}}}}`:                                                            "App Store Connect: new TestFlight crash feedback (crash-1)",
+		`{"data":{"type":"buildBetaDetailExternalBuildStateUpdated","id":"evt-4","attributes":{"newValue":"IN_BETA_TESTING"},"relationships":{"instance":{"data":{"type":"buildBetaDetails","id":"detail-1"}}}}}`:                     "App Store Connect: BUILD_BETA_DETAIL_EXTERNAL_BUILD_STATE_UPDATED (buildBetaDetails detail-1): IN_BETA_TESTING",
+	}
+	for body, want := range tests {
+		event, err := asc.ParseWebhookEvent([]byte(body))
+		if err != nil {
+			t.Fatalf("ParseWebhookEvent() error: %v", err)
+		}
+		if got := webhookEventSummary(event); got != want {
+			t.Fatalf("webhookEventSummary() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestWebhookDispatcherNotifiesTargets(t *testing.T) {
+	received := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received[r.URL.Path] = string(body)
+	}))
+	t.Cleanup(server.Close)
+
+	event, err := asc.ParseWebhookEvent([]byte(testWebhookBody))
+	if err != nil {
+		t.Fatalf("ParseWebhookEvent() error: %v", err)
+	}
+	dispatcher := &webhookDispatcher{targets: notify.Targets{
+		Teams:   server.URL + "/teams",
+		Discord: server.URL + "/discord",
+		Webhook: server.URL + "/generic",
+	}}
+	if err := dispatcher.targets.Validate(); err != nil {
+		t.Fatalf("Validate() error: %v", err)
+	}
+	dispatcher.dispatch(context.Background(), &webhookDelivery{event: event})
+
+	for _, path := range []string{"/teams", "/discord", "/generic"} {
+		if !strings.Contains(received[path], "upload-1 finished processing") {
+			t.Fatalf("expected %s to receive the event summary, got %q", path, received[path])
+		}
+	}
+}
@@ -0,0 +1,458 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// stepState is what later steps can reference about a finished step.
+type stepState struct {
+	outcome string
+	outputs map[string]string
+	output  any
+}
+
+// evalContext resolves ${{ }} references against variables, the
+// environment, and earlier steps.
+type evalContext struct {
+	vars   map[string]string
+	steps  map[string]*stepState
+	failed bool
+}
+
+// lookup resolves vars.NAME, env.NAME, steps.ID.outcome, steps.ID.NAME for a
+// declared output, or steps.ID.PATH into the step's JSON output.
+func (c *evalContext) lookup(ref string) (string, bool) {
+	scope, rest, _ := strings.Cut(ref, ".")
+	switch scope {
+	case "vars":
+		value, ok := c.vars[rest]
+		return value, ok
+	case "env":
+		return os.LookupEnv(rest)
+	case "steps":
+		id, path, ok := strings.Cut(rest, ".")
+		if !ok {
+			return "", false
+		}
+		step := c.steps[id]
+		if step == nil {
+			return "", false
+		}
+		if path == "outcome" {
+			return step.outcome, true
+		}
+		if value, ok := step.outputs[path]; ok {
+			return value, true
+		}
+		return jsonPathValue(step.output, path)
+	default:
+		return "", false
+	}
+}
+
+// expand replaces every ${{ expression }} in s with its value. Unresolved
+// references are errors so typos never reach a command as empty strings.
+func (c *evalContext) expand(s string) (string, error) {
+	var out strings.Builder
+	for {
+		start := strings.Index(s, "${{")
+		if start < 0 {
+			out.WriteString(s)
+			return out.String(), nil
+		}
+		end := strings.Index(s[start:], "}}")
+		if end < 0 {
+			return "", fmt.Errorf("unterminated ${{ in %q", s)
+		}
+		node, err := parseExpression(s[start+3 : start+end])
+		if err != nil {
+			return "", err
+		}
+		value, err := node.eval(c, true)
+		if err != nil {
+			return "", err
+		}
+		out.WriteString(s[:start])
+		out.WriteString(value)
+		s = s[start+end+2:]
+	}
+}
+
+// shouldRun evaluates a step condition. Without success(), failure(), or
+// always(), a condition only passes while no earlier step has failed.
+func (c *evalContext) shouldRun(condition string) (bool, error) {
+	if strings.TrimSpace(condition) == "" {
+		return !c.failed, nil
+	}
+	node, err := parseCondition(condition)
+	if err != nil {
+		return false, err
+	}
+	if !usesStatusFunction(node) && c.failed {
+		return false, nil
+	}
+	value, err := node.eval(c, false)
+	if err != nil {
+		return false, err
+	}
+	return truthy(value), nil
+}
+
+// jsonPathValue walks a dotted path such as data.id or data[0].id.
+func jsonPathValue(root any, path string) (string, bool) {
+	path = strings.NewReplacer("[", ".", "]", "").Replace(path)
+	current := root
+	for _, part := range strings.Split(path, ".") {
+		if part == "" {
+			continue
+		}
+		switch node := current.(type) {
+		case map[string]any:
+			value, ok := node[part]
+			if !ok {
+				return "", false
+			}
+			current = value
+		case []any:
+			index, err := strconv.Atoi(part)
+			if err != nil || index < 0 || index >= len(node) {
+				return "", false
+			}
+			current = node[index]
+		default:
+			return "", false
+		}
+	}
+	switch value := current.(type) {
+	case nil:
+		return "", true
+	case string:
+		return value, true
+	case json.Number:
+		return value.String(), true
+	case bool:
+		return strconv.FormatBool(value), true
+	default:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return "", false
+		}
+		return string(encoded), true
+	}
+}
+
+func truthy(value string) bool {
+	return value != "" && value != "false" && value != "0"
+}
+
+// exprNode is a parsed expression. Values are strings; comparisons and
+// logical operators yield "true" or "false".
+type exprNode interface {
+	eval(c *evalContext, strict bool) (string, error)
+}
+
+type literalNode struct{ value string }
+
+type refNode struct{ ref string }
+
+type callNode struct{ name string }
+
+type notNode struct{ operand exprNode }
+
+type binaryNode struct {
+	op          string
+	left, right exprNode
+}
+
+func (n literalNode) eval(*evalContext, bool) (string, error) {
+	return n.value, nil
+}
+
+func (n refNode) eval(c *evalContext, strict bool) (string, error) {
+	value, ok := c.lookup(n.ref)
+	if !ok && strict {
+		return "", fmt.Errorf("unresolved reference %q", n.ref)
+	}
+	return value, nil
+}
+
+func (n callNode) eval(c *evalContext, _ bool) (string, error) {
+	switch n.name {
+	case "success":
+		return strconv.FormatBool(!c.failed), nil
+	case "failure":
+		return strconv.FormatBool(c.failed), nil
+	default:
+		return "true", nil
+	}
+}
+
+func (n notNode) eval(c *evalContext, strict bool) (string, error) {
+	value, err := n.operand.eval(c, strict)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatBool(!truthy(value)), nil
+}
+
+func (n binaryNode) eval(c *evalContext, strict bool) (string, error) {
+	left, err := n.left.eval(c, strict)
+	if err != nil {
+		return "", err
+	}
+	switch n.op {
+	case "&&":
+		if !truthy(left) {
+			return "false", nil
+		}
+	case "||":
+		if truthy(left) {
+			return "true", nil
+		}
+	}
+	right, err := n.right.eval(c, strict)
+	if err != nil {
+		return "", err
+	}
+	switch n.op {
+	case "==":
+		return strconv.FormatBool(left == right), nil
+	case "!=":
+		return strconv.FormatBool(left != right), nil
+	default:
+		return strconv.FormatBool(truthy(right)), nil
+	}
+}
+
+func usesStatusFunction(node exprNode) bool {
+	switch n := node.(type) {
+	case callNode:
+		return true
+	case notNode:
+		return usesStatusFunction(n.operand)
+	case binaryNode:
+		return usesStatusFunction(n.left) || usesStatusFunction(n.right)
+	default:
+		return false
+	}
+}
+
+// parseCondition parses an if: value, with or without a ${{ }} wrapper.
+func parseCondition(condition string) (exprNode, error) {
+	condition = strings.TrimSpace(condition)
+	if strings.HasPrefix(condition, "${{") && strings.HasSuffix(condition, "}}") {
+		condition = condition[3 : len(condition)-2]
+	}
+	return parseExpression(condition)
+}
+
+// parseExpression parses
+//
+//	expr    = and { "||" and }
+//	and     = unary { "&&" unary }
+//	unary   = "!" unary | compare
+//	compare = primary [ ("==" | "!=") primary ]
+//	primary = "(" expr ")" | 'string' | "string" | true | false | number | name "()" | reference
+func parseExpression(source string) (exprNode, error) {
+	tokens, err := tokenizeExpression(source)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty expression")
+	}
+	p := &exprParser{tokens: tokens}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q in expression %q", p.tokens[p.pos].text, strings.TrimSpace(source))
+	}
+	return node, nil
+}
+
+type exprTokenKind int
+
+const (
+	tokenOperator exprTokenKind = iota
+	tokenString
+	tokenIdent
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+}
+
+func tokenizeExpression(source string) ([]exprToken, error) {
+	var tokens []exprToken
+	for i := 0; i < len(source); {
+		ch := source[i]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			i++
+		case ch == '\'' || ch == '"':
+			var value strings.Builder
+			j := i + 1
+			for ; j < len(source); j++ {
+				if source[j] == ch {
+					// A doubled quote is an escaped quote.
+					if j+1 < len(source) && source[j+1] == ch {
+						value.WriteByte(ch)
+						j++
+						continue
+					}
+					break
+				}
+				value.WriteByte(source[j])
+			}
+			if j >= len(source) {
+				return nil, fmt.Errorf("unterminated string in expression %q", strings.TrimSpace(source))
+			}
+			tokens = append(tokens, exprToken{kind: tokenString, text: value.String()})
+			i = j + 1
+		case strings.HasPrefix(source[i:], "==") || strings.HasPrefix(source[i:], "!=") ||
+			strings.HasPrefix(source[i:], "&&") || strings.HasPrefix(source[i:], "||"):
+			tokens = append(tokens, exprToken{kind: tokenOperator, text: source[i : i+2]})
+			i += 2
+		case ch == '!' || ch == '(' || ch == ')':
+			tokens = append(tokens, exprToken{kind: tokenOperator, text: string(ch)})
+			i++
+		case isIdentByte(ch):
+			j := i
+			for j < len(source) && isIdentByte(source[j]) {
+				j++
+			}
+			tokens = append(tokens, exprToken{kind: tokenIdent, text: source[i:j]})
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected %q in expression %q", string(ch), strings.TrimSpace(source))
+		}
+	}
+	return tokens, nil
+}
+
+func isIdentByte(ch byte) bool {
+	return ch == '_' || ch == '-' || ch == '.' || ch == '[' || ch == ']' ||
+		(ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9')
+}
+
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+}
+
+func (p *exprParser) peekOperator(op string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == tokenOperator && p.tokens[p.pos].text == op
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekOperator("||") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: "||", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekOperator("&&") {
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: "&&", left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if p.peekOperator("!") {
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	}
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range []string{"==", "!="} {
+		if p.peekOperator(op) {
+			p.pos++
+			right, err := p.parsePrimary()
+			if err != nil {
+				return nil, err
+			}
+			return binaryNode{op: op, left: left, right: right}, nil
+		}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	switch token.kind {
+	case tokenString:
+		return literalNode{value: token.text}, nil
+	case tokenIdent:
+		if p.peekOperator("(") {
+			if p.pos+1 >= len(p.tokens) || p.tokens[p.pos+1].text != ")" {
+				return nil, fmt.Errorf("%s() takes no arguments", token.text)
+			}
+			p.pos += 2
+			switch token.text {
+			case "success", "failure", "always":
+				return callNode{name: token.text}, nil
+			default:
+				return nil, fmt.Errorf("unknown function %s()", token.text)
+			}
+		}
+		switch {
+		case token.text == "true" || token.text == "false":
+			return literalNode{value: token.text}, nil
+		case token.text[0] >= '0' && token.text[0] <= '9':
+			return literalNode{value: token.text}, nil
+		case strings.HasPrefix(token.text, "vars.") || strings.HasPrefix(token.text, "env.") || strings.HasPrefix(token.text, "steps."):
+			return refNode{ref: token.text}, nil
+		default:
+			return nil, fmt.Errorf("unknown reference %q (use vars., env., or steps.)", token.text)
+		}
+	default:
+		if token.text == "(" {
+			node, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if !p.peekOperator(")") {
+				return nil, fmt.Errorf("missing ) in expression")
+			}
+			p.pos++
+			return node, nil
+		}
+		return nil, fmt.Errorf("unexpected %q in expression", token.text)
+	}
+}
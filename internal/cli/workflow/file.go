@@ -0,0 +1,193 @@
+package workflow
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const defaultRetryDelay = 5 * time.Second
+
+var stepIDPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+// workflowFile is the YAML workflow definition.
+type workflowFile struct {
+	Name  string            `yaml:"name"`
+	Vars  map[string]string `yaml:"vars"`
+	Env   map[string]string `yaml:"env"`
+	Steps []workflowStep    `yaml:"steps"`
+}
+
+// workflowStep is one asc invocation.
+type workflowStep struct {
+	ID              string            `yaml:"id"`
+	Name            string            `yaml:"name"`
+	Run             string            `yaml:"run"`
+	Args            []string          `yaml:"args"`
+	If              string            `yaml:"if"`
+	Env             map[string]string `yaml:"env"`
+	Outputs         map[string]string `yaml:"outputs"`
+	Retries         int               `yaml:"retries"`
+	RetryDelay      string            `yaml:"retry-delay"`
+	ContinueOnError bool              `yaml:"continue-on-error"`
+
+	retryDelay time.Duration
+}
+
+// loadWorkflowFile reads and validates a workflow file.
+func loadWorkflowFile(path string) (*workflowFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseWorkflowFile(data)
+}
+
+func parseWorkflowFile(data []byte) (*workflowFile, error) {
+	var wf workflowFile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&wf); err != nil {
+		return nil, fmt.Errorf("parse workflow: %w", err)
+	}
+	if err := wf.validate(); err != nil {
+		return nil, err
+	}
+	return &wf, nil
+}
+
+func (wf *workflowFile) validate() error {
+	if len(wf.Steps) == 0 {
+		return fmt.Errorf("workflow has no steps")
+	}
+	seen := make(map[string]bool, len(wf.Steps))
+	for i := range wf.Steps {
+		step := &wf.Steps[i]
+		if step.ID == "" {
+			step.ID = fmt.Sprintf("step-%d", i+1)
+		}
+		if !stepIDPattern.MatchString(step.ID) {
+			return fmt.Errorf("step %d: id %q must start with a letter and contain only letters, digits, '-' and '_'", i+1, step.ID)
+		}
+		if seen[step.ID] {
+			return fmt.Errorf("step %d: duplicate id %q", i+1, step.ID)
+		}
+		seen[step.ID] = true
+
+		hasRun := strings.TrimSpace(step.Run) != ""
+		if hasRun == (len(step.Args) > 0) {
+			return fmt.Errorf("step %q: exactly one of run or args is required", step.ID)
+		}
+		if step.Retries < 0 {
+			return fmt.Errorf("step %q: retries must not be negative", step.ID)
+		}
+		step.retryDelay = defaultRetryDelay
+		if value := strings.TrimSpace(step.RetryDelay); value != "" {
+			delay, err := time.ParseDuration(value)
+			if err != nil || delay < 0 {
+				return fmt.Errorf("step %q: retry-delay must be a duration such as 30s", step.ID)
+			}
+			step.retryDelay = delay
+		}
+		if step.If != "" {
+			if _, err := parseCondition(step.If); err != nil {
+				return fmt.Errorf("step %q: invalid if: %w", step.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// commandArgs returns the step's argument template, splitting run like a
+// shell would.
+func (s *workflowStep) commandArgs() ([]string, error) {
+	if len(s.Args) > 0 {
+		if s.Args[0] == "asc" {
+			return s.Args[1:], nil
+		}
+		return s.Args, nil
+	}
+	return splitCommandLine(s.Run)
+}
+
+// splitCommandLine splits a command line into arguments. Single and double
+// quotes group words, backslash escapes the next character outside single
+// quotes, and ${{ ... }} expressions are kept whole. A leading "asc" is
+// dropped.
+func splitCommandLine(line string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+		quote   rune
+	)
+	runes := []rune(line)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == '$' && i+2 < len(runes) && runes[i+1] == '{' && runes[i+2] == '{' {
+			end := strings.Index(string(runes[i:]), "}}")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated ${{ in %q", line)
+			}
+			expression := []rune(string(runes[i:])[:end+2])
+			current.WriteString(string(expression))
+			inArg = true
+			i += len(expression) - 1
+			continue
+		}
+		switch {
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case quote == '"':
+			switch {
+			case r == '"':
+				quote = 0
+			case r == '\\' && i+1 < len(runes) && strings.ContainsRune(`"\$`, runes[i+1]):
+				i++
+				current.WriteRune(runes[i])
+			default:
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == '\\':
+			if i+1 < len(runes) {
+				i++
+				current.WriteRune(runes[i])
+				inArg = true
+			}
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote in %q", line)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	if len(args) > 0 && args[0] == "asc" {
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		return nil, fmt.Errorf("empty command")
+	}
+	return args, nil
+}
@@ -0,0 +1,363 @@
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// CommandFactory builds a fresh root command tree. Each step parses flags
+// into a new tree so steps never share flag state.
+type CommandFactory func() []*ffcli.Command
+
+// runner executes workflow steps in-process.
+type runner struct {
+	factory CommandFactory
+	// progress receives one line per step start and finish.
+	progress io.Writer
+	// sleep waits between retry attempts.
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// stepRun is the captured result of one step attempt.
+type stepRun struct {
+	stdout string
+	stderr string
+	err    error
+}
+
+// run executes the steps in order and returns the combined report with one
+// JUnit test case per step.
+func (r *runner) run(ctx context.Context, wf *workflowFile, file string, vars map[string]string) (*asc.WorkflowReport, []shared.JUnitTestCase, error) {
+	evalCtx := &evalContext{vars: vars, steps: map[string]*stepState{}}
+	restoreEnv, err := applyEnv(evalCtx, wf.Env)
+	if err != nil {
+		return nil, nil, fmt.Errorf("env: %w", err)
+	}
+	defer restoreEnv()
+
+	started := time.Now()
+	report := &asc.WorkflowReport{
+		Workflow:  wf.Name,
+		File:      file,
+		Status:    asc.WorkflowStatusSuccess,
+		StartedAt: started.UTC().Format(time.RFC3339),
+	}
+	var cases []shared.JUnitTestCase
+	for i := range wf.Steps {
+		step := &wf.Steps[i]
+		result, testCase := r.runStep(ctx, evalCtx, step, i+1, len(wf.Steps))
+		report.Steps = append(report.Steps, result)
+		cases = append(cases, testCase)
+		if result.Status == asc.WorkflowStatusFailure && !step.ContinueOnError {
+			evalCtx.failed = true
+		}
+	}
+	if evalCtx.failed {
+		report.Status = asc.WorkflowStatusFailure
+	}
+	report.Duration = formatStepDuration(time.Since(started))
+	return report, cases, nil
+}
+
+func (r *runner) runStep(ctx context.Context, evalCtx *evalContext, step *workflowStep, index, total int) (asc.WorkflowStepResult, shared.JUnitTestCase) {
+	result := asc.WorkflowStepResult{
+		ID:              step.ID,
+		Name:            step.Name,
+		ContinueOnError: step.ContinueOnError,
+	}
+	testCase := shared.JUnitTestCase{Name: step.ID, Classname: "workflow"}
+	label := step.ID
+	if step.Name != "" {
+		label = fmt.Sprintf("%s (%s)", step.ID, step.Name)
+	}
+	state := &stepState{outcome: asc.WorkflowStatusSkipped}
+	evalCtx.steps[step.ID] = state
+
+	fail := func(err error) (asc.WorkflowStepResult, shared.JUnitTestCase) {
+		result.Status = asc.WorkflowStatusFailure
+		result.Error = err.Error()
+		state.outcome = asc.WorkflowStatusFailure
+		testCase.Failure = "ERROR"
+		testCase.Message = result.Error
+		fmt.Fprintf(r.progress, "[%d/%d] %s failed: %s\n", index, total, label, result.Error)
+		return result, testCase
+	}
+
+	shouldRun, err := evalCtx.shouldRun(step.If)
+	if err != nil {
+		return fail(fmt.Errorf("if: %w", err))
+	}
+	if !shouldRun {
+		result.Status = asc.WorkflowStatusSkipped
+		testCase.Skipped = "condition not met"
+		fmt.Fprintf(r.progress, "[%d/%d] %s skipped\n", index, total, label)
+		return result, testCase
+	}
+
+	templates, err := step.commandArgs()
+	if err != nil {
+		return fail(err)
+	}
+	args := make([]string, 0, len(templates))
+	for _, template := range templates {
+		arg, err := evalCtx.expand(template)
+		if err != nil {
+			return fail(err)
+		}
+		args = append(args, arg)
+	}
+	result.Command = append([]string{"asc"}, args...)
+
+	restoreEnv, err := applyEnv(evalCtx, step.Env)
+	if err != nil {
+		return fail(fmt.Errorf("env: %w", err))
+	}
+	defer restoreEnv()
+
+	fmt.Fprintf(r.progress, "[%d/%d] %s: asc %s\n", index, total, label, commandPath(args))
+	started := time.Now()
+	var run stepRun
+	for attempt := 1; attempt <= step.Retries+1; attempt++ {
+		result.Attempts = attempt
+		run = r.execute(ctx, args)
+		// Usage errors fail the same way on every attempt.
+		if run.err == nil || errors.Is(run.err, flag.ErrHelp) || attempt > step.Retries {
+			break
+		}
+		fmt.Fprintf(r.progress, "[%d/%d] %s attempt %d failed: %v; retrying in %s\n", index, total, label, attempt, run.err, step.retryDelay)
+		if err := r.sleep(ctx, step.retryDelay); err != nil {
+			run.err = err
+			break
+		}
+	}
+	elapsed := time.Since(started)
+	result.Duration = formatStepDuration(elapsed)
+	testCase.Time = elapsed
+	testCase.SystemOut = run.stdout
+	testCase.SystemErr = run.stderr
+
+	if output := strings.TrimSpace(run.stdout); output != "" {
+		decoder := json.NewDecoder(strings.NewReader(output))
+		decoder.UseNumber()
+		var decoded any
+		if err := decoder.Decode(&decoded); err == nil && !decoder.More() {
+			state.output = decoded
+			result.Output = json.RawMessage(output)
+		} else {
+			encoded, _ := json.Marshal(output)
+			result.Output = encoded
+			state.output = output
+		}
+	}
+
+	if run.err != nil {
+		return fail(stepError(run))
+	}
+
+	if len(step.Outputs) > 0 {
+		state.outputs = make(map[string]string, len(step.Outputs))
+		for name, path := range step.Outputs {
+			value, ok := jsonPathValue(state.output, path)
+			if !ok {
+				return fail(fmt.Errorf("output %q: no value at %q", name, path))
+			}
+			state.outputs[name] = value
+		}
+		result.Outputs = state.outputs
+	}
+
+	result.Status = asc.WorkflowStatusSuccess
+	state.outcome = asc.WorkflowStatusSuccess
+	fmt.Fprintf(r.progress, "[%d/%d] %s succeeded in %s\n", index, total, label, result.Duration)
+	return result, testCase
+}
+
+// execute runs one asc command in-process. Stdout is captured for outputs;
+// stderr is captured and still shown so progress stays visible.
+func (r *runner) execute(ctx context.Context, args []string) (run stepRun) {
+	root := &ffcli.Command{
+		Name:        "asc",
+		FlagSet:     flag.NewFlagSet("asc", flag.ContinueOnError),
+		Subcommands: r.factory(),
+	}
+	// Flag errors must fail the step, not exit the process.
+	setContinueOnError(root)
+	shared.ResetResolvedRefs()
+
+	stdoutReader, stdoutWriter, err := os.Pipe()
+	if err != nil {
+		return stepRun{err: err}
+	}
+	stderrReader, stderrWriter, err := os.Pipe()
+	if err != nil {
+		_ = stdoutReader.Close()
+		_ = stdoutWriter.Close()
+		return stepRun{err: err}
+	}
+
+	origStdout, origStderr := os.Stdout, os.Stderr
+	var stdoutBuf, stderrBuf bytes.Buffer
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(&stdoutBuf, stdoutReader)
+	}()
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(io.MultiWriter(&stderrBuf, origStderr), stderrReader)
+	}()
+
+	run.err = func() (err error) {
+		os.Stdout, os.Stderr = stdoutWriter, stderrWriter
+		defer func() {
+			os.Stdout, os.Stderr = origStdout, origStderr
+			if recovered := recover(); recovered != nil {
+				err = fmt.Errorf("command panicked: %v", recovered)
+			}
+		}()
+		if err := root.Parse(args); err != nil {
+			return err
+		}
+		return root.Run(ctx)
+	}()
+
+	_ = stdoutWriter.Close()
+	_ = stderrWriter.Close()
+	wg.Wait()
+	_ = stdoutReader.Close()
+	_ = stderrReader.Close()
+
+	run.stdout = stdoutBuf.String()
+	run.stderr = stderrBuf.String()
+	return run
+}
+
+// validateCommands checks that every step names an existing command, so a
+// typo in a late step fails before earlier steps change anything.
+func validateCommands(wf *workflowFile, commands []*ffcli.Command) error {
+	for _, step := range wf.Steps {
+		args, err := step.commandArgs()
+		if err != nil {
+			return fmt.Errorf("step %q: %w", step.ID, err)
+		}
+		name := args[0]
+		if strings.Contains(name, "${{") {
+			continue
+		}
+		found := false
+		for _, cmd := range commands {
+			if cmd != nil && cmd.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("step %q: unknown command %q", step.ID, name)
+		}
+	}
+	return nil
+}
+
+func setContinueOnError(cmd *ffcli.Command) {
+	if cmd.FlagSet == nil {
+		cmd.FlagSet = flag.NewFlagSet(cmd.Name, flag.ContinueOnError)
+	}
+	cmd.FlagSet.Init(cmd.FlagSet.Name(), flag.ContinueOnError)
+	for _, sub := range cmd.Subcommands {
+		setContinueOnError(sub)
+	}
+}
+
+// applyEnv expands and sets env, returning a function that restores the
+// previous values.
+func applyEnv(evalCtx *evalContext, env map[string]string) (func(), error) {
+	type previousValue struct {
+		value string
+		set   bool
+	}
+	previous := make(map[string]previousValue, len(env))
+	restore := func() {
+		for key, prev := range previous {
+			if prev.set {
+				_ = os.Setenv(key, prev.value)
+			} else {
+				_ = os.Unsetenv(key)
+			}
+		}
+	}
+	for key, raw := range env {
+		value, err := evalCtx.expand(raw)
+		if err != nil {
+			restore()
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		prevValue, set := os.LookupEnv(key)
+		previous[key] = previousValue{value: prevValue, set: set}
+		if err := os.Setenv(key, value); err != nil {
+			restore()
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	return restore, nil
+}
+
+// stepError describes a failed attempt. Usage errors are printed by the
+// command itself, so the message is taken from its stderr.
+func stepError(run stepRun) error {
+	if !errors.Is(run.err, flag.ErrHelp) {
+		return run.err
+	}
+	lines := strings.Split(strings.TrimSpace(run.stderr), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if message, ok := strings.CutPrefix(strings.TrimSpace(lines[i]), "Error:"); ok {
+			return errors.New(strings.TrimSpace(message))
+		}
+	}
+	return errors.New("invalid arguments")
+}
+
+// commandPath returns the subcommand words of args, leaving out flag values
+// that may hold secrets.
+func commandPath(args []string) string {
+	var path []string
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			break
+		}
+		path = append(path, arg)
+	}
+	return strings.Join(path, " ")
+}
+
+func formatStepDuration(d time.Duration) string {
+	return d.Round(time.Millisecond).String()
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,164 @@
+package workflow
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// varsFlag collects repeatable key=value flags.
+type varsFlag map[string]string
+
+func (f varsFlag) String() string {
+	return ""
+}
+
+func (f varsFlag) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	key = strings.TrimSpace(key)
+	if !ok || key == "" {
+		return fmt.Errorf("expected key=value, got %q", value)
+	}
+	f[key] = val
+	return nil
+}
+
+// WorkflowCommand returns the workflow command group. factory must build the
+// full root command tree; it is called again for every step.
+func WorkflowCommand(factory CommandFactory) *ffcli.Command {
+	fs := flag.NewFlagSet("workflow", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "workflow",
+		ShortUsage: "asc workflow <subcommand> [flags]",
+		ShortHelp:  "Run multi-step asc workflows from YAML files.",
+		LongHelp: `Run multi-step asc workflows from YAML files.
+
+Examples:
+  asc workflow run release.yaml
+  asc workflow run release.yaml --var version=2.1 --var ipa=build/App.ipa`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			RunCommand(factory),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// RunCommand returns the workflow run subcommand.
+func RunCommand(factory CommandFactory) *ffcli.Command {
+	fs := flag.NewFlagSet("workflow run", flag.ExitOnError)
+
+	vars := varsFlag{}
+	fs.Var(vars, "var", "Set a workflow variable as key=value, overriding vars in the file (repeatable)")
+	output := fs.String("output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, csv, ndjson")
+	pretty := fs.Bool("pretty", false, "Pretty-print JSON output")
+
+	return &ffcli.Command{
+		Name:       "run",
+		ShortUsage: "asc workflow run <file.yaml> [flags]",
+		ShortHelp:  "Run the steps of a workflow file.",
+		LongHelp: `Run the steps of a workflow file.
+
+Each step runs an asc command in-process. Steps run in order; once a step
+fails, later steps are skipped unless their condition calls failure() or
+always(). The combined report (status, attempts, duration, and output of
+every step) is printed when the workflow ends. With the root --report junit
+flag, each step becomes a test case.
+
+File format:
+  name: release
+  vars:
+    app: "123456789"
+  env:
+    ASC_APP_ID: ${{ vars.app }}
+  steps:
+    - id: upload
+      run: publish testflight --ipa "${{ vars.ipa }}" --group Beta --wait
+      retries: 2
+      retry-delay: 30s
+    - id: validate
+      run: validate --app ${{ vars.app }} --version-id ${{ vars.version_id }}
+      continue-on-error: true
+    - id: notify
+      if: ${{ steps.validate.outcome == 'success' }}
+      args: [notify, slack, --message, "Build ${{ steps.upload.buildId }} uploaded"]
+
+Steps take either run (a command line) or args (a list). ${{ }} expressions
+can reference vars.NAME, env.NAME, steps.ID.outcome (success, failure, or
+skipped), and steps.ID.PATH, a path into the step's JSON output such as
+buildId or data[0].id. outputs: maps names to such paths. Conditions support
+==, !=, &&, ||, !, parentheses, quoted strings, and success(), failure(),
+and always().
+
+Root flags such as --profile and --simulate apply to every step.
+
+Examples:
+  asc workflow run release.yaml
+  asc workflow run release.yaml --var version=2.1 --var ipa=build/App.ipa
+  asc --report junit --report-file workflow.xml workflow run release.yaml`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			// Allow flags after the file (asc workflow run release.yaml --var a=b).
+			positional, err := shared.ParseInterspersed(fs, args)
+			if err != nil {
+				return err
+			}
+			if len(positional) != 1 {
+				return shared.UsageError("exactly one workflow file is required")
+			}
+			path := positional[0]
+			wf, err := loadWorkflowFile(path)
+			if err != nil {
+				return shared.UsageErrorf("invalid workflow %s: %v", path, err)
+			}
+			if err := validateCommands(wf, factory()); err != nil {
+				return shared.UsageErrorf("invalid workflow %s: %v", path, err)
+			}
+
+			resolvedVars := make(map[string]string, len(wf.Vars)+len(vars))
+			for key, value := range wf.Vars {
+				resolvedVars[key] = value
+			}
+			for key, value := range vars {
+				resolvedVars[key] = value
+			}
+
+			r := &runner{factory: factory, progress: os.Stderr, sleep: sleepContext}
+			report, cases, err := r.run(ctx, wf, path, resolvedVars)
+			if err != nil {
+				return fmt.Errorf("workflow run: %w", err)
+			}
+			shared.SetReportTestCases(cases)
+
+			if err := shared.PrintOutput(report, *output, *pretty); err != nil {
+				return err
+			}
+			if report.Status == asc.WorkflowStatusFailure {
+				return fmt.Errorf("workflow run: %s", failedStepsSummary(report))
+			}
+			return nil
+		},
+	}
+}
+
+func failedStepsSummary(report *asc.WorkflowReport) string {
+	var failed []string
+	for _, step := range report.Steps {
+		if step.Status == asc.WorkflowStatusFailure && !step.ContinueOnError {
+			failed = append(failed, fmt.Sprintf("step %q failed: %s", step.ID, step.Error))
+		}
+	}
+	return strings.Join(failed, "; ")
+}
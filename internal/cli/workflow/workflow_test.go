@@ -0,0 +1,429 @@
+package workflow
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// fakeCommands is a small command tree standing in for the asc root.
+type fakeCommands struct {
+	calls      [][]string
+	flakyFails int
+}
+
+func (f *fakeCommands) factory() []*ffcli.Command {
+	echoFS := flag.NewFlagSet("echo", flag.ExitOnError)
+	body := echoFS.String("json", "", "JSON to print")
+	echo := &ffcli.Command{
+		Name:    "echo",
+		FlagSet: echoFS,
+		Exec: func(ctx context.Context, args []string) error {
+			f.calls = append(f.calls, append([]string{"echo"}, args...))
+			fmt.Fprintln(os.Stdout, *body)
+			return nil
+		},
+	}
+
+	flaky := &ffcli.Command{
+		Name:    "flaky",
+		FlagSet: flag.NewFlagSet("flaky", flag.ExitOnError),
+		Exec: func(ctx context.Context, args []string) error {
+			f.calls = append(f.calls, []string{"flaky"})
+			if f.flakyFails > 0 {
+				f.flakyFails--
+				return errors.New("temporary failure")
+			}
+			fmt.Fprintln(os.Stdout, `{"ok":true}`)
+			return nil
+		},
+	}
+
+	usageFS := flag.NewFlagSet("usage", flag.ExitOnError)
+	app := usageFS.String("app", "", "App ID")
+	usage := &ffcli.Command{
+		Name:    "usage",
+		FlagSet: usageFS,
+		Exec: func(ctx context.Context, args []string) error {
+			f.calls = append(f.calls, []string{"usage"})
+			if *app == "" {
+				return shared.UsageError("--app is required")
+			}
+			return nil
+		},
+	}
+
+	envCmd := &ffcli.Command{
+		Name:    "env",
+		FlagSet: flag.NewFlagSet("env", flag.ExitOnError),
+		Exec: func(ctx context.Context, args []string) error {
+			fmt.Fprintf(os.Stdout, "{\"value\":%q}\n", os.Getenv("WORKFLOW_TEST_VALUE"))
+			return nil
+		},
+	}
+
+	return []*ffcli.Command{echo, flaky, usage, envCmd}
+}
+
+func runTestWorkflow(t *testing.T, fake *fakeCommands, source string, vars map[string]string) (*asc.WorkflowReport, []shared.JUnitTestCase) {
+	t.Helper()
+	wf, err := parseWorkflowFile([]byte(source))
+	if err != nil {
+		t.Fatalf("parseWorkflowFile() error: %v", err)
+	}
+	if err := validateCommands(wf, fake.factory()); err != nil {
+		t.Fatalf("validateCommands() error: %v", err)
+	}
+	if vars == nil {
+		vars = map[string]string{}
+	}
+	for key, value := range wf.Vars {
+		if _, ok := vars[key]; !ok {
+			vars[key] = value
+		}
+	}
+	r := &runner{
+		factory:  fake.factory,
+		progress: io.Discard,
+		sleep:    func(context.Context, time.Duration) error { return nil },
+	}
+
+	var report *asc.WorkflowReport
+	var cases []shared.JUnitTestCase
+	// Usage errors print to stderr; keep test output clean.
+	origStderr := os.Stderr
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatalf("open %s: %v", os.DevNull, err)
+	}
+	os.Stderr = devNull
+	defer func() {
+		os.Stderr = origStderr
+		_ = devNull.Close()
+	}()
+	report, cases, err = r.run(context.Background(), wf, "test.yaml", vars)
+	if err != nil {
+		t.Fatalf("run() error: %v", err)
+	}
+	return report, cases
+}
+
+func stepStatuses(report *asc.WorkflowReport) []string {
+	statuses := make([]string, 0, len(report.Steps))
+	for _, step := range report.Steps {
+		statuses = append(statuses, step.ID+"="+step.Status)
+	}
+	return statuses
+}
+
+func TestWorkflowRunPassesStepOutputsToLaterSteps(t *testing.T) {
+	fake := &fakeCommands{}
+	report, cases := runTestWorkflow(t, fake, `
+name: release
+vars:
+  app: "123"
+steps:
+  - id: upload
+    run: asc echo --json '{"buildId":"build-9","data":[{"id":"v-1"}]}'
+    outputs:
+      version: data[0].id
+  - id: notify
+    args: [echo, --json, "${{ steps.upload.buildId }}/${{ steps.upload.version }}/${{ vars.app }}"]
+`, nil)
+
+	if report.Status != asc.WorkflowStatusSuccess {
+		t.Fatalf("expected success, got %+v", report)
+	}
+	if report.Workflow != "release" || report.File != "test.yaml" {
+		t.Fatalf("unexpected report header: %+v", report)
+	}
+	if len(fake.calls) != 2 {
+		t.Fatalf("expected 2 calls, got %v", fake.calls)
+	}
+	if got := report.Steps[1].Command; !reflect.DeepEqual(got, []string{"asc", "echo", "--json", "build-9/v-1/123"}) {
+		t.Fatalf("unexpected expanded command: %v", got)
+	}
+	if report.Steps[0].Outputs["version"] != "v-1" {
+		t.Fatalf("expected declared output, got %+v", report.Steps[0].Outputs)
+	}
+	if string(report.Steps[1].Output) != `"build-9/v-1/123"` {
+		t.Fatalf("unexpected step output: %s", report.Steps[1].Output)
+	}
+	if len(cases) != 2 || cases[0].Name != "upload" || cases[0].Failure != "" {
+		t.Fatalf("unexpected test cases: %+v", cases)
+	}
+}
+
+func TestWorkflowRunSkipsStepsAfterFailure(t *testing.T) {
+	fake := &fakeCommands{flakyFails: 5}
+	report, cases := runTestWorkflow(t, fake, `
+steps:
+  - id: soft
+    run: flaky
+    continue-on-error: true
+  - id: after-soft
+    run: echo --json '{}'
+  - id: hard
+    run: usage
+  - id: skipped
+    run: echo --json '{}'
+  - id: cleanup
+    if: always()
+    run: echo --json '{}'
+  - id: on-failure
+    if: failure() && steps.hard.outcome == 'failure'
+    run: echo --json '{}'
+  - id: plain-condition
+    if: ${{ steps.soft.outcome == 'failure' }}
+    run: echo --json '{}'
+`, nil)
+
+	want := []string{
+		"soft=failure",
+		"after-soft=success",
+		"hard=failure",
+		"skipped=skipped",
+		"cleanup=success",
+		"on-failure=success",
+		"plain-condition=skipped",
+	}
+	if got := stepStatuses(report); !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected statuses:\n got %v\nwant %v", got, want)
+	}
+	if report.Status != asc.WorkflowStatusFailure {
+		t.Fatalf("expected workflow failure, got %q", report.Status)
+	}
+	if report.Steps[2].Error != "--app is required" {
+		t.Fatalf("expected usage error message, got %q", report.Steps[2].Error)
+	}
+	if report.Steps[2].Attempts != 1 {
+		t.Fatalf("usage errors should not be retried, got %d attempts", report.Steps[2].Attempts)
+	}
+	if cases[3].Skipped == "" || cases[2].Failure == "" {
+		t.Fatalf("expected skipped and failed test cases, got %+v", cases)
+	}
+	if summary := failedStepsSummary(report); summary != `step "hard" failed: --app is required` {
+		t.Fatalf("unexpected summary: %q", summary)
+	}
+}
+
+func TestWorkflowRunRetriesFailedSteps(t *testing.T) {
+	fake := &fakeCommands{flakyFails: 2}
+	report, _ := runTestWorkflow(t, fake, `
+steps:
+  - id: flaky
+    run: flaky
+    retries: 2
+    retry-delay: 1s
+`, nil)
+
+	if report.Status != asc.WorkflowStatusSuccess {
+		t.Fatalf("expected success after retries, got %+v", report.Steps[0])
+	}
+	if report.Steps[0].Attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", report.Steps[0].Attempts)
+	}
+
+	fake = &fakeCommands{flakyFails: 3}
+	report, _ = runTestWorkflow(t, fake, `
+steps:
+  - id: flaky
+    run: flaky
+    retries: 2
+`, nil)
+	if report.Steps[0].Status != asc.WorkflowStatusFailure || report.Steps[0].Error != "temporary failure" {
+		t.Fatalf("expected failure after exhausting retries, got %+v", report.Steps[0])
+	}
+}
+
+func TestWorkflowRunAppliesEnvAndVarOverrides(t *testing.T) {
+	t.Setenv("WORKFLOW_TEST_VALUE", "original")
+	fake := &fakeCommands{}
+	report, _ := runTestWorkflow(t, fake, `
+vars:
+  value: from-file
+env:
+  WORKFLOW_TEST_VALUE: ${{ vars.value }}
+steps:
+  - id: workflow-env
+    run: env
+  - id: step-env
+    run: env
+    env:
+      WORKFLOW_TEST_VALUE: step-${{ steps.workflow-env.value }}
+`, map[string]string{"value": "from-flag"})
+
+	if string(report.Steps[0].Output) != `{"value":"from-flag"}` {
+		t.Fatalf("unexpected workflow env output: %s", report.Steps[0].Output)
+	}
+	if string(report.Steps[1].Output) != `{"value":"step-from-flag"}` {
+		t.Fatalf("unexpected step env output: %s", report.Steps[1].Output)
+	}
+	if got := os.Getenv("WORKFLOW_TEST_VALUE"); got != "original" {
+		t.Fatalf("expected env to be restored, got %q", got)
+	}
+}
+
+func TestWorkflowRunFailsOnUnresolvedReference(t *testing.T) {
+	fake := &fakeCommands{}
+	report, _ := runTestWorkflow(t, fake, `
+steps:
+  - id: notify
+    run: echo --json ${{ steps.upload.buildId }}
+`, nil)
+	if report.Steps[0].Status != asc.WorkflowStatusFailure || !strings.Contains(report.Steps[0].Error, `unresolved reference "steps.upload.buildId"`) {
+		t.Fatalf("expected unresolved reference failure, got %+v", report.Steps[0])
+	}
+	if len(fake.calls) != 0 {
+		t.Fatalf("expected no command to run, got %v", fake.calls)
+	}
+}
+
+func TestParseWorkflowFileValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		wantErr string
+	}{
+		{name: "no steps", source: "name: x\n", wantErr: "workflow has no steps"},
+		{name: "unknown field", source: "steps:\n  - id: a\n    run: echo\n    retry: 2\n", wantErr: "field retry not found"},
+		{name: "run and args", source: "steps:\n  - id: a\n    run: echo\n    args: [echo]\n", wantErr: "exactly one of run or args"},
+		{name: "duplicate id", source: "steps:\n  - id: a\n    run: echo\n  - id: a\n    run: echo\n", wantErr: `duplicate id "a"`},
+		{name: "bad id", source: "steps:\n  - id: 1a\n    run: echo\n", wantErr: "must start with a letter"},
+		{name: "bad delay", source: "steps:\n  - id: a\n    run: echo\n    retry-delay: soon\n", wantErr: "retry-delay must be a duration"},
+		{name: "bad condition", source: "steps:\n  - id: a\n    run: echo\n    if: steps.a.outcome ==\n", wantErr: "invalid if"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseWorkflowFile([]byte(test.source))
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+
+	wf, err := parseWorkflowFile([]byte("steps:\n  - run: nope\n"))
+	if err != nil {
+		t.Fatalf("parseWorkflowFile() error: %v", err)
+	}
+	if wf.Steps[0].ID != "step-1" {
+		t.Fatalf("expected generated id step-1, got %q", wf.Steps[0].ID)
+	}
+	fake := &fakeCommands{}
+	if err := validateCommands(wf, fake.factory()); err == nil || !strings.Contains(err.Error(), `unknown command "nope"`) {
+		t.Fatalf("expected unknown command error, got %v", err)
+	}
+}
+
+func TestSplitCommandLine(t *testing.T) {
+	tests := []struct {
+		line string
+		want []string
+	}{
+		{line: "asc builds list --app 123", want: []string{"builds", "list", "--app", "123"}},
+		{line: `notify slack --message "Build ${{ steps.upload.buildId }} is \"ready\""`, want: []string{"notify", "slack", "--message", `Build ${{ steps.upload.buildId }} is "ready"`}},
+		{line: `echo --json '{"a": "b c"}'`, want: []string{"echo", "--json", `{"a": "b c"}`}},
+		{line: `echo --app ${{ vars.app }} path\ with\ space`, want: []string{"echo", "--app", "${{ vars.app }}", "path with space"}},
+	}
+	for _, test := range tests {
+		got, err := splitCommandLine(test.line)
+		if err != nil {
+			t.Fatalf("splitCommandLine(%q) error: %v", test.line, err)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Fatalf("splitCommandLine(%q) = %q, want %q", test.line, got, test.want)
+		}
+	}
+	for _, line := range []string{`echo "unterminated`, "echo ${{ vars.app", "asc"} {
+		if _, err := splitCommandLine(line); err == nil {
+			t.Fatalf("expected error for %q", line)
+		}
+	}
+}
+
+func TestEvalContextConditions(t *testing.T) {
+	t.Setenv("WORKFLOW_TEST_FLAG", "yes")
+	evalCtx := &evalContext{
+		vars: map[string]string{"track": "beta", "empty": ""},
+		steps: map[string]*stepState{
+			"upload": {outcome: asc.WorkflowStatusSuccess, output: map[string]any{"uploaded": true}},
+		},
+	}
+	tests := []struct {
+		condition string
+		want      bool
+	}{
+		{condition: "vars.track == 'beta'", want: true},
+		{condition: "${{ vars.track != \"beta\" }}", want: false},
+		{condition: "vars.empty", want: false},
+		{condition: "!vars.empty && env.WORKFLOW_TEST_FLAG == 'yes'", want: true},
+		{condition: "steps.upload.uploaded && (vars.track == 'prod' || steps.upload.outcome == 'success')", want: true},
+		{condition: "steps.missing.outcome == 'success'", want: false},
+		{condition: "failure()", want: false},
+	}
+	for _, test := range tests {
+		got, err := evalCtx.shouldRun(test.condition)
+		if err != nil {
+			t.Fatalf("shouldRun(%q) error: %v", test.condition, err)
+		}
+		if got != test.want {
+			t.Fatalf("shouldRun(%q) = %t, want %t", test.condition, got, test.want)
+		}
+	}
+
+	evalCtx.failed = true
+	if ok, _ := evalCtx.shouldRun("vars.track == 'beta'"); ok {
+		t.Fatal("conditions without status functions should not run after a failure")
+	}
+	if ok, _ := evalCtx.shouldRun("always() && vars.track == 'beta'"); !ok {
+		t.Fatal("always() conditions should run after a failure")
+	}
+
+	for _, condition := range []string{"unknown()", "vars.a ==", "foo == 'x'", "'open"} {
+		if _, err := parseCondition(condition); err == nil {
+			t.Fatalf("expected parse error for %q", condition)
+		}
+	}
+}
+
+func TestWorkflowReportTableOutput(t *testing.T) {
+	report := &asc.WorkflowReport{
+		Workflow: "release",
+		Status:   asc.WorkflowStatusSuccess,
+		Steps: []asc.WorkflowStepResult{
+			{ID: "upload", Name: "Upload", Status: asc.WorkflowStatusSuccess, Attempts: 2, Duration: "1.5s"},
+		},
+	}
+	var buf bytes.Buffer
+	orig := os.Stdout
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("pipe: %v", err)
+	}
+	os.Stdout = writer
+	printErr := shared.PrintOutput(report, "table", false)
+	os.Stdout = orig
+	_ = writer.Close()
+	_, _ = io.Copy(&buf, reader)
+	if printErr != nil {
+		t.Fatalf("PrintOutput() error: %v", printErr)
+	}
+	for _, want := range []string{"upload", "Upload", "success", "1.5s"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Fatalf("expected %q in table output, got %q", want, buf.String())
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 // waitForBuildCompletion polls until the build run completes or times out.
 func waitForBuildCompletion(ctx context.Context, client *asc.Client, buildRunID string, pollInterval time.Duration, outputFormat string, pretty bool) error {
+	ctx = asc.WithoutResponseCache(ctx)
 	ticker := time.NewTicker(pollInterval)
 	defer ticker.Stop()
 
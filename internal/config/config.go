@@ -138,6 +138,56 @@ type Config struct {
 	MaxDelay             string        `json:"max_delay"`
 	RetryLog             string        `json:"retry_log"`
 	Debug                string        `json:"debug"`
+	CacheTTL             DurationValue `json:"cache_ttl"`
+	RateLimit            string        `json:"rate_limit"`
+	RateLimitShared      string        `json:"rate_limit_shared"`
+
+	Notify *NotifyConfig `json:"notify,omitempty"`
+}
+
+// NotifyConfig holds default targets for asc notify providers.
+type NotifyConfig struct {
+	Slack   *SlackNotifyConfig   `json:"slack,omitempty"`
+	Teams   *TeamsNotifyConfig   `json:"teams,omitempty"`
+	Discord *DiscordNotifyConfig `json:"discord,omitempty"`
+	Webhook *WebhookNotifyConfig `json:"webhook,omitempty"`
+	Email   *EmailNotifyConfig   `json:"email,omitempty"`
+}
+
+// SlackNotifyConfig configures asc notify slack.
+type SlackNotifyConfig struct {
+	Webhook string `json:"webhook,omitempty"`
+	Channel string `json:"channel,omitempty"`
+}
+
+// TeamsNotifyConfig configures asc notify teams.
+type TeamsNotifyConfig struct {
+	Webhook string `json:"webhook,omitempty"`
+}
+
+// DiscordNotifyConfig configures asc notify discord.
+type DiscordNotifyConfig struct {
+	Webhook  string `json:"webhook,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+// WebhookNotifyConfig configures asc notify webhook.
+type WebhookNotifyConfig struct {
+	URL      string            `json:"url,omitempty"`
+	Method   string            `json:"method,omitempty"`
+	Headers  map[string]string `json:"headers,omitempty"`
+	Template string            `json:"template,omitempty"`
+}
+
+// EmailNotifyConfig configures asc notify email. Prefer ASC_SMTP_PASSWORD
+// over storing Password in the config file.
+type EmailNotifyConfig struct {
+	Host     string   `json:"host,omitempty"`
+	Port     int      `json:"port,omitempty"`
+	Username string   `json:"username,omitempty"`
+	Password string   `json:"password,omitempty"`
+	From     string   `json:"from,omitempty"`
+	To       []string `json:"to,omitempty"`
 }
 
 // ErrNotFound is returned when the config file doesn't exist
@@ -331,9 +381,19 @@ func (c *Config) Validate() error {
 	if err := validateDurationValue("upload_timeout_seconds", c.UploadTimeoutSeconds); err != nil {
 		return wrapInvalidConfig(err)
 	}
+	if err := validateDurationValue("cache_ttl", c.CacheTTL); err != nil {
+		return wrapInvalidConfig(err)
+	}
 	if err := validateMaxRetries(c.MaxRetries); err != nil {
 		return wrapInvalidConfig(err)
 	}
+	if err := validateRateLimit(c.RateLimit); err != nil {
+		return wrapInvalidConfig(err)
+	}
+
+	if c.Notify != nil && c.Notify.Email != nil && (c.Notify.Email.Port < 0 || c.Notify.Email.Port > 65535) {
+		return wrapInvalidConfig(fmt.Errorf("notify.email.port must be between 1 and 65535"))
+	}
 
 	baseDelay, baseSet, err := parseOptionalDuration("base_delay", c.BaseDelay)
 	if err != nil {
@@ -383,6 +443,18 @@ func validateMaxRetries(raw string) error {
 	return nil
 }
 
+func validateRateLimit(raw string) error {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || strings.EqualFold(raw, "off") {
+		return nil
+	}
+	parsed, err := strconv.Atoi(raw)
+	if err != nil || parsed < 0 {
+		return fmt.Errorf("rate_limit must be a non-negative integer or \"off\"")
+	}
+	return nil
+}
+
 func parseOptionalDuration(field, raw string) (time.Duration, bool, error) {
 	raw = strings.TrimSpace(raw)
 	if raw == "" {
@@ -306,6 +306,22 @@ func TestLoadAtRejectsInvalidTimeout(t *testing.T) {
 	}
 }
 
+func TestLoadAtRejectsInvalidCacheTTL(t *testing.T) {
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "config.json")
+	cfg := &Config{
+		CacheTTL: DurationValue{Raw: "soon"},
+	}
+	if err := SaveAt(path, cfg); err != nil {
+		t.Fatalf("SaveAt() error: %v", err)
+	}
+
+	_, err := LoadAt(path)
+	if !errors.Is(err, ErrInvalidConfig) {
+		t.Fatalf("expected ErrInvalidConfig, got %v", err)
+	}
+}
+
 func TestLoadAtRejectsMaxRetriesOutOfRange(t *testing.T) {
 	tempDir := t.TempDir()
 	path := filepath.Join(tempDir, "config.json")
@@ -344,3 +360,19 @@ func TestLoadAtRejectsMaxDelayBelowBaseDelay(t *testing.T) {
 		t.Fatalf("expected ErrInvalidConfig, got %v", err)
 	}
 }
+
+func TestLoadAtRejectsInvalidNotifyEmailPort(t *testing.T) {
+	tempDir := t.TempDir()
+	path := filepath.Join(tempDir, "config.json")
+	cfg := &Config{
+		Notify: &NotifyConfig{Email: &EmailNotifyConfig{Host: "smtp.example.com", Port: 70000}},
+	}
+	if err := SaveAt(path, cfg); err != nil {
+		t.Fatalf("SaveAt() error: %v", err)
+	}
+
+	_, err := LoadAt(path)
+	if !errors.Is(err, ErrInvalidConfig) {
+		t.Fatalf("expected ErrInvalidConfig, got %v", err)
+	}
+}
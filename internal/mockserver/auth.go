@@ -0,0 +1,73 @@
+package mockserver
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	tokenAudience = "appstoreconnect-v1"
+	// es256SignatureSize is the raw R||S length of an ES256 signature.
+	es256SignatureSize = 64
+)
+
+// authorize checks the request's bearer token. With a verify key the token
+// signature must match it; without one any well-formed ES256 token is
+// accepted, so JWTs signed by any local .p8 key work.
+func (s *Server) authorize(r *http.Request) error {
+	raw, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	raw = strings.TrimSpace(raw)
+	if !ok || raw == "" {
+		return errors.New("missing bearer token")
+	}
+	return verifyToken(raw, s.verifyKey, s.now())
+}
+
+func verifyToken(raw string, key *ecdsa.PublicKey, now time.Time) error {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}),
+		jwt.WithAudience(tokenAudience),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	}
+	parser := jwt.NewParser(opts...)
+	claims := &jwt.RegisteredClaims{}
+
+	var token *jwt.Token
+	if key != nil {
+		parsed, err := parser.ParseWithClaims(raw, claims, func(*jwt.Token) (any, error) {
+			return key, nil
+		})
+		if err != nil {
+			return err
+		}
+		token = parsed
+	} else {
+		parsed, parts, err := parser.ParseUnverified(raw, claims)
+		if err != nil {
+			return err
+		}
+		if parsed.Method.Alg() != jwt.SigningMethodES256.Alg() {
+			return fmt.Errorf("unexpected signing method %q", parsed.Method.Alg())
+		}
+		signature, err := parser.DecodeSegment(parts[2])
+		if err != nil || len(signature) != es256SignatureSize {
+			return errors.New("malformed ES256 signature")
+		}
+		if err := jwt.NewValidator(opts...).Validate(claims); err != nil {
+			return err
+		}
+		token = parsed
+	}
+
+	if kid, _ := token.Header["kid"].(string); strings.TrimSpace(kid) == "" {
+		return errors.New("token header is missing kid")
+	}
+	return nil
+}
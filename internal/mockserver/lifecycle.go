@@ -0,0 +1,252 @@
+package mockserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	uploadPathPrefix = "/uploads/"
+	// uploadChunkSize is the length of each upload operation handed out.
+	uploadChunkSize = 8 << 20
+)
+
+// applyCreateDefaults fills in the server-owned attributes App Store Connect
+// sets on creation and attaches upload operations to file-backed resources.
+func (s *Server) applyCreateDefaults(res *Resource, base string) {
+	setDefault := func(key string, value any) {
+		if _, ok := res.Attributes[key]; !ok {
+			res.Attributes[key] = value
+		}
+	}
+
+	switch res.Type {
+	case "appStoreVersions":
+		setDefault("appStoreState", "PREPARE_FOR_SUBMISSION")
+		setDefault("appVersionState", "PREPARE_FOR_SUBMISSION")
+		setDefault("createdDate", s.timestamp())
+	case "builds":
+		setDefault("processingState", "VALID")
+		setDefault("expired", false)
+		setDefault("uploadedDate", s.timestamp())
+	case "buildUploads":
+		setDefault("state", map[string]any{"state": "AWAITING_UPLOAD"})
+		setDefault("createdDate", s.timestamp())
+	case "betaTesters":
+		setDefault("inviteType", "EMAIL")
+		setDefault("state", "INVITED")
+	case "reviewSubmissions", "reviewSubmissionItems":
+		setDefault("state", "READY_FOR_REVIEW")
+	}
+
+	if size, ok := int64Attribute(res.Attributes, "fileSize"); ok && size > 0 {
+		res.Attributes["uploadOperations"] = uploadOperations(res, base, size)
+		res.Attributes["assetDeliveryState"] = map[string]any{"state": "AWAITING_UPLOAD"}
+	}
+}
+
+// applyUpdateEffects advances state machines driven by PATCHed attributes:
+// committing an upload and submitting or canceling a review submission.
+func (s *Server) applyUpdateEffects(res *Resource, changed map[string]any) {
+	if uploaded, _ := changed["uploaded"].(bool); uploaded {
+		if _, ok := res.Attributes["uploadOperations"]; ok {
+			res.Attributes["assetDeliveryState"] = map[string]any{"state": "COMPLETE"}
+		}
+		if res.Type == "buildUploadFiles" {
+			s.completeBuildUpload(res)
+		}
+	}
+
+	if res.Type == "reviewSubmissions" {
+		if submitted, _ := changed["submitted"].(bool); submitted {
+			res.Attributes["state"] = "WAITING_FOR_REVIEW"
+			res.Attributes["submittedDate"] = s.timestamp()
+		}
+		if canceled, _ := changed["canceled"].(bool); canceled {
+			res.Attributes["state"] = "CANCELING"
+		}
+	}
+}
+
+// completeBuildUpload marks the file's build upload complete and creates the
+// processed build (and its pre-release version) for the uploaded bundle.
+func (s *Server) completeBuildUpload(file *Resource) {
+	var upload *Resource
+	for _, ref := range file.Relationships["buildUpload"].Data {
+		upload = s.lookup(ref.Type, ref.ID)
+	}
+	if upload == nil || len(upload.Relationships["build"].Data) > 0 {
+		return
+	}
+	upload.Attributes["state"] = map[string]any{"state": "COMPLETE"}
+	upload.Attributes["uploadedDate"] = s.timestamp()
+
+	appRel := upload.Relationships["app"]
+	platform, _ := upload.Attributes["platform"].(string)
+	shortVersion, _ := upload.Attributes["cfBundleShortVersionString"].(string)
+	buildNumber, _ := upload.Attributes["cfBundleVersion"].(string)
+
+	build := &Resource{
+		Type: "builds",
+		ID:   s.nextID("builds"),
+		Attributes: map[string]any{
+			"version":         buildNumber,
+			"processingState": "VALID",
+			"expired":         false,
+			"uploadedDate":    s.timestamp(),
+		},
+		Relationships: map[string]Relationship{"app": appRel},
+	}
+	if shortVersion != "" {
+		build.Relationships["preReleaseVersion"] = Relationship{Data: []Identifier{s.preReleaseVersion(appRel, shortVersion, platform)}}
+	}
+	s.insert(build)
+
+	if upload.Relationships == nil {
+		upload.Relationships = make(map[string]Relationship)
+	}
+	upload.Relationships["build"] = Relationship{Data: []Identifier{build.identifier()}}
+}
+
+// preReleaseVersion finds or creates the pre-release version for an app.
+func (s *Server) preReleaseVersion(appRel Relationship, version, platform string) Identifier {
+	for _, id := range s.order["preReleaseVersions"] {
+		existing := s.resources["preReleaseVersions"][id]
+		if existing.Attributes["version"] == version && existing.Attributes["platform"] == platform &&
+			sameLinkage(existing.Relationships["app"], appRel) {
+			return existing.identifier()
+		}
+	}
+	created := &Resource{
+		Type:          "preReleaseVersions",
+		ID:            s.nextID("preReleaseVersions"),
+		Attributes:    map[string]any{"version": version, "platform": platform},
+		Relationships: map[string]Relationship{"app": appRel},
+	}
+	s.insert(created)
+	return created.identifier()
+}
+
+func sameLinkage(a, b Relationship) bool {
+	if len(a.Data) != len(b.Data) {
+		return false
+	}
+	for i := range a.Data {
+		if a.Data[i] != b.Data[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func uploadOperations(res *Resource, base string, size int64) []map[string]any {
+	ops := make([]map[string]any, 0, size/uploadChunkSize+1)
+	for offset := int64(0); offset < size; offset += uploadChunkSize {
+		length := min(int64(uploadChunkSize), size-offset)
+		ops = append(ops, map[string]any{
+			"method": http.MethodPut,
+			"url":    fmt.Sprintf("%s%s%s/%s?offset=%d", base, uploadPathPrefix, res.Type, res.ID, offset),
+			"length": length,
+			"offset": offset,
+			"requestHeaders": []map[string]string{
+				{"name": "Content-Type", "value": "application/octet-stream"},
+			},
+		})
+	}
+	return ops
+}
+
+// handleUpload stores one upload operation's bytes at its offset in the
+// resource's file under DataDir/uploads/{type}/{id}/. Like the presigned
+// URLs App Store Connect hands out, upload URLs need no bearer token.
+func (s *Server) handleUpload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, uploadPathPrefix), "/")
+	if len(parts) != 2 {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", "unknown upload URL")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := s.lookup(parts[0], parts[1])
+	if res == nil {
+		writeResourceNotFound(w, parts[0], parts[1])
+		return
+	}
+	size, ok := int64Attribute(res.Attributes, "fileSize")
+	if _, hasOps := res.Attributes["uploadOperations"]; !ok || !hasOps {
+		writeError(w, http.StatusConflict, "ENTITY_ERROR", "The resource does not accept uploads", fmt.Sprintf("%s %q has no upload operations", res.Type, res.ID))
+		return
+	}
+	offset, err := strconv.ParseInt(r.URL.Query().Get("offset"), 10, 64)
+	if err != nil || offset < 0 || offset >= size {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "A parameter has an invalid value", "invalid upload offset")
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, size-offset+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "The request entity is invalid", err.Error())
+		return
+	}
+	if int64(len(body)) > size-offset {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "The request entity is invalid", "upload exceeds the declared fileSize")
+		return
+	}
+
+	path := s.uploadFilePath(res)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		writeError(w, http.StatusInternalServerError, "UNEXPECTED_ERROR", "An unexpected error occurred", err.Error())
+		return
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "UNEXPECTED_ERROR", "An unexpected error occurred", err.Error())
+		return
+	}
+	_, writeErr := file.WriteAt(body, offset)
+	closeErr := file.Close()
+	if writeErr != nil || closeErr != nil {
+		writeError(w, http.StatusInternalServerError, "UNEXPECTED_ERROR", "An unexpected error occurred", fmt.Sprintf("write upload: %v", errors.Join(writeErr, closeErr)))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// uploadFilePath returns where a resource's uploaded bytes are stored.
+func (s *Server) uploadFilePath(res *Resource) string {
+	name, _ := res.Attributes["fileName"].(string)
+	name = filepath.Base(strings.TrimSpace(name))
+	if name == "" || name == "." || name == string(filepath.Separator) {
+		name = "upload.bin"
+	}
+	return filepath.Join(s.dataDir, "uploads", res.Type, res.ID, name)
+}
+
+func int64Attribute(attributes map[string]any, key string) (int64, bool) {
+	switch value := attributes[key].(type) {
+	case json.Number:
+		parsed, err := value.Int64()
+		return parsed, err == nil
+	case float64:
+		return int64(value), true
+	case int64:
+		return value, true
+	case int:
+		return int64(value), true
+	default:
+		return 0, false
+	}
+}
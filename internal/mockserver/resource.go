@@ -0,0 +1,188 @@
+package mockserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Identifier is a JSON:API resource identifier.
+type Identifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// Relationship is a JSON:API relationship linkage. ToMany linkages marshal as
+// arrays; to-one linkages marshal as an object (or null when empty).
+type Relationship struct {
+	Data   []Identifier
+	ToMany bool
+}
+
+// MarshalJSON encodes the relationship as {"data": ...}.
+func (r Relationship) MarshalJSON() ([]byte, error) {
+	var data any
+	switch {
+	case r.ToMany:
+		data = r.Data
+		if r.Data == nil {
+			data = []Identifier{}
+		}
+	case len(r.Data) > 0:
+		data = r.Data[0]
+	}
+	return json.Marshal(struct {
+		Data any `json:"data"`
+	}{Data: data})
+}
+
+// UnmarshalJSON decodes {"data": {...}}, {"data": [...]}, or {"data": null}.
+func (r *Relationship) UnmarshalJSON(raw []byte) error {
+	var wrapper struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &wrapper); err != nil {
+		return err
+	}
+	data := bytes.TrimSpace(wrapper.Data)
+	switch {
+	case len(data) == 0 || bytes.Equal(data, []byte("null")):
+		*r = Relationship{}
+	case data[0] == '[':
+		var ids []Identifier
+		if err := json.Unmarshal(data, &ids); err != nil {
+			return err
+		}
+		*r = Relationship{Data: ids, ToMany: true}
+	default:
+		var id Identifier
+		if err := json.Unmarshal(data, &id); err != nil {
+			return err
+		}
+		*r = Relationship{Data: []Identifier{id}}
+	}
+	return nil
+}
+
+func (r Relationship) contains(target Identifier) bool {
+	for _, id := range r.Data {
+		if id == target {
+			return true
+		}
+	}
+	return false
+}
+
+// Resource is a stored JSON:API resource.
+type Resource struct {
+	Type          string                  `json:"type"`
+	ID            string                  `json:"id"`
+	Attributes    map[string]any          `json:"attributes,omitempty"`
+	Relationships map[string]Relationship `json:"relationships,omitempty"`
+}
+
+func (r *Resource) identifier() Identifier {
+	return Identifier{Type: r.Type, ID: r.ID}
+}
+
+// references reports whether any relationship of r points at target.
+func (r *Resource) references(target Identifier) bool {
+	for _, rel := range r.Relationships {
+		if rel.contains(target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Resource) clone() Resource {
+	out := Resource{Type: r.Type, ID: r.ID}
+	if r.Attributes != nil {
+		out.Attributes = make(map[string]any, len(r.Attributes))
+		for key, value := range r.Attributes {
+			out.Attributes[key] = value
+		}
+	}
+	if r.Relationships != nil {
+		out.Relationships = make(map[string]Relationship, len(r.Relationships))
+		for key, rel := range r.Relationships {
+			out.Relationships[key] = Relationship{Data: append([]Identifier(nil), rel.Data...), ToMany: rel.ToMany}
+		}
+	}
+	return out
+}
+
+// SeedFile is the on-disk seed format: a JSON:API-style document whose data
+// array holds the resources to preload.
+type SeedFile struct {
+	Data []Resource `json:"data"`
+}
+
+// LoadSeedFile reads resources to preload from a JSON seed file.
+func LoadSeedFile(path string) ([]Resource, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var seed SeedFile
+	if err := json.Unmarshal(raw, &seed); err != nil {
+		return nil, fmt.Errorf("parse seed file %s: %w", path, err)
+	}
+	return seed.Data, nil
+}
+
+// DefaultSeed returns a small fixture: one app with an editable version,
+// an en-US localization, and an internal beta group.
+func DefaultSeed() []Resource {
+	app := Identifier{Type: "apps", ID: "1000000001"}
+	version := Identifier{Type: "appStoreVersions", ID: "mock-version-1"}
+	return []Resource{
+		{
+			Type: app.Type,
+			ID:   app.ID,
+			Attributes: map[string]any{
+				"name":          "Mock App",
+				"bundleId":      "com.example.mock",
+				"sku":           "MOCK001",
+				"primaryLocale": "en-US",
+			},
+		},
+		{
+			Type: version.Type,
+			ID:   version.ID,
+			Attributes: map[string]any{
+				"platform":        "IOS",
+				"versionString":   "1.0",
+				"appStoreState":   "PREPARE_FOR_SUBMISSION",
+				"appVersionState": "PREPARE_FOR_SUBMISSION",
+			},
+			Relationships: map[string]Relationship{
+				"app": {Data: []Identifier{app}},
+			},
+		},
+		{
+			Type: "appStoreVersionLocalizations",
+			ID:   "mock-version-localization-1",
+			Attributes: map[string]any{
+				"locale":      "en-US",
+				"description": "A mock app for local development.",
+				"keywords":    "mock,example",
+			},
+			Relationships: map[string]Relationship{
+				"appStoreVersion": {Data: []Identifier{version}},
+			},
+		},
+		{
+			Type: "betaGroups",
+			ID:   "mock-beta-group-1",
+			Attributes: map[string]any{
+				"name":            "Internal Testers",
+				"isInternalGroup": true,
+			},
+			Relationships: map[string]Relationship{
+				"app": {Data: []Identifier{app}},
+			},
+		},
+	}
+}
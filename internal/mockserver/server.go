@@ -0,0 +1,770 @@
+// Package mockserver implements an offline, stateful, in-memory subset of the
+// App Store Connect API for local development and tests.
+//
+// The server speaks JSON:API like the real service: resources can be listed,
+// fetched, created, updated and deleted, related resources are reachable
+// through /v1/{type}/{id}/{relationship}, and linkages through
+// /v1/{type}/{id}/relationships/{relationship}. Resources created with a
+// fileSize attribute receive upload operations that store their bytes under
+// the server's data directory.
+package mockserver
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 200
+	maxBodyBytes     = 10 << 20
+	timestampLayout  = "2006-01-02T15:04:05.000-07:00"
+)
+
+// resourceTypes lists the resource types served by the mock.
+var resourceTypes = map[string]bool{
+	"apps":                         true,
+	"appInfos":                     true,
+	"appInfoLocalizations":         true,
+	"appStoreVersions":             true,
+	"appStoreVersionLocalizations": true,
+	"appScreenshotSets":            true,
+	"appScreenshots":               true,
+	"betaAppLocalizations":         true,
+	"betaBuildLocalizations":       true,
+	"betaGroups":                   true,
+	"betaTesters":                  true,
+	"builds":                       true,
+	"buildUploads":                 true,
+	"buildUploadFiles":             true,
+	"preReleaseVersions":           true,
+	"reviewSubmissions":            true,
+	"reviewSubmissionItems":        true,
+}
+
+// relationTarget describes the resource type a relationship links to.
+type relationTarget struct {
+	resourceType string
+	toMany       bool
+}
+
+// relationshipTypes maps relationship names that are not themselves
+// resource types to their target type.
+var relationshipTypes = map[string]relationTarget{
+	"app":                         {resourceType: "apps"},
+	"appInfo":                     {resourceType: "appInfos"},
+	"appStoreVersion":             {resourceType: "appStoreVersions"},
+	"appStoreVersionLocalization": {resourceType: "appStoreVersionLocalizations"},
+	"appScreenshotSet":            {resourceType: "appScreenshotSets"},
+	"betaGroup":                   {resourceType: "betaGroups"},
+	"build":                       {resourceType: "builds"},
+	"buildUpload":                 {resourceType: "buildUploads"},
+	"individualTesters":           {resourceType: "betaTesters", toMany: true},
+	"items":                       {resourceType: "reviewSubmissionItems", toMany: true},
+	"preReleaseVersion":           {resourceType: "preReleaseVersions"},
+	"reviewSubmission":            {resourceType: "reviewSubmissions"},
+}
+
+func resolveRelation(name string) (relationTarget, bool) {
+	if resourceTypes[name] {
+		return relationTarget{resourceType: name, toMany: true}, true
+	}
+	target, ok := relationshipTypes[name]
+	return target, ok
+}
+
+// Options configures a Server.
+type Options struct {
+	// DataDir stores uploaded file contents. Required.
+	DataDir string
+	// VerifyKey, when set, requires bearer tokens to be signed by this key.
+	VerifyKey *ecdsa.PublicKey
+	// Seed preloads resources.
+	Seed []Resource
+	// Now overrides the clock (for tests).
+	Now func() time.Time
+}
+
+// Server is an in-memory App Store Connect API. It implements http.Handler.
+type Server struct {
+	mu        sync.Mutex
+	dataDir   string
+	verifyKey *ecdsa.PublicKey
+	now       func() time.Time
+	resources map[string]map[string]*Resource
+	order     map[string][]string
+	seq       int
+}
+
+// New creates a Server preloaded with opts.Seed.
+func New(opts Options) (*Server, error) {
+	dataDir := strings.TrimSpace(opts.DataDir)
+	if dataDir == "" {
+		return nil, errors.New("data directory is required")
+	}
+	if err := os.MkdirAll(dataDir, 0o755); err != nil {
+		return nil, fmt.Errorf("create data directory: %w", err)
+	}
+	now := opts.Now
+	if now == nil {
+		now = time.Now
+	}
+	s := &Server{
+		dataDir:   dataDir,
+		verifyKey: opts.VerifyKey,
+		now:       now,
+		resources: make(map[string]map[string]*Resource),
+		order:     make(map[string][]string),
+		seq:       1000,
+	}
+	for _, res := range opts.Seed {
+		if !resourceTypes[res.Type] {
+			return nil, fmt.Errorf("seed: unsupported resource type %q", res.Type)
+		}
+		if strings.TrimSpace(res.ID) == "" {
+			return nil, fmt.Errorf("seed: %s resource is missing an id", res.Type)
+		}
+		if s.lookup(res.Type, res.ID) != nil {
+			return nil, fmt.Errorf("seed: duplicate %s resource %q", res.Type, res.ID)
+		}
+		stored := res.clone()
+		s.insert(&stored)
+	}
+	return s, nil
+}
+
+// DataDir returns the directory holding uploaded files.
+func (s *Server) DataDir() string {
+	return s.dataDir
+}
+
+// Resources returns a snapshot of the stored resources of one type, in
+// creation order.
+func (s *Server) Resources(resourceType string) []Resource {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Resource, 0, len(s.order[resourceType]))
+	for _, id := range s.order[resourceType] {
+		out = append(out, s.resources[resourceType][id].clone())
+	}
+	return out
+}
+
+// ServeHTTP routes API, relationship, and upload requests.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, uploadPathPrefix) {
+		s.handleUpload(w, r)
+		return
+	}
+	if err := s.authorize(r); err != nil {
+		writeError(w, http.StatusUnauthorized, "NOT_AUTHORIZED", "Authentication credentials are missing or invalid.", err.Error())
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segments) < 2 || segments[0] != "v1" || !resourceTypes[segments[1]] {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", fmt.Sprintf("The path provided does not match a defined resource type: %s", r.URL.Path))
+		return
+	}
+	segments = segments[1:]
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case len(segments) == 1:
+		switch r.Method {
+		case http.MethodGet:
+			s.handleList(w, r, segments[0])
+		case http.MethodPost:
+			s.handleCreate(w, r, segments[0])
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+	case len(segments) == 2:
+		switch r.Method {
+		case http.MethodGet:
+			s.handleGet(w, r, segments[0], segments[1])
+		case http.MethodPatch:
+			s.handleUpdate(w, r, segments[0], segments[1])
+		case http.MethodDelete:
+			s.handleDelete(w, segments[0], segments[1])
+		default:
+			writeMethodNotAllowed(w, r)
+		}
+	case len(segments) == 3:
+		if r.Method != http.MethodGet {
+			writeMethodNotAllowed(w, r)
+			return
+		}
+		s.handleRelated(w, r, segments[0], segments[1], segments[2])
+	case len(segments) == 4 && segments[2] == "relationships":
+		s.handleLinkage(w, r, segments[0], segments[1], segments[3])
+	default:
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", fmt.Sprintf("The path provided does not match a defined resource type: %s", r.URL.Path))
+	}
+}
+
+func (s *Server) handleList(w http.ResponseWriter, r *http.Request, resourceType string) {
+	items := make([]*Resource, 0, len(s.order[resourceType]))
+	for _, id := range s.order[resourceType] {
+		items = append(items, s.resources[resourceType][id])
+	}
+	s.writeList(w, r, items)
+}
+
+func (s *Server) handleGet(w http.ResponseWriter, r *http.Request, resourceType, id string) {
+	res := s.lookup(resourceType, id)
+	if res == nil {
+		writeResourceNotFound(w, resourceType, id)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"data":  resourceObject(res, baseURL(r)),
+		"links": map[string]string{"self": baseURL(r) + r.URL.RequestURI()},
+	})
+}
+
+func (s *Server) handleCreate(w http.ResponseWriter, r *http.Request, resourceType string) {
+	res, err := decodeResource(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "The request entity is invalid", err.Error())
+		return
+	}
+	if res.Type != resourceType {
+		writeError(w, http.StatusConflict, "ENTITY_ERROR.INCLUDED.INVALID_TYPE", "The provided entity includes an invalid type", fmt.Sprintf("expected type %q, got %q", resourceType, res.Type))
+		return
+	}
+	if err := s.checkRelationships(res.Relationships); err != nil {
+		writeError(w, http.StatusConflict, "ENTITY_ERROR.RELATIONSHIP.INVALID", "The provided entity includes a relationship with an invalid value", err.Error())
+		return
+	}
+	if res.Attributes == nil {
+		res.Attributes = make(map[string]any)
+	}
+	res.ID = s.nextID(resourceType)
+	s.insert(res)
+	s.applyCreateDefaults(res, baseURL(r))
+
+	writeJSON(w, http.StatusCreated, map[string]any{
+		"data":  resourceObject(res, baseURL(r)),
+		"links": map[string]string{"self": resourceURL(res, baseURL(r))},
+	})
+}
+
+func (s *Server) handleUpdate(w http.ResponseWriter, r *http.Request, resourceType, id string) {
+	res := s.lookup(resourceType, id)
+	if res == nil {
+		writeResourceNotFound(w, resourceType, id)
+		return
+	}
+	patch, err := decodeResource(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "The request entity is invalid", err.Error())
+		return
+	}
+	if patch.Type != resourceType || patch.ID != id {
+		writeError(w, http.StatusConflict, "ENTITY_ERROR.INCLUDED.INVALID_ID", "The provided entity id does not match the path", fmt.Sprintf("expected %s %q, got %s %q", resourceType, id, patch.Type, patch.ID))
+		return
+	}
+	if err := s.checkRelationships(patch.Relationships); err != nil {
+		writeError(w, http.StatusConflict, "ENTITY_ERROR.RELATIONSHIP.INVALID", "The provided entity includes a relationship with an invalid value", err.Error())
+		return
+	}
+
+	if res.Attributes == nil {
+		res.Attributes = make(map[string]any)
+	}
+	for key, value := range patch.Attributes {
+		res.Attributes[key] = value
+	}
+	for name, rel := range patch.Relationships {
+		if res.Relationships == nil {
+			res.Relationships = make(map[string]Relationship)
+		}
+		res.Relationships[name] = rel
+	}
+	s.applyUpdateEffects(res, patch.Attributes)
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"data":  resourceObject(res, baseURL(r)),
+		"links": map[string]string{"self": resourceURL(res, baseURL(r))},
+	})
+}
+
+func (s *Server) handleDelete(w http.ResponseWriter, resourceType, id string) {
+	res := s.lookup(resourceType, id)
+	if res == nil {
+		writeResourceNotFound(w, resourceType, id)
+		return
+	}
+	s.remove(res)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleRelated(w http.ResponseWriter, r *http.Request, resourceType, id, name string) {
+	parent := s.lookup(resourceType, id)
+	if parent == nil {
+		writeResourceNotFound(w, resourceType, id)
+		return
+	}
+	target, ok := resolveRelation(name)
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", fmt.Sprintf("The relationship '%s' does not exist on '%s'", name, resourceType))
+		return
+	}
+	related := s.related(parent, name, target)
+	if target.toMany {
+		s.writeList(w, r, related)
+		return
+	}
+
+	var data any
+	if len(related) > 0 {
+		data = resourceObject(related[0], baseURL(r))
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"data":  data,
+		"links": map[string]string{"self": baseURL(r) + r.URL.RequestURI()},
+	})
+}
+
+func (s *Server) handleLinkage(w http.ResponseWriter, r *http.Request, resourceType, id, name string) {
+	parent := s.lookup(resourceType, id)
+	if parent == nil {
+		writeResourceNotFound(w, resourceType, id)
+		return
+	}
+	target, ok := resolveRelation(name)
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", fmt.Sprintf("The relationship '%s' does not exist on '%s'", name, resourceType))
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		rel := Relationship{ToMany: target.toMany}
+		for _, res := range s.related(parent, name, target) {
+			rel.Data = append(rel.Data, res.identifier())
+		}
+		writeJSON(w, http.StatusOK, rel)
+		return
+	}
+
+	var body Relationship
+	if err := decodeBody(r, &body); err != nil {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "The request entity is invalid", err.Error())
+		return
+	}
+	if err := s.checkRelationships(map[string]Relationship{name: body}); err != nil {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", err.Error())
+		return
+	}
+	if parent.Relationships == nil {
+		parent.Relationships = make(map[string]Relationship)
+	}
+
+	switch r.Method {
+	case http.MethodPost, http.MethodDelete:
+		if !target.toMany {
+			writeMethodNotAllowed(w, r)
+			return
+		}
+		current := parent.Relationships[name]
+		current.ToMany = true
+		for _, ref := range body.Data {
+			if r.Method == http.MethodPost {
+				if !current.contains(ref) {
+					current.Data = append(current.Data, ref)
+				}
+				continue
+			}
+			current.Data = removeIdentifier(current.Data, ref)
+			if other := s.lookup(ref.Type, ref.ID); other != nil {
+				unlink(other, parent.identifier())
+			}
+		}
+		parent.Relationships[name] = current
+	case http.MethodPatch:
+		keep := make(map[Identifier]bool, len(body.Data))
+		for _, ref := range body.Data {
+			keep[ref] = true
+		}
+		for _, res := range s.related(parent, name, target) {
+			if !keep[res.identifier()] {
+				unlink(res, parent.identifier())
+				unlink(parent, res.identifier())
+			}
+		}
+		parent.Relationships[name] = Relationship{Data: body.Data, ToMany: target.toMany}
+	default:
+		writeMethodNotAllowed(w, r)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// related returns the resources linked from parent's named relationship plus
+// resources of the target type that reference parent.
+func (s *Server) related(parent *Resource, name string, target relationTarget) []*Resource {
+	seen := make(map[Identifier]bool)
+	var out []*Resource
+	add := func(res *Resource) {
+		if res == nil || seen[res.identifier()] {
+			return
+		}
+		seen[res.identifier()] = true
+		out = append(out, res)
+	}
+	for _, ref := range parent.Relationships[name].Data {
+		add(s.lookup(ref.Type, ref.ID))
+	}
+	for _, id := range s.order[target.resourceType] {
+		res := s.resources[target.resourceType][id]
+		if res.references(parent.identifier()) {
+			add(res)
+		}
+	}
+	return out
+}
+
+// writeList filters, sorts, and paginates items per the request query.
+func (s *Server) writeList(w http.ResponseWriter, r *http.Request, items []*Resource) {
+	query := r.URL.Query()
+
+	filtered := make([]*Resource, 0, len(items))
+	for _, res := range items {
+		if s.matchesFilters(res, query) {
+			filtered = append(filtered, res)
+		}
+	}
+	if value := strings.TrimSpace(query.Get("sort")); value != "" {
+		sortResources(filtered, strings.Split(value, ","))
+	}
+
+	limit := defaultPageLimit
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxPageLimit {
+			writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "A parameter has an invalid value", fmt.Sprintf("'%s' is not a valid value for 'limit'; must be between 1 and %d", value, maxPageLimit))
+			return
+		}
+		limit = parsed
+	}
+	offset := 0
+	if value := query.Get("cursor"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "A parameter has an invalid value", fmt.Sprintf("'%s' is not a valid cursor", value))
+			return
+		}
+		offset = min(parsed, len(filtered))
+	}
+	end := min(offset+limit, len(filtered))
+
+	base := baseURL(r)
+	data := make([]map[string]any, 0, end-offset)
+	for _, res := range filtered[offset:end] {
+		data = append(data, resourceObject(res, base))
+	}
+	links := map[string]string{"self": base + r.URL.RequestURI()}
+	if end < len(filtered) {
+		next := r.URL.Query()
+		next.Set("cursor", strconv.Itoa(end))
+		next.Set("limit", strconv.Itoa(limit))
+		links["next"] = base + r.URL.Path + "?" + next.Encode()
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"data":  data,
+		"links": links,
+		"meta":  map[string]any{"paging": map[string]int{"total": len(filtered), "limit": limit}},
+	})
+}
+
+// matchesFilters applies filter[name]=a,b parameters. A filter matches an
+// attribute value, the id, or a relationship linkage in either direction.
+func (s *Server) matchesFilters(res *Resource, query map[string][]string) bool {
+	for key, values := range query {
+		name, ok := strings.CutPrefix(key, "filter[")
+		if !ok || !strings.HasSuffix(name, "]") || len(values) == 0 {
+			continue
+		}
+		name = strings.TrimSuffix(name, "]")
+		allowed := make(map[string]bool)
+		for _, value := range strings.Split(values[0], ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				allowed[value] = true
+			}
+		}
+		if !s.matchesFilter(res, name, allowed) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) matchesFilter(res *Resource, name string, allowed map[string]bool) bool {
+	if name == "id" {
+		return allowed[res.ID]
+	}
+	if value, ok := res.Attributes[name]; ok {
+		return allowed[fmt.Sprint(value)]
+	}
+	for _, ref := range res.Relationships[name].Data {
+		if allowed[ref.ID] {
+			return true
+		}
+	}
+	target, ok := resolveRelation(name)
+	if !ok {
+		return false
+	}
+	if s.linkedTo(res, target.resourceType, allowed) {
+		return true
+	}
+	// Like App Store Connect, resolve filters through one intermediate
+	// resource, e.g. betaTesters?filter[apps] via the testers' beta groups.
+	for _, neighbor := range s.neighbors(res) {
+		if neighbor.Type != target.resourceType && s.linkedTo(neighbor, target.resourceType, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// linkedTo reports whether res links to, or is referenced by, a resource of
+// resourceType whose id is allowed.
+func (s *Server) linkedTo(res *Resource, resourceType string, allowed map[string]bool) bool {
+	for _, rel := range res.Relationships {
+		for _, ref := range rel.Data {
+			if ref.Type == resourceType && allowed[ref.ID] {
+				return true
+			}
+		}
+	}
+	for id := range allowed {
+		if other := s.lookup(resourceType, id); other != nil && other.references(res.identifier()) {
+			return true
+		}
+	}
+	return false
+}
+
+// neighbors returns the resources res links to and the resources linking to res.
+func (s *Server) neighbors(res *Resource) []*Resource {
+	var out []*Resource
+	for _, rel := range res.Relationships {
+		for _, ref := range rel.Data {
+			if other := s.lookup(ref.Type, ref.ID); other != nil {
+				out = append(out, other)
+			}
+		}
+	}
+	for _, byID := range s.resources {
+		for _, other := range byID {
+			if other.references(res.identifier()) {
+				out = append(out, other)
+			}
+		}
+	}
+	return out
+}
+
+func sortResources(items []*Resource, keys []string) {
+	sort.SliceStable(items, func(i, j int) bool {
+		for _, key := range keys {
+			key = strings.TrimSpace(key)
+			desc := strings.HasPrefix(key, "-")
+			key = strings.TrimPrefix(key, "-")
+			a, b := sortValue(items[i], key), sortValue(items[j], key)
+			if a == b {
+				continue
+			}
+			less := a < b
+			if af, aerr := strconv.ParseFloat(a, 64); aerr == nil {
+				if bf, berr := strconv.ParseFloat(b, 64); berr == nil {
+					less = af < bf
+				}
+			}
+			if desc {
+				return !less
+			}
+			return less
+		}
+		return false
+	})
+}
+
+func sortValue(res *Resource, key string) string {
+	if key == "id" {
+		return res.ID
+	}
+	value, ok := res.Attributes[key]
+	if !ok || value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
+
+// checkRelationships verifies that every linked resource of a served type exists.
+func (s *Server) checkRelationships(relationships map[string]Relationship) error {
+	for name, rel := range relationships {
+		for _, ref := range rel.Data {
+			if !resourceTypes[ref.Type] {
+				continue
+			}
+			if s.lookup(ref.Type, ref.ID) == nil {
+				return fmt.Errorf("relationship %q references missing %s %q", name, ref.Type, ref.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) lookup(resourceType, id string) *Resource {
+	return s.resources[resourceType][id]
+}
+
+func (s *Server) insert(res *Resource) {
+	if s.resources[res.Type] == nil {
+		s.resources[res.Type] = make(map[string]*Resource)
+	}
+	s.resources[res.Type][res.ID] = res
+	s.order[res.Type] = append(s.order[res.Type], res.ID)
+}
+
+// remove deletes res and drops every linkage that points at it.
+func (s *Server) remove(res *Resource) {
+	delete(s.resources[res.Type], res.ID)
+	ids := s.order[res.Type]
+	for i, id := range ids {
+		if id == res.ID {
+			s.order[res.Type] = append(ids[:i:i], ids[i+1:]...)
+			break
+		}
+	}
+	for _, byID := range s.resources {
+		for _, other := range byID {
+			unlink(other, res.identifier())
+		}
+	}
+}
+
+func (s *Server) nextID(resourceType string) string {
+	for {
+		s.seq++
+		id := strconv.Itoa(s.seq)
+		if s.lookup(resourceType, id) == nil {
+			return id
+		}
+	}
+}
+
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(timestampLayout)
+}
+
+// unlink removes target from every relationship of res.
+func unlink(res *Resource, target Identifier) {
+	for name, rel := range res.Relationships {
+		if rel.contains(target) {
+			rel.Data = removeIdentifier(rel.Data, target)
+			res.Relationships[name] = rel
+		}
+	}
+}
+
+func removeIdentifier(ids []Identifier, target Identifier) []Identifier {
+	out := ids[:0:0]
+	for _, id := range ids {
+		if id != target {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func decodeResource(r *http.Request) (*Resource, error) {
+	var doc struct {
+		Data *Resource `json:"data"`
+	}
+	if err := decodeBody(r, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Data == nil {
+		return nil, errors.New("request body is missing data")
+	}
+	return doc.Data, nil
+}
+
+func decodeBody(r *http.Request, v any) error {
+	decoder := json.NewDecoder(io.LimitReader(r.Body, maxBodyBytes))
+	decoder.UseNumber()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("invalid JSON body: %w", err)
+	}
+	return nil
+}
+
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+func resourceURL(res *Resource, base string) string {
+	return base + "/v1/" + res.Type + "/" + res.ID
+}
+
+func resourceObject(res *Resource, base string) map[string]any {
+	attributes := res.Attributes
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+	obj := map[string]any{
+		"type":       res.Type,
+		"id":         res.ID,
+		"attributes": attributes,
+		"links":      map[string]string{"self": resourceURL(res, base)},
+	}
+	if len(res.Relationships) > 0 {
+		obj["relationships"] = res.Relationships
+	}
+	return obj
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, title, detail string) {
+	writeJSON(w, status, map[string]any{
+		"errors": []map[string]string{{
+			"status": strconv.Itoa(status),
+			"code":   code,
+			"title":  title,
+			"detail": detail,
+		}},
+	})
+}
+
+func writeResourceNotFound(w http.ResponseWriter, resourceType, id string) {
+	writeError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", fmt.Sprintf("There is no resource of type '%s' with id '%s'", resourceType, id))
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "The request method is not allowed", fmt.Sprintf("%s is not allowed on %s", r.Method, r.URL.Path))
+}
@@ -0,0 +1,276 @@
+package mockserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func writeTestKey(t *testing.T) (string, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "AuthKey_TEST.p8")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	return path, key
+}
+
+// startMockServer serves a seeded mock and points asc clients at it.
+func startMockServer(t *testing.T, opts Options) (*Server, *asc.Client) {
+	t.Helper()
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	keyPath, _ := writeTestKey(t)
+	if opts.DataDir == "" {
+		opts.DataDir = t.TempDir()
+	}
+	if opts.Seed == nil {
+		opts.Seed = DefaultSeed()
+	}
+	server, err := New(opts)
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	t.Setenv("ASC_BASE_URL", httpServer.URL)
+
+	client, err := asc.NewClientWithHTTPClient("KEY123", "ISS456", keyPath, httpServer.Client())
+	if err != nil {
+		t.Fatalf("NewClientWithHTTPClient() error: %v", err)
+	}
+	return server, client
+}
+
+func TestMockServerTestFlightAndReviewFlow(t *testing.T) {
+	_, client := startMockServer(t, Options{})
+	ctx := context.Background()
+
+	apps, err := client.GetApps(ctx)
+	if err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if len(apps.Data) != 1 || apps.Data[0].Attributes.BundleID != "com.example.mock" {
+		t.Fatalf("unexpected apps: %+v", apps.Data)
+	}
+	appID := apps.Data[0].ID
+
+	group, err := client.CreateBetaGroup(ctx, appID, "External")
+	if err != nil {
+		t.Fatalf("CreateBetaGroup() error: %v", err)
+	}
+	if _, err := client.CreateBetaTester(ctx, "tester@example.com", "Test", "Er", []string{group.Data.ID}); err != nil {
+		t.Fatalf("CreateBetaTester() error: %v", err)
+	}
+
+	groupTesters, err := client.GetBetaGroupTesters(ctx, group.Data.ID)
+	if err != nil {
+		t.Fatalf("GetBetaGroupTesters() error: %v", err)
+	}
+	if len(groupTesters.Data) != 1 || groupTesters.Data[0].Attributes.Email != "tester@example.com" {
+		t.Fatalf("unexpected group testers: %+v", groupTesters.Data)
+	}
+	appTesters, err := client.GetBetaTesters(ctx, appID)
+	if err != nil {
+		t.Fatalf("GetBetaTesters() error: %v", err)
+	}
+	if len(appTesters.Data) != 1 {
+		t.Fatalf("expected app filter to find the tester through its group, got %d", len(appTesters.Data))
+	}
+
+	versions, err := client.GetAppStoreVersions(ctx, appID)
+	if err != nil {
+		t.Fatalf("GetAppStoreVersions() error: %v", err)
+	}
+	if len(versions.Data) != 1 || versions.Data[0].Attributes.VersionString != "1.0" {
+		t.Fatalf("unexpected versions: %+v", versions.Data)
+	}
+	localizations, err := client.GetAppStoreVersionLocalizations(ctx, versions.Data[0].ID)
+	if err != nil {
+		t.Fatalf("GetAppStoreVersionLocalizations() error: %v", err)
+	}
+	if len(localizations.Data) != 1 || localizations.Data[0].Attributes.Locale != "en-US" {
+		t.Fatalf("unexpected localizations: %+v", localizations.Data)
+	}
+
+	submission, err := client.CreateReviewSubmission(ctx, appID, asc.PlatformIOS)
+	if err != nil {
+		t.Fatalf("CreateReviewSubmission() error: %v", err)
+	}
+	if submission.Data.Attributes.SubmissionState != "READY_FOR_REVIEW" {
+		t.Fatalf("expected READY_FOR_REVIEW, got %q", submission.Data.Attributes.SubmissionState)
+	}
+	submitted, err := client.SubmitReviewSubmission(ctx, submission.Data.ID)
+	if err != nil {
+		t.Fatalf("SubmitReviewSubmission() error: %v", err)
+	}
+	if submitted.Data.Attributes.SubmissionState != "WAITING_FOR_REVIEW" {
+		t.Fatalf("expected WAITING_FOR_REVIEW, got %q", submitted.Data.Attributes.SubmissionState)
+	}
+
+	if _, err := client.GetApp(ctx, "missing"); !asc.IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestMockServerBuildUploadStoresFileAndCreatesBuild(t *testing.T) {
+	server, client := startMockServer(t, Options{})
+	ctx := context.Background()
+	appID := DefaultSeed()[0].ID
+
+	upload, err := client.CreateBuildUpload(ctx, asc.BuildUploadCreateRequest{
+		Data: asc.BuildUploadCreateData{
+			Type: asc.ResourceTypeBuildUploads,
+			Attributes: asc.BuildUploadAttributes{
+				CFBundleShortVersionString: "1.0",
+				CFBundleVersion:            "42",
+				Platform:                   asc.PlatformIOS,
+			},
+			Relationships: &asc.BuildUploadRelationships{
+				App: &asc.Relationship{Data: asc.ResourceData{Type: asc.ResourceTypeApps, ID: appID}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateBuildUpload() error: %v", err)
+	}
+
+	payload := []byte("fake ipa contents")
+	ipaPath := filepath.Join(t.TempDir(), "App.ipa")
+	if err := os.WriteFile(ipaPath, payload, 0o644); err != nil {
+		t.Fatalf("write ipa: %v", err)
+	}
+	file, err := client.CreateBuildUploadFile(ctx, asc.BuildUploadFileCreateRequest{
+		Data: asc.BuildUploadFileCreateData{
+			Type: asc.ResourceTypeBuildUploadFiles,
+			Attributes: asc.BuildUploadFileAttributes{
+				FileName: "App.ipa",
+				FileSize: int64(len(payload)),
+				UTI:      asc.UTIIPA,
+			},
+			Relationships: &asc.BuildUploadFileRelationships{
+				BuildUpload: &asc.Relationship{Data: asc.ResourceData{Type: asc.ResourceTypeBuildUploads, ID: upload.Data.ID}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateBuildUploadFile() error: %v", err)
+	}
+	if len(file.Data.Attributes.UploadOperations) != 1 {
+		t.Fatalf("expected one upload operation, got %+v", file.Data.Attributes.UploadOperations)
+	}
+	if err := asc.ExecuteUploadOperations(ctx, ipaPath, file.Data.Attributes.UploadOperations); err != nil {
+		t.Fatalf("ExecuteUploadOperations() error: %v", err)
+	}
+
+	uploaded := true
+	if _, err := client.UpdateBuildUploadFile(ctx, file.Data.ID, asc.BuildUploadFileUpdateRequest{
+		Data: asc.BuildUploadFileUpdateData{
+			Type:       asc.ResourceTypeBuildUploadFiles,
+			ID:         file.Data.ID,
+			Attributes: &asc.BuildUploadFileUpdateAttributes{Uploaded: &uploaded},
+		},
+	}); err != nil {
+		t.Fatalf("UpdateBuildUploadFile() error: %v", err)
+	}
+
+	stored, err := os.ReadFile(filepath.Join(server.DataDir(), "uploads", "buildUploadFiles", file.Data.ID, "App.ipa"))
+	if err != nil {
+		t.Fatalf("read stored upload: %v", err)
+	}
+	if string(stored) != string(payload) {
+		t.Fatalf("stored upload = %q, want %q", stored, payload)
+	}
+
+	builds, err := client.GetBuilds(ctx, appID)
+	if err != nil {
+		t.Fatalf("GetBuilds() error: %v", err)
+	}
+	if len(builds.Data) != 1 || builds.Data[0].Attributes.Version != "42" || builds.Data[0].Attributes.ProcessingState != "VALID" {
+		t.Fatalf("unexpected builds: %+v", builds.Data)
+	}
+}
+
+func TestMockServerPaginatesWithNextLinks(t *testing.T) {
+	seed := []Resource{
+		{Type: "apps", ID: "1", Attributes: map[string]any{"name": "One"}},
+		{Type: "apps", ID: "2", Attributes: map[string]any{"name": "Two"}},
+		{Type: "apps", ID: "3", Attributes: map[string]any{"name": "Three"}},
+	}
+	_, client := startMockServer(t, Options{Seed: seed})
+	ctx := context.Background()
+
+	first, err := client.GetApps(ctx, asc.WithAppsLimit(2))
+	if err != nil {
+		t.Fatalf("GetApps() error: %v", err)
+	}
+	if len(first.Data) != 2 || first.Links.Next == "" {
+		t.Fatalf("expected a first page with a next link, got %d items and next %q", len(first.Data), first.Links.Next)
+	}
+	all, err := asc.PaginateAll(ctx, first, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetApps(ctx, asc.WithAppsNextURL(nextURL))
+	})
+	if err != nil {
+		t.Fatalf("PaginateAll() error: %v", err)
+	}
+	if got := len(all.(*asc.AppsResponse).Data); got != 3 {
+		t.Fatalf("expected 3 apps across pages, got %d", got)
+	}
+}
+
+func TestMockServerRejectsInvalidTokens(t *testing.T) {
+	_, verifyKey := writeTestKey(t)
+	server, client := startMockServer(t, Options{VerifyKey: &verifyKey.PublicKey})
+
+	if _, err := client.GetApps(context.Background()); err == nil {
+		t.Fatal("expected token signed by a different key to be rejected")
+	}
+
+	for _, header := range []string{"", "Bearer not-a-jwt"} {
+		req := httptest.NewRequest(http.MethodGet, "/v1/apps", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("Authorization %q: expected 401, got %d", header, rec.Code)
+		}
+	}
+}
+
+func TestNewRejectsInvalidSeed(t *testing.T) {
+	tests := []struct {
+		name string
+		seed []Resource
+	}{
+		{name: "unsupported type", seed: []Resource{{Type: "bundleIds", ID: "1"}}},
+		{name: "missing id", seed: []Resource{{Type: "apps"}}},
+		{name: "duplicate", seed: []Resource{{Type: "apps", ID: "1"}, {Type: "apps", ID: "1"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := New(Options{DataDir: t.TempDir(), Seed: test.seed}); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
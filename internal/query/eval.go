@@ -0,0 +1,365 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+type node interface {
+	eval(value any) (any, error)
+}
+
+type currentNode struct{}
+
+func (currentNode) eval(value any) (any, error) {
+	return value, nil
+}
+
+type literalNode struct {
+	value any
+}
+
+func (n literalNode) eval(any) (any, error) {
+	return n.value, nil
+}
+
+type fieldNode struct {
+	name string
+}
+
+func (n fieldNode) eval(value any) (any, error) {
+	object, ok := value.(*Object)
+	if !ok {
+		return nil, nil
+	}
+	field, _ := object.Get(n.name)
+	return field, nil
+}
+
+type subNode struct {
+	left node
+	step step
+}
+
+func (n subNode) eval(value any) (any, error) {
+	left, err := n.left.eval(value)
+	if err != nil || left == nil {
+		return nil, err
+	}
+	switch {
+	case n.step.index != nil:
+		list, ok := left.([]any)
+		if !ok {
+			return nil, nil
+		}
+		index := *n.step.index
+		if index < 0 {
+			index += len(list)
+		}
+		if index < 0 || index >= len(list) {
+			return nil, nil
+		}
+		return list[index], nil
+	case n.step.multi != nil:
+		return n.step.multi.eval(left)
+	default:
+		return fieldNode{name: n.step.field}.eval(left)
+	}
+}
+
+type projectionNode struct {
+	kind   string
+	left   node
+	filter node
+	right  node
+}
+
+func (n projectionNode) eval(value any) (any, error) {
+	left, err := n.left.eval(value)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := left.([]any)
+	if !ok {
+		return nil, nil
+	}
+	if n.kind == "[]" {
+		flattened := make([]any, 0, len(list))
+		for _, item := range list {
+			if inner, ok := item.([]any); ok {
+				flattened = append(flattened, inner...)
+			} else {
+				flattened = append(flattened, item)
+			}
+		}
+		list = flattened
+	}
+
+	results := make([]any, 0, len(list))
+	for _, item := range list {
+		if n.filter != nil {
+			matched, err := n.filter.eval(item)
+			if err != nil {
+				return nil, err
+			}
+			if !isTruthy(matched) {
+				continue
+			}
+		}
+		result, err := n.right.eval(item)
+		if err != nil {
+			return nil, err
+		}
+		if result != nil {
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+type pipeNode struct {
+	left, right node
+}
+
+func (n pipeNode) eval(value any) (any, error) {
+	left, err := n.left.eval(value)
+	if err != nil {
+		return nil, err
+	}
+	return n.right.eval(left)
+}
+
+type orNode struct {
+	left, right node
+}
+
+func (n orNode) eval(value any) (any, error) {
+	left, err := n.left.eval(value)
+	if err != nil || isTruthy(left) {
+		return left, err
+	}
+	return n.right.eval(value)
+}
+
+type andNode struct {
+	left, right node
+}
+
+func (n andNode) eval(value any) (any, error) {
+	left, err := n.left.eval(value)
+	if err != nil || !isTruthy(left) {
+		return left, err
+	}
+	return n.right.eval(value)
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n notNode) eval(value any) (any, error) {
+	operand, err := n.operand.eval(value)
+	if err != nil {
+		return nil, err
+	}
+	return !isTruthy(operand), nil
+}
+
+type compareNode struct {
+	op          string
+	left, right node
+}
+
+func (n compareNode) eval(value any) (any, error) {
+	left, err := n.left.eval(value)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(value)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "==":
+		return valuesEqual(left, right), nil
+	case "!=":
+		return !valuesEqual(left, right), nil
+	}
+
+	// Ordering compares numbers numerically and strings lexically; anything
+	// else is null, matching JMESPath.
+	var cmp int
+	if ln, ok := toNumber(left); ok {
+		rn, ok := toNumber(right)
+		if !ok {
+			return nil, nil
+		}
+		switch {
+		case ln < rn:
+			cmp = -1
+		case ln > rn:
+			cmp = 1
+		}
+	} else if ls, ok := left.(string); ok {
+		rs, ok := right.(string)
+		if !ok {
+			return nil, nil
+		}
+		cmp = strings.Compare(ls, rs)
+	} else {
+		return nil, nil
+	}
+	switch n.op {
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">":
+		return cmp > 0, nil
+	default:
+		return cmp >= 0, nil
+	}
+}
+
+type multiHashNode struct {
+	keys   []string
+	values []node
+}
+
+func (n multiHashNode) eval(value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	object := NewObject()
+	for i, key := range n.keys {
+		result, err := n.values[i].eval(value)
+		if err != nil {
+			return nil, err
+		}
+		object.Set(key, result)
+	}
+	return object, nil
+}
+
+type multiListNode struct {
+	values []node
+}
+
+func (n multiListNode) eval(value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	results := make([]any, 0, len(n.values))
+	for _, child := range n.values {
+		result, err := child.eval(value)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+type functionNode struct {
+	name string
+	fn   func(args []any) (any, error)
+	args []node
+}
+
+func (n functionNode) eval(value any) (any, error) {
+	args := make([]any, 0, len(n.args))
+	for _, arg := range n.args {
+		result, err := arg.eval(value)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, result)
+	}
+	result, err := n.fn(args)
+	if err != nil {
+		return nil, fmt.Errorf("%s(): %w", n.name, err)
+	}
+	return result, nil
+}
+
+type function struct {
+	arity int
+	call  func(args []any) (any, error)
+}
+
+var functions = map[string]function{
+	"length": {arity: 1, call: func(args []any) (any, error) {
+		switch v := args[0].(type) {
+		case string:
+			return json.Number(fmt.Sprint(utf8.RuneCountInString(v))), nil
+		case []any:
+			return json.Number(fmt.Sprint(len(v))), nil
+		case *Object:
+			return json.Number(fmt.Sprint(v.Len())), nil
+		default:
+			return nil, fmt.Errorf("expected string, array, or object")
+		}
+	}},
+	"contains": {arity: 2, call: func(args []any) (any, error) {
+		switch v := args[0].(type) {
+		case string:
+			needle, ok := args[1].(string)
+			return ok && strings.Contains(v, needle), nil
+		case []any:
+			for _, item := range v {
+				if valuesEqual(item, args[1]) {
+					return true, nil
+				}
+			}
+			return false, nil
+		case nil:
+			return false, nil
+		default:
+			return nil, fmt.Errorf("expected string or array")
+		}
+	}},
+	"starts_with": {arity: 2, call: func(args []any) (any, error) {
+		subject, prefix, err := stringArgs(args)
+		if err != nil || subject == nil {
+			return false, err
+		}
+		return strings.HasPrefix(*subject, prefix), nil
+	}},
+	"ends_with": {arity: 2, call: func(args []any) (any, error) {
+		subject, suffix, err := stringArgs(args)
+		if err != nil || subject == nil {
+			return false, err
+		}
+		return strings.HasSuffix(*subject, suffix), nil
+	}},
+	"keys": {arity: 1, call: func(args []any) (any, error) {
+		object, ok := args[0].(*Object)
+		if !ok {
+			return nil, fmt.Errorf("expected object")
+		}
+		keys := make([]any, 0, object.Len())
+		for _, key := range object.Keys() {
+			keys = append(keys, key)
+		}
+		return keys, nil
+	}},
+}
+
+// stringArgs returns a nil subject for a missing field, so filters such as
+// [?starts_with(name, 'x')] skip items without the field.
+func stringArgs(args []any) (*string, string, error) {
+	other, ok := args[1].(string)
+	if !ok {
+		return nil, "", fmt.Errorf("expected string arguments")
+	}
+	if args[0] == nil {
+		return nil, other, nil
+	}
+	subject, ok := args[0].(string)
+	if !ok {
+		return nil, "", fmt.Errorf("expected string arguments")
+	}
+	return &subject, other, nil
+}
@@ -0,0 +1,549 @@
+// Package query implements a JMESPath-style expression language over JSON
+// values, used by the --query output flag.
+//
+// Supported syntax:
+//
+//	field, "quoted field", @           identifiers and the current node
+//	a.b, a[0], a[-1]                   sub-expressions and indexes
+//	a[*].b, a[].b, a[?expr].b          list, flatten, and filter projections
+//	a.{id: id, v: b.c}, a.[x, y]       multiselect hash and list
+//	a | b                              pipes (stop projections)
+//	==, !=, <, <=, >, >=, &&, ||, !    comparisons and logic
+//	'raw string', `json`, 42           literals
+//	length, contains, starts_with,     functions
+//	ends_with, keys
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Query is a compiled expression.
+type Query struct {
+	source string
+	root   node
+}
+
+// Compile parses an expression.
+func Compile(expression string) (*Query, error) {
+	tokens, err := lex(expression)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %s at position %d", tok, tok.pos)
+	}
+	return &Query{source: expression, root: root}, nil
+}
+
+// String returns the source expression.
+func (q *Query) String() string {
+	return q.source
+}
+
+// Apply evaluates the query against value, which should come from Decode or FromValue.
+func (q *Query) Apply(value any) (any, error) {
+	return q.root.eval(value)
+}
+
+// ---- lexer ----
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenQuotedIdent
+	tokenRawString
+	tokenLiteral
+	tokenNumber
+	tokenDot
+	tokenStar
+	tokenAt
+	tokenComma
+	tokenColon
+	tokenPipe
+	tokenOr
+	tokenAnd
+	tokenNot
+	tokenLBracket
+	tokenRBracket
+	tokenLBrace
+	tokenRBrace
+	tokenLParen
+	tokenRParen
+	tokenQuestion
+	tokenCompare
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value any
+	pos   int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of expression"
+	}
+	return strconv.Quote(t.text)
+}
+
+func lex(input string) ([]token, error) {
+	var tokens []token
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+		case r == '_' || unicode.IsLetter(r):
+			for i < len(runes) && (runes[i] == '_' || unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[start:i]), pos: start})
+			continue
+		case r == '-' || unicode.IsDigit(r):
+			i++
+			for i < len(runes) && unicode.IsDigit(runes[i]) {
+				i++
+			}
+			text := string(runes[start:i])
+			if text == "-" {
+				return nil, fmt.Errorf("invalid number at position %d", start)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: text, value: json.Number(text), pos: start})
+			continue
+		case r == '"' || r == '\'' || r == '`':
+			end := i + 1
+			for end < len(runes) && runes[end] != r {
+				if runes[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf("unterminated %c at position %d", r, start)
+			}
+			body := string(runes[i+1 : end])
+			text := string(runes[start : end+1])
+			i = end + 1
+			switch r {
+			case '"':
+				var name string
+				if err := json.Unmarshal([]byte(text), &name); err != nil {
+					return nil, fmt.Errorf("invalid quoted identifier at position %d", start)
+				}
+				tokens = append(tokens, token{kind: tokenQuotedIdent, text: name, pos: start})
+			case '\'':
+				value := strings.ReplaceAll(body, `\'`, `'`)
+				tokens = append(tokens, token{kind: tokenRawString, text: text, value: value, pos: start})
+			case '`':
+				value, err := Decode([]byte(strings.ReplaceAll(body, "\\`", "`")))
+				if err != nil {
+					return nil, fmt.Errorf("invalid JSON literal at position %d: %w", start, err)
+				}
+				tokens = append(tokens, token{kind: tokenLiteral, text: text, value: value, pos: start})
+			}
+			continue
+		}
+
+		two := ""
+		if i+1 < len(runes) {
+			two = string(runes[i : i+2])
+		}
+		switch two {
+		case "||":
+			tokens = append(tokens, token{kind: tokenOr, text: two, pos: start})
+			i += 2
+			continue
+		case "&&":
+			tokens = append(tokens, token{kind: tokenAnd, text: two, pos: start})
+			i += 2
+			continue
+		case "==", "!=", "<=", ">=":
+			tokens = append(tokens, token{kind: tokenCompare, text: two, pos: start})
+			i += 2
+			continue
+		}
+
+		kinds := map[rune]tokenKind{
+			'.': tokenDot, '*': tokenStar, '@': tokenAt, ',': tokenComma, ':': tokenColon,
+			'|': tokenPipe, '!': tokenNot, '[': tokenLBracket, ']': tokenRBracket,
+			'{': tokenLBrace, '}': tokenRBrace, '(': tokenLParen, ')': tokenRParen,
+			'?': tokenQuestion, '<': tokenCompare, '>': tokenCompare,
+		}
+		kind, ok := kinds[r]
+		if !ok {
+			return nil, fmt.Errorf("unexpected character %q at position %d", r, start)
+		}
+		tokens = append(tokens, token{kind: kind, text: string(r), pos: start})
+		i++
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+// ---- parser ----
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) peekAt(offset int) token {
+	if p.pos+offset >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.pos+offset]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) expect(kind tokenKind, what string) (token, error) {
+	tok := p.next()
+	if tok.kind != kind {
+		return tok, fmt.Errorf("expected %s, got %s at position %d", what, tok, tok.pos)
+	}
+	return tok, nil
+}
+
+func (p *parser) parseExpression() (node, error) {
+	left, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenPipe {
+		p.next()
+		right, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		left = pipeNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	if p.peek().kind == tokenNot {
+		p.next()
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseChain()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokenCompare {
+		return left, nil
+	}
+	op := p.next().text
+	right, err := p.parseChain()
+	if err != nil {
+		return nil, err
+	}
+	return compareNode{op: op, left: left, right: right}, nil
+}
+
+// step is one postfix operation in a chain such as a.b[0][*].c.
+type step struct {
+	field      string
+	index      *int
+	projection string // "*", "[]", or "?"
+	filter     node
+	multi      node
+}
+
+func (p *parser) parseChain() (node, error) {
+	base, steps, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch p.peek().kind {
+		case tokenDot:
+			p.next()
+			s, err := p.parseDotStep()
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, s)
+		case tokenLBracket:
+			s, err := p.parseBracketStep()
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, s)
+		default:
+			return buildChain(base, steps), nil
+		}
+	}
+}
+
+func (p *parser) parseDotStep() (step, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokenIdent, tokenQuotedIdent:
+		p.next()
+		return step{field: tok.text}, nil
+	case tokenLBrace:
+		multi, err := p.parseMultiHash()
+		return step{multi: multi}, err
+	case tokenLBracket:
+		p.next()
+		multi, err := p.parseMultiList()
+		return step{multi: multi}, err
+	case tokenStar:
+		return step{}, fmt.Errorf("object wildcard .* is not supported (position %d)", tok.pos)
+	default:
+		return step{}, fmt.Errorf("expected field name after '.', got %s at position %d", tok, tok.pos)
+	}
+}
+
+func (p *parser) parseBracketStep() (step, error) {
+	open := p.next()
+	tok := p.next()
+	switch tok.kind {
+	case tokenStar:
+		if _, err := p.expect(tokenRBracket, "']'"); err != nil {
+			return step{}, err
+		}
+		return step{projection: "*"}, nil
+	case tokenRBracket:
+		return step{projection: "[]"}, nil
+	case tokenQuestion:
+		filter, err := p.parseExpression()
+		if err != nil {
+			return step{}, err
+		}
+		if _, err := p.expect(tokenRBracket, "']'"); err != nil {
+			return step{}, err
+		}
+		return step{projection: "?", filter: filter}, nil
+	case tokenNumber:
+		index, err := strconv.Atoi(tok.text)
+		if err != nil {
+			return step{}, fmt.Errorf("invalid index %s at position %d", tok, tok.pos)
+		}
+		if next := p.peek(); next.kind == tokenColon {
+			return step{}, fmt.Errorf("slices are not supported (position %d)", next.pos)
+		}
+		if _, err := p.expect(tokenRBracket, "']'"); err != nil {
+			return step{}, err
+		}
+		return step{index: &index}, nil
+	default:
+		return step{}, fmt.Errorf("unexpected %s after '[' at position %d", tok, open.pos)
+	}
+}
+
+// parsePrimary returns the base node plus any steps implied by a leading
+// bracket, e.g. "[*].id" is @ followed by a list projection.
+func (p *parser) parsePrimary() (node, []step, error) {
+	tok := p.peek()
+	switch tok.kind {
+	case tokenIdent:
+		if p.peekAt(1).kind == tokenLParen {
+			call, err := p.parseFunction()
+			return call, nil, err
+		}
+		p.next()
+		return fieldNode{name: tok.text}, nil, nil
+	case tokenQuotedIdent:
+		p.next()
+		return fieldNode{name: tok.text}, nil, nil
+	case tokenAt:
+		p.next()
+		return currentNode{}, nil, nil
+	case tokenRawString, tokenLiteral, tokenNumber:
+		p.next()
+		return literalNode{value: tok.value}, nil, nil
+	case tokenLParen:
+		p.next()
+		inner, err := p.parseExpression()
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := p.expect(tokenRParen, "')'"); err != nil {
+			return nil, nil, err
+		}
+		return inner, nil, nil
+	case tokenLBrace:
+		multi, err := p.parseMultiHash()
+		return multi, nil, err
+	case tokenLBracket:
+		switch p.peekAt(1).kind {
+		case tokenStar, tokenRBracket, tokenQuestion, tokenNumber:
+			s, err := p.parseBracketStep()
+			if err != nil {
+				return nil, nil, err
+			}
+			return currentNode{}, []step{s}, nil
+		default:
+			p.next()
+			multi, err := p.parseMultiList()
+			return multi, nil, err
+		}
+	default:
+		return nil, nil, fmt.Errorf("unexpected %s at position %d", tok, tok.pos)
+	}
+}
+
+func (p *parser) parseMultiHash() (node, error) {
+	if _, err := p.expect(tokenLBrace, "'{'"); err != nil {
+		return nil, err
+	}
+	var keys []string
+	var values []node
+	for {
+		keyTok := p.next()
+		if keyTok.kind != tokenIdent && keyTok.kind != tokenQuotedIdent {
+			return nil, fmt.Errorf("expected key in multiselect hash, got %s at position %d", keyTok, keyTok.pos)
+		}
+		if _, err := p.expect(tokenColon, "':'"); err != nil {
+			return nil, err
+		}
+		value, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, keyTok.text)
+		values = append(values, value)
+		tok := p.next()
+		if tok.kind == tokenRBrace {
+			return multiHashNode{keys: keys, values: values}, nil
+		}
+		if tok.kind != tokenComma {
+			return nil, fmt.Errorf("expected ',' or '}', got %s at position %d", tok, tok.pos)
+		}
+	}
+}
+
+// parseMultiList parses the remainder of "[a, b]" after the opening bracket.
+func (p *parser) parseMultiList() (node, error) {
+	var values []node
+	for {
+		value, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		tok := p.next()
+		if tok.kind == tokenRBracket {
+			return multiListNode{values: values}, nil
+		}
+		if tok.kind != tokenComma {
+			return nil, fmt.Errorf("expected ',' or ']', got %s at position %d", tok, tok.pos)
+		}
+	}
+}
+
+func (p *parser) parseFunction() (node, error) {
+	nameTok := p.next()
+	p.next() // (
+	fn, ok := functions[nameTok.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %s at position %d", nameTok, nameTok.pos)
+	}
+	var args []node
+	if p.peek().kind == tokenRParen {
+		p.next()
+	} else {
+		for {
+			arg, err := p.parseExpression()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			tok := p.next()
+			if tok.kind == tokenRParen {
+				break
+			}
+			if tok.kind != tokenComma {
+				return nil, fmt.Errorf("expected ',' or ')', got %s at position %d", tok, tok.pos)
+			}
+		}
+	}
+	if len(args) != fn.arity {
+		return nil, fmt.Errorf("%s() takes %d argument(s), got %d", nameTok.text, fn.arity, len(args))
+	}
+	return functionNode{name: nameTok.text, fn: fn.call, args: args}, nil
+}
+
+// buildChain applies steps to base. A projection step consumes the rest of
+// the chain, which is evaluated against each projected element.
+func buildChain(base node, steps []step) node {
+	current := base
+	for i, s := range steps {
+		if s.projection != "" {
+			return projectionNode{
+				kind:   s.projection,
+				left:   current,
+				filter: s.filter,
+				right:  buildChain(currentNode{}, steps[i+1:]),
+			}
+		}
+		current = subNode{left: current, step: s}
+	}
+	return current
+}
@@ -0,0 +1,135 @@
+package query
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const sampleDocument = `{
+  "data": [
+    {"id": "1", "type": "builds", "attributes": {"version": "10", "processingState": "VALID", "expired": false, "size": 120}},
+    {"id": "2", "type": "builds", "attributes": {"version": "11", "processingState": "PROCESSING", "expired": false, "size": 80}},
+    {"id": "3", "type": "builds", "attributes": {"version": "12", "processingState": "VALID", "expired": true, "size": 200}}
+  ],
+  "links": {"self": "https://example.com"},
+  "tags": [["a", "b"], ["c"]]
+}`
+
+func mustDecode(t *testing.T, data string) any {
+	t.Helper()
+	value, err := Decode([]byte(data))
+	if err != nil {
+		t.Fatalf("Decode() error: %v", err)
+	}
+	return value
+}
+
+func applyJSON(t *testing.T, expression string, document any) string {
+	t.Helper()
+	q, err := Compile(expression)
+	if err != nil {
+		t.Fatalf("Compile(%q) error: %v", expression, err)
+	}
+	result, err := q.Apply(document)
+	if err != nil {
+		t.Fatalf("Apply(%q) error: %v", expression, err)
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	return string(data)
+}
+
+func TestQueryApply(t *testing.T) {
+	document := mustDecode(t, sampleDocument)
+	tests := []struct {
+		expression string
+		want       string
+	}{
+		{`links.self`, `"https://example.com"`},
+		{`data[0].id`, `"1"`},
+		{`data[-1].id`, `"3"`},
+		{`data[5].id`, `null`},
+		{`missing.field`, `null`},
+		{`data[*].id`, `["1","2","3"]`},
+		{`data[*].attributes.missing`, `[]`},
+		{`data[?attributes.processingState == 'VALID'].id`, `["1","3"]`},
+		{`data[?attributes.processingState == 'VALID' && !attributes.expired].id`, `["1"]`},
+		{`data[?attributes.size > ` + "`100`" + `].attributes.version`, `["10","12"]`},
+		{`data[?starts_with(attributes.version, '1')] | length(@)`, `3`},
+		{`data[*].{id: id, version: attributes.version}`, `[{"id":"1","version":"10"},{"id":"2","version":"11"},{"id":"3","version":"12"}]`},
+		{`data[0].[id, type]`, `["1","builds"]`},
+		{`tags[]`, `["a","b","c"]`},
+		{`data[*].id | [0]`, `"1"`},
+		{`keys(links)`, `["self"]`},
+		{`contains(data[*].id, '2')`, `true`},
+		{`missing || 'fallback'`, `"fallback"`},
+		{`"links".self`, `"https://example.com"`},
+	}
+	for _, test := range tests {
+		if got := applyJSON(t, test.expression, document); got != test.want {
+			t.Errorf("%s = %s, want %s", test.expression, got, test.want)
+		}
+	}
+}
+
+func TestQueryTopLevelArray(t *testing.T) {
+	document := mustDecode(t, `[{"name":"a","n":1},{"name":"b","n":2}]`)
+	if got := applyJSON(t, `[?n >= `+"`2`"+`].name`, document); got != `["b"]` {
+		t.Fatalf("unexpected result %s", got)
+	}
+	if got := applyJSON(t, `[*].name`, document); got != `["a","b"]` {
+		t.Fatalf("unexpected result %s", got)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, expression := range []string{
+		``,
+		`data[`,
+		`data[*`,
+		`data.`,
+		`data[0:2]`,
+		`unknown_fn(@)`,
+		`length(a, b)`,
+		`{id id}`,
+		`'unterminated`,
+		`data ^ x`,
+	} {
+		if _, err := Compile(expression); err == nil {
+			t.Errorf("Compile(%q) expected error", expression)
+		}
+	}
+}
+
+func TestObjectPreservesOrder(t *testing.T) {
+	value := mustDecode(t, `{"z":1,"a":{"y":true,"b":null}}`)
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	if string(data) != `{"z":1,"a":{"y":true,"b":null}}` {
+		t.Fatalf("unexpected encoding %s", data)
+	}
+}
+
+func TestLookupAndSetPath(t *testing.T) {
+	value := mustDecode(t, sampleDocument)
+	item := Lookup(value, "links.self")
+	if item != "https://example.com" {
+		t.Fatalf("Lookup() = %v", item)
+	}
+
+	object := NewObject()
+	SetPath(object, "attributes.version", "1.0")
+	SetPath(object, "id", "X")
+	SetPath(object, "attributes.state", "READY")
+	data, err := json.Marshal(object)
+	if err != nil {
+		t.Fatalf("Marshal() error: %v", err)
+	}
+	if string(data) != `{"attributes":{"version":"1.0","state":"READY"},"id":"X"}` {
+		t.Fatalf("unexpected object %s", data)
+	}
+}
@@ -0,0 +1,277 @@
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Object is a JSON object that preserves key order, so projected output and
+// table columns follow the order of the source document or the query.
+type Object struct {
+	keys   []string
+	values map[string]any
+}
+
+// NewObject returns an empty Object.
+func NewObject() *Object {
+	return &Object{values: map[string]any{}}
+}
+
+// Get returns the value stored under key.
+func (o *Object) Get(key string) (any, bool) {
+	if o == nil {
+		return nil, false
+	}
+	value, ok := o.values[key]
+	return value, ok
+}
+
+// Set stores value under key, appending key if it is new.
+func (o *Object) Set(key string, value any) {
+	if _, exists := o.values[key]; !exists {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+// Keys returns the keys in insertion order.
+func (o *Object) Keys() []string {
+	if o == nil {
+		return nil
+	}
+	return append([]string(nil), o.keys...)
+}
+
+// Len returns the number of keys.
+func (o *Object) Len() int {
+	if o == nil {
+		return 0
+	}
+	return len(o.keys)
+}
+
+// MarshalJSON encodes the object with keys in insertion order.
+func (o *Object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.Keys() {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		encodedValue, err := json.Marshal(o.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encodedKey)
+		buf.WriteByte(':')
+		buf.Write(encodedValue)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Decode parses JSON into query values: *Object, []any, string,
+// json.Number, bool, or nil.
+func Decode(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	value, err := decodeValue(decoder)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return value, nil
+}
+
+// FromValue converts v to query values via its JSON encoding.
+func FromValue(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return Decode(data)
+}
+
+func decodeValue(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t := token.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			object := NewObject()
+			for decoder.More() {
+				keyToken, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+				key, ok := keyToken.(string)
+				if !ok {
+					return nil, fmt.Errorf("invalid object key %v", keyToken)
+				}
+				value, err := decodeValue(decoder)
+				if err != nil {
+					return nil, err
+				}
+				object.Set(key, value)
+			}
+			if _, err := decoder.Token(); err != nil {
+				return nil, err
+			}
+			return object, nil
+		case '[':
+			values := make([]any, 0)
+			for decoder.More() {
+				value, err := decodeValue(decoder)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, value)
+			}
+			if _, err := decoder.Token(); err != nil {
+				return nil, err
+			}
+			return values, nil
+		default:
+			return nil, fmt.Errorf("unexpected delimiter %q", t)
+		}
+	default:
+		return token, nil
+	}
+}
+
+// Lookup returns the value at a dotted path such as "attributes.version",
+// or nil when any segment is missing.
+func Lookup(value any, path string) any {
+	current := value
+	for _, segment := range strings.Split(path, ".") {
+		object, ok := current.(*Object)
+		if !ok {
+			return nil
+		}
+		current, _ = object.Get(segment)
+	}
+	return current
+}
+
+// SetPath stores value at a dotted path, creating intermediate objects.
+func SetPath(object *Object, path string, value any) {
+	segments := strings.Split(path, ".")
+	current := object
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current.Get(segment)
+		child, isObject := next.(*Object)
+		if !ok || !isObject {
+			child = NewObject()
+			current.Set(segment, child)
+		}
+		current = child
+	}
+	current.Set(segments[len(segments)-1], value)
+}
+
+// String formats a value for a table cell: scalars as text, nested values as compact JSON.
+func String(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
+
+func isTruthy(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case []any:
+		return len(v) > 0
+	case *Object:
+		return v.Len() > 0
+	default:
+		return true
+	}
+}
+
+func toNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func valuesEqual(a, b any) bool {
+	if an, ok := toNumber(a); ok {
+		bn, ok := toNumber(b)
+		return ok && an == bn
+	}
+	switch av := a.(type) {
+	case nil:
+		return b == nil
+	case string:
+		bv, ok := b.(string)
+		return ok && av == bv
+	case bool:
+		bv, ok := b.(bool)
+		return ok && av == bv
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !valuesEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case *Object:
+		bv, ok := b.(*Object)
+		if !ok || av.Len() != bv.Len() {
+			return false
+		}
+		for _, key := range av.keys {
+			other, exists := bv.Get(key)
+			if !exists || !valuesEqual(av.values[key], other) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,322 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProductReport is the validation output for in-app purchases and subscriptions.
+type ProductReport struct {
+	AppID   string        `json:"appId"`
+	Scope   string        `json:"scope"`
+	Summary Summary       `json:"summary"`
+	Checks  []CheckResult `json:"checks"`
+	Strict  bool          `json:"strict,omitempty"`
+}
+
+// Product report scopes.
+const (
+	ScopeIAP           = "iap"
+	ScopeSubscriptions = "subscriptions"
+)
+
+// ProductLocalization represents an in-app purchase, subscription, or
+// subscription group localization.
+type ProductLocalization struct {
+	ID          string
+	Locale      string
+	Name        string
+	Description string
+}
+
+// ProductAvailability represents a product's territory availability.
+type ProductAvailability struct {
+	ID             string
+	TerritoryCount int
+}
+
+// InAppPurchase represents an in-app purchase and the pieces review requires.
+type InAppPurchase struct {
+	ID                  string
+	Name                string
+	ProductID           string
+	Type                string
+	State               string
+	Localizations       []ProductLocalization
+	HasReviewScreenshot bool
+	// PriceScheduleID is empty when no price schedule exists.
+	PriceScheduleID  string
+	ManualPriceCount int
+	// Availability is nil when availability has not been configured.
+	Availability *ProductAvailability
+}
+
+// SubscriptionGroup represents a subscription group and its subscriptions.
+type SubscriptionGroup struct {
+	ID            string
+	ReferenceName string
+	Localizations []ProductLocalization
+	Subscriptions []Subscription
+}
+
+// Subscription represents an auto-renewable subscription and the pieces review requires.
+type Subscription struct {
+	ID                  string
+	Name                string
+	ProductID           string
+	State               string
+	Period              string
+	Localizations       []ProductLocalization
+	HasReviewScreenshot bool
+	PriceCount          int
+	// Availability is nil when availability has not been configured.
+	Availability *ProductAvailability
+}
+
+// IAPInput collects the in-app purchase validation inputs.
+type IAPInput struct {
+	AppID          string
+	PrimaryLocale  string
+	InAppPurchases []InAppPurchase
+}
+
+// SubscriptionInput collects the subscription validation inputs.
+type SubscriptionInput struct {
+	AppID         string
+	PrimaryLocale string
+	Groups        []SubscriptionGroup
+}
+
+// ValidateIAPs checks in-app purchases for missing submission requirements.
+func ValidateIAPs(input IAPInput, strict bool) ProductReport {
+	checks := make([]CheckResult, 0)
+	for _, iap := range input.InAppPurchases {
+		checks = append(checks, iapChecks(input.PrimaryLocale, iap)...)
+	}
+	return ProductReport{
+		AppID:   input.AppID,
+		Scope:   ScopeIAP,
+		Summary: summarize(checks, strict),
+		Checks:  checks,
+		Strict:  strict,
+	}
+}
+
+// ValidateSubscriptions checks subscription groups and subscriptions for
+// missing submission requirements.
+func ValidateSubscriptions(input SubscriptionInput, strict bool) ProductReport {
+	checks := make([]CheckResult, 0)
+	for _, group := range input.Groups {
+		checks = append(checks, subscriptionGroupChecks(input.PrimaryLocale, group)...)
+		for _, sub := range group.Subscriptions {
+			checks = append(checks, subscriptionChecks(input.PrimaryLocale, sub)...)
+		}
+	}
+	return ProductReport{
+		AppID:   input.AppID,
+		Scope:   ScopeSubscriptions,
+		Summary: summarize(checks, strict),
+		Checks:  checks,
+		Strict:  strict,
+	}
+}
+
+func iapChecks(primaryLocale string, iap InAppPurchase) []CheckResult {
+	const resourceType = "inAppPurchase"
+	label := productLabel(iap.Name, iap.ProductID)
+
+	checks := localizationChecks("iap", primaryLocale, label, "inAppPurchaseLocalization", resourceType, iap.ID, iap.Localizations, true)
+
+	if !iap.HasReviewScreenshot {
+		checks = append(checks, CheckResult{
+			ID:           "iap.review_screenshot.missing",
+			Severity:     SeverityError,
+			ResourceType: resourceType,
+			ResourceID:   iap.ID,
+			Message:      fmt.Sprintf("%s has no App Review screenshot", label),
+			Remediation:  fmt.Sprintf(`Upload one with: asc iap review-screenshots create --iap-id "%s" --file PATH`, iap.ID),
+		})
+	}
+
+	if iap.PriceScheduleID == "" || iap.ManualPriceCount == 0 {
+		checks = append(checks, CheckResult{
+			ID:           "iap.price_schedule.missing",
+			Severity:     SeverityError,
+			ResourceType: resourceType,
+			ResourceID:   iap.ID,
+			Message:      fmt.Sprintf("%s has no price schedule", label),
+			Remediation:  fmt.Sprintf(`Set a base price with: asc iap price-schedules create --iap-id "%s" --base-territory USA --prices PRICE_POINT_ID`, iap.ID),
+		})
+	}
+
+	checks = append(checks, availabilityChecks("iap", label, resourceType, iap.ID, iap.Availability,
+		fmt.Sprintf(`asc iap availability set --iap-id "%s" --territories USA`, iap.ID))...)
+	return checks
+}
+
+func subscriptionGroupChecks(primaryLocale string, group SubscriptionGroup) []CheckResult {
+	const resourceType = "subscriptionGroup"
+	label := productLabel(group.ReferenceName, "")
+
+	checks := localizationChecks("subscriptions.group", primaryLocale, "group "+label, "subscriptionGroupLocalization", resourceType, group.ID, group.Localizations, false)
+	if len(group.Subscriptions) == 0 {
+		checks = append(checks, CheckResult{
+			ID:           "subscriptions.group.empty",
+			Severity:     SeverityWarning,
+			ResourceType: resourceType,
+			ResourceID:   group.ID,
+			Message:      fmt.Sprintf("group %s has no subscriptions", label),
+			Remediation:  "Add a subscription to the group or delete the unused group",
+		})
+	}
+	return checks
+}
+
+func subscriptionChecks(primaryLocale string, sub Subscription) []CheckResult {
+	const resourceType = "subscription"
+	label := productLabel(sub.Name, sub.ProductID)
+
+	checks := localizationChecks("subscriptions", primaryLocale, label, "subscriptionLocalization", resourceType, sub.ID, sub.Localizations, true)
+
+	if strings.TrimSpace(sub.Period) == "" {
+		checks = append(checks, CheckResult{
+			ID:           "subscriptions.period.missing",
+			Severity:     SeverityError,
+			Field:        "subscriptionPeriod",
+			ResourceType: resourceType,
+			ResourceID:   sub.ID,
+			Message:      fmt.Sprintf("%s has no subscription duration", label),
+			Remediation:  fmt.Sprintf(`Set one with: asc subscriptions update --id "%s" --subscription-period ONE_MONTH`, sub.ID),
+		})
+	}
+
+	if !sub.HasReviewScreenshot {
+		checks = append(checks, CheckResult{
+			ID:           "subscriptions.review_screenshot.missing",
+			Severity:     SeverityError,
+			ResourceType: resourceType,
+			ResourceID:   sub.ID,
+			Message:      fmt.Sprintf("%s has no App Review screenshot", label),
+			Remediation:  fmt.Sprintf(`Upload one with: asc subscriptions review-screenshots create --subscription-id "%s" --file PATH`, sub.ID),
+		})
+	}
+
+	if sub.PriceCount == 0 {
+		checks = append(checks, CheckResult{
+			ID:           "subscriptions.prices.missing",
+			Severity:     SeverityError,
+			ResourceType: resourceType,
+			ResourceID:   sub.ID,
+			Message:      fmt.Sprintf("%s has no prices", label),
+			Remediation:  fmt.Sprintf(`Add a price with: asc subscriptions prices add --id "%s" --price-point PRICE_POINT_ID`, sub.ID),
+		})
+	}
+
+	checks = append(checks, availabilityChecks("subscriptions", label, resourceType, sub.ID, sub.Availability,
+		fmt.Sprintf(`asc subscriptions availability set --id "%s" --territory USA`, sub.ID))...)
+	return checks
+}
+
+// localizationChecks reports missing localizations, a missing primary-locale
+// localization, and empty display names (and descriptions when required).
+func localizationChecks(prefix, primaryLocale, label, locType, resourceType, resourceID string, locs []ProductLocalization, requireDescription bool) []CheckResult {
+	var checks []CheckResult
+	if len(locs) == 0 {
+		return append(checks, CheckResult{
+			ID:           prefix + ".localizations.missing",
+			Severity:     SeverityError,
+			ResourceType: resourceType,
+			ResourceID:   resourceID,
+			Message:      fmt.Sprintf("%s has no localizations", label),
+			Remediation:  "Add at least one localization with a display name",
+		})
+	}
+
+	if locale := strings.TrimSpace(primaryLocale); locale != "" {
+		found := false
+		for _, loc := range locs {
+			if strings.EqualFold(loc.Locale, locale) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			checks = append(checks, CheckResult{
+				ID:           prefix + ".localizations.primary_locale",
+				Severity:     SeverityWarning,
+				Locale:       locale,
+				ResourceType: resourceType,
+				ResourceID:   resourceID,
+				Message:      fmt.Sprintf("%s has no localization for the primary locale", label),
+				Remediation:  "Add a localization for the app's primary locale",
+			})
+		}
+	}
+
+	for _, loc := range locs {
+		if strings.TrimSpace(loc.Name) == "" {
+			checks = append(checks, CheckResult{
+				ID:           prefix + ".localizations.name",
+				Severity:     SeverityError,
+				Locale:       loc.Locale,
+				Field:        "name",
+				ResourceType: locType,
+				ResourceID:   loc.ID,
+				Message:      fmt.Sprintf("%s localization is missing a display name", label),
+				Remediation:  "Provide a display name for this localization",
+			})
+		}
+		if requireDescription && strings.TrimSpace(loc.Description) == "" {
+			checks = append(checks, CheckResult{
+				ID:           prefix + ".localizations.description",
+				Severity:     SeverityError,
+				Locale:       loc.Locale,
+				Field:        "description",
+				ResourceType: locType,
+				ResourceID:   loc.ID,
+				Message:      fmt.Sprintf("%s localization is missing a description", label),
+				Remediation:  "Provide a description for this localization",
+			})
+		}
+	}
+	return checks
+}
+
+func availabilityChecks(prefix, label, resourceType, resourceID string, availability *ProductAvailability, command string) []CheckResult {
+	if availability == nil {
+		return []CheckResult{{
+			ID:           prefix + ".availability.missing",
+			Severity:     SeverityError,
+			ResourceType: resourceType,
+			ResourceID:   resourceID,
+			Message:      fmt.Sprintf("%s has no availability configured", label),
+			Remediation:  "Set availability with: " + command,
+		}}
+	}
+	if availability.TerritoryCount == 0 {
+		return []CheckResult{{
+			ID:           prefix + ".availability.no_territories",
+			Severity:     SeverityError,
+			ResourceType: resourceType,
+			ResourceID:   resourceID,
+			Message:      fmt.Sprintf("%s is not available in any territory", label),
+			Remediation:  "Add territories with: " + command,
+		}}
+	}
+	return nil
+}
+
+func productLabel(name, productID string) string {
+	name = strings.TrimSpace(name)
+	productID = strings.TrimSpace(productID)
+	switch {
+	case name != "" && productID != "":
+		return fmt.Sprintf("%s (%s)", name, productID)
+	case name != "":
+		return name
+	case productID != "":
+		return productID
+	default:
+		return "product"
+	}
+}
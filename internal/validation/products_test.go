@@ -0,0 +1,123 @@
+package validation
+
+import "testing"
+
+func completeIAP() InAppPurchase {
+	return InAppPurchase{
+		ID:                  "iap-1",
+		Name:                "Coins",
+		ProductID:           "com.example.coins",
+		Localizations:       []ProductLocalization{{ID: "loc-1", Locale: "en-US", Name: "Coins", Description: "A pile of coins"}},
+		HasReviewScreenshot: true,
+		PriceScheduleID:     "sched-1",
+		ManualPriceCount:    1,
+		Availability:        &ProductAvailability{ID: "avail-1", TerritoryCount: 175},
+	}
+}
+
+func completeSubscription() Subscription {
+	return Subscription{
+		ID:                  "sub-1",
+		Name:                "Monthly",
+		ProductID:           "com.example.monthly",
+		Period:              "ONE_MONTH",
+		Localizations:       []ProductLocalization{{ID: "loc-1", Locale: "en-US", Name: "Monthly", Description: "Monthly access"}},
+		HasReviewScreenshot: true,
+		PriceCount:          1,
+		Availability:        &ProductAvailability{ID: "avail-1", TerritoryCount: 1},
+	}
+}
+
+func TestValidateIAPs_Complete(t *testing.T) {
+	report := ValidateIAPs(IAPInput{AppID: "app-1", PrimaryLocale: "en-US", InAppPurchases: []InAppPurchase{completeIAP()}}, false)
+	if len(report.Checks) != 0 {
+		t.Fatalf("expected no checks, got %+v", report.Checks)
+	}
+	if report.Scope != ScopeIAP {
+		t.Fatalf("expected scope %q, got %q", ScopeIAP, report.Scope)
+	}
+}
+
+func TestValidateIAPs_MissingPieces(t *testing.T) {
+	iap := completeIAP()
+	iap.Localizations = []ProductLocalization{{ID: "loc-1", Locale: "de-DE", Name: "Münzen"}}
+	iap.HasReviewScreenshot = false
+	iap.PriceScheduleID = ""
+	iap.Availability = &ProductAvailability{ID: "avail-1"}
+
+	report := ValidateIAPs(IAPInput{AppID: "app-1", PrimaryLocale: "en-US", InAppPurchases: []InAppPurchase{iap}}, true)
+	for _, id := range []string{
+		"iap.localizations.primary_locale",
+		"iap.localizations.description",
+		"iap.review_screenshot.missing",
+		"iap.price_schedule.missing",
+		"iap.availability.no_territories",
+	} {
+		if !hasCheckID(report.Checks, id) {
+			t.Errorf("expected %s check, got %+v", id, report.Checks)
+		}
+	}
+	if report.Summary.Errors != 4 || report.Summary.Warnings != 1 || report.Summary.Blocking != 5 {
+		t.Fatalf("unexpected summary %+v", report.Summary)
+	}
+	for _, check := range report.Checks {
+		if check.Remediation == "" {
+			t.Errorf("expected remediation for %s", check.ID)
+		}
+	}
+}
+
+func TestValidateIAPs_NoLocalizationsOrAvailability(t *testing.T) {
+	iap := completeIAP()
+	iap.Localizations = nil
+	iap.Availability = nil
+
+	report := ValidateIAPs(IAPInput{InAppPurchases: []InAppPurchase{iap}}, false)
+	if !hasCheckID(report.Checks, "iap.localizations.missing") || !hasCheckID(report.Checks, "iap.availability.missing") {
+		t.Fatalf("unexpected checks %+v", report.Checks)
+	}
+}
+
+func TestValidateSubscriptions(t *testing.T) {
+	incomplete := completeSubscription()
+	incomplete.ID = "sub-2"
+	incomplete.Period = ""
+	incomplete.PriceCount = 0
+	incomplete.HasReviewScreenshot = false
+	incomplete.Localizations[0].Name = ""
+
+	report := ValidateSubscriptions(SubscriptionInput{
+		AppID:         "app-1",
+		PrimaryLocale: "en-US",
+		Groups: []SubscriptionGroup{
+			{
+				ID:            "group-1",
+				ReferenceName: "Premium",
+				Localizations: []ProductLocalization{{ID: "gloc-1", Locale: "en-US", Name: "Premium"}},
+				Subscriptions: []Subscription{completeSubscription(), incomplete},
+			},
+			{ID: "group-2", ReferenceName: "Empty"},
+		},
+	}, false)
+
+	for _, id := range []string{
+		"subscriptions.period.missing",
+		"subscriptions.prices.missing",
+		"subscriptions.review_screenshot.missing",
+		"subscriptions.localizations.name",
+		"subscriptions.group.localizations.missing",
+		"subscriptions.group.empty",
+	} {
+		if !hasCheckID(report.Checks, id) {
+			t.Errorf("expected %s check, got %+v", id, report.Checks)
+		}
+	}
+	for _, check := range report.Checks {
+		if check.ResourceID == "sub-1" {
+			t.Errorf("expected complete subscription to pass, got %+v", check)
+		}
+	}
+	if report.Summary.Warnings != 1 || report.Summary.Blocking != report.Summary.Errors {
+		t.Fatalf("unexpected summary %+v", report.Summary)
+	}
+}
@@ -7,6 +7,7 @@ func Validate(input Input, strict bool) Report {
 	checks = append(checks, requiredFieldChecks(input.PrimaryLocale, input.VersionLocalizations, input.AppInfoLocalizations)...)
 	checks = append(checks, screenshotChecks(input.Platform, input.ScreenshotSets)...)
 	checks = append(checks, ageRatingChecks(input.AgeRatingDeclaration)...)
+	checks = append(checks, customRuleChecks(input)...)
 
 	summary := summarize(checks, strict)
 
@@ -0,0 +1,411 @@
+package validation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultRulesPath is the repository-relative location of custom rules.
+const DefaultRulesPath = ".asc/validate.yaml"
+
+// Custom rule types.
+const (
+	RuleBannedWords      = "banned_words"
+	RuleRequiredKeywords = "required_keywords"
+	RuleURLPattern       = "url_pattern"
+	RuleWhatsNewVersion  = "whats_new_mentions_version"
+	RuleMinScreenshots   = "min_screenshots"
+)
+
+// RuleFile is the parsed contents of a validate.yaml file.
+type RuleFile struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Rule is a user-defined validation check. Which options apply depends on Type:
+//   - banned_words: Words must not appear in Fields (default: description).
+//   - required_keywords: Words must all appear in Fields (default: keywords).
+//   - url_pattern: URLs in Fields (default: supportUrl, marketingUrl) must match
+//     Pattern; Reachable also requires them to respond.
+//   - whats_new_mentions_version: What's New must mention the version string.
+//   - min_screenshots: each locale needs at least Min screenshots per display
+//     type (DisplayTypes, or every uploaded display type when empty).
+type Rule struct {
+	ID           string   `yaml:"id"`
+	Type         string   `yaml:"type"`
+	Severity     Severity `yaml:"severity"`
+	Message      string   `yaml:"message"`
+	Remediation  string   `yaml:"remediation"`
+	Locales      []string `yaml:"locales"`
+	Fields       []string `yaml:"fields"`
+	Words        []string `yaml:"words"`
+	Pattern      string   `yaml:"pattern"`
+	Reachable    bool     `yaml:"reachable"`
+	DisplayTypes []string `yaml:"display_types"`
+	Min          int      `yaml:"min"`
+
+	pattern *regexp.Regexp
+}
+
+// ruleFields lists the localized fields rules can inspect.
+var ruleFields = map[string]bool{
+	"description":     true,
+	"keywords":        true,
+	"whatsNew":        true,
+	"promotionalText": true,
+	"supportUrl":      true,
+	"marketingUrl":    true,
+	"name":            true,
+	"subtitle":        true,
+}
+
+// LoadRuleFile reads and validates custom rules from path.
+func LoadRuleFile(path string) (*RuleFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := ParseRuleFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return rules, nil
+}
+
+// ParseRuleFile parses and validates custom rules from YAML.
+func ParseRuleFile(data []byte) (*RuleFile, error) {
+	var file RuleFile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(file.Rules))
+	for i := range file.Rules {
+		rule := &file.Rules[i]
+		if err := rule.normalize(); err != nil {
+			if rule.ID != "" {
+				return nil, fmt.Errorf("rule %q: %w", rule.ID, err)
+			}
+			return nil, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		if seen[rule.ID] {
+			return nil, fmt.Errorf("duplicate rule id %q", rule.ID)
+		}
+		seen[rule.ID] = true
+	}
+	return &file, nil
+}
+
+func (r *Rule) normalize() error {
+	r.ID = strings.TrimSpace(r.ID)
+	if r.ID == "" {
+		return fmt.Errorf("id is required")
+	}
+
+	switch r.Severity {
+	case "":
+		r.Severity = SeverityError
+	case SeverityError, SeverityWarning, SeverityInfo:
+	default:
+		return fmt.Errorf("severity must be one of error, warning, info")
+	}
+
+	for _, field := range r.Fields {
+		if !ruleFields[field] {
+			return fmt.Errorf("unknown field %q", field)
+		}
+	}
+
+	switch r.Type {
+	case RuleBannedWords:
+		if len(r.Words) == 0 {
+			return fmt.Errorf("words is required")
+		}
+		if len(r.Fields) == 0 {
+			r.Fields = []string{"description"}
+		}
+	case RuleRequiredKeywords:
+		if len(r.Words) == 0 {
+			return fmt.Errorf("words is required")
+		}
+		if len(r.Fields) == 0 {
+			r.Fields = []string{"keywords"}
+		}
+	case RuleURLPattern:
+		if strings.TrimSpace(r.Pattern) == "" && !r.Reachable {
+			return fmt.Errorf("pattern or reachable is required")
+		}
+		if r.Pattern != "" {
+			compiled, err := regexp.Compile(r.Pattern)
+			if err != nil {
+				return fmt.Errorf("invalid pattern: %w", err)
+			}
+			r.pattern = compiled
+		}
+		if len(r.Fields) == 0 {
+			r.Fields = []string{"supportUrl", "marketingUrl"}
+		}
+	case RuleWhatsNewVersion:
+	case RuleMinScreenshots:
+		if r.Min <= 0 {
+			return fmt.Errorf("min must be greater than 0")
+		}
+	case "":
+		return fmt.Errorf("type is required")
+	default:
+		return fmt.Errorf("unknown type %q", r.Type)
+	}
+	return nil
+}
+
+func (r Rule) appliesToLocale(locale string) bool {
+	if len(r.Locales) == 0 {
+		return true
+	}
+	for _, candidate := range r.Locales {
+		if strings.EqualFold(candidate, locale) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Rule) result(message, remediation string) CheckResult {
+	if strings.TrimSpace(r.Message) != "" {
+		message = r.Message
+	}
+	if strings.TrimSpace(r.Remediation) != "" {
+		remediation = r.Remediation
+	}
+	return CheckResult{
+		ID:          "custom." + r.ID,
+		Severity:    r.Severity,
+		Message:     message,
+		Remediation: remediation,
+	}
+}
+
+type localizedField struct {
+	Locale       string
+	Field        string
+	ResourceType string
+	ResourceID   string
+	Value        string
+}
+
+func (f localizedField) attach(check CheckResult) CheckResult {
+	check.Locale = f.Locale
+	check.Field = f.Field
+	check.ResourceType = f.ResourceType
+	check.ResourceID = f.ResourceID
+	return check
+}
+
+func collectLocalizedFields(input Input, rule Rule) []localizedField {
+	var fields []localizedField
+	for _, loc := range input.VersionLocalizations {
+		if !rule.appliesToLocale(loc.Locale) {
+			continue
+		}
+		values := map[string]string{
+			"description":     loc.Description,
+			"keywords":        loc.Keywords,
+			"whatsNew":        loc.WhatsNew,
+			"promotionalText": loc.PromotionalText,
+			"supportUrl":      loc.SupportURL,
+			"marketingUrl":    loc.MarketingURL,
+		}
+		for _, field := range rule.Fields {
+			if value, ok := values[field]; ok {
+				fields = append(fields, localizedField{loc.Locale, field, "appStoreVersionLocalization", loc.ID, value})
+			}
+		}
+	}
+	for _, loc := range input.AppInfoLocalizations {
+		if !rule.appliesToLocale(loc.Locale) {
+			continue
+		}
+		values := map[string]string{"name": loc.Name, "subtitle": loc.Subtitle}
+		for _, field := range rule.Fields {
+			if value, ok := values[field]; ok {
+				fields = append(fields, localizedField{loc.Locale, field, "appInfoLocalization", loc.ID, value})
+			}
+		}
+	}
+	return fields
+}
+
+func customRuleChecks(input Input) []CheckResult {
+	var checks []CheckResult
+	for _, rule := range input.Rules {
+		switch rule.Type {
+		case RuleBannedWords:
+			checks = append(checks, bannedWordChecks(input, rule)...)
+		case RuleRequiredKeywords:
+			checks = append(checks, requiredKeywordChecks(input, rule)...)
+		case RuleURLPattern:
+			checks = append(checks, urlPatternChecks(input, rule)...)
+		case RuleWhatsNewVersion:
+			checks = append(checks, whatsNewVersionChecks(input, rule)...)
+		case RuleMinScreenshots:
+			checks = append(checks, minScreenshotChecks(input, rule)...)
+		}
+	}
+	return checks
+}
+
+func bannedWordChecks(input Input, rule Rule) []CheckResult {
+	var checks []CheckResult
+	for _, field := range collectLocalizedFields(input, rule) {
+		for _, word := range rule.Words {
+			if containsWord(field.Value, word) {
+				checks = append(checks, field.attach(rule.result(
+					fmt.Sprintf("%s contains banned word %q", field.Field, word),
+					fmt.Sprintf("Remove %q from %s", word, field.Field),
+				)))
+			}
+		}
+	}
+	return checks
+}
+
+func requiredKeywordChecks(input Input, rule Rule) []CheckResult {
+	var checks []CheckResult
+	for _, field := range collectLocalizedFields(input, rule) {
+		for _, word := range rule.Words {
+			if !containsKeyword(field.Field, field.Value, word) {
+				checks = append(checks, field.attach(rule.result(
+					fmt.Sprintf("%s is missing required keyword %q", field.Field, word),
+					fmt.Sprintf("Add %q to %s", word, field.Field),
+				)))
+			}
+		}
+	}
+	return checks
+}
+
+func urlPatternChecks(input Input, rule Rule) []CheckResult {
+	var checks []CheckResult
+	for _, field := range collectLocalizedFields(input, rule) {
+		url := strings.TrimSpace(field.Value)
+		if url == "" {
+			continue
+		}
+		if rule.pattern != nil && !rule.pattern.MatchString(url) {
+			checks = append(checks, field.attach(rule.result(
+				fmt.Sprintf("%s %s does not match %s", field.Field, url, rule.Pattern),
+				fmt.Sprintf("Update %s to match %s", field.Field, rule.Pattern),
+			)))
+			continue
+		}
+		if rule.Reachable && input.ProbeURL != nil {
+			if err := input.ProbeURL(url); err != nil {
+				checks = append(checks, field.attach(rule.result(
+					fmt.Sprintf("%s %s is not reachable: %v", field.Field, url, err),
+					fmt.Sprintf("Make sure %s responds successfully", url),
+				)))
+			}
+		}
+	}
+	return checks
+}
+
+func whatsNewVersionChecks(input Input, rule Rule) []CheckResult {
+	version := strings.TrimSpace(input.VersionString)
+	if version == "" {
+		return nil
+	}
+	rule.Fields = []string{"whatsNew"}
+
+	var checks []CheckResult
+	for _, field := range collectLocalizedFields(input, rule) {
+		if strings.TrimSpace(field.Value) == "" || strings.Contains(field.Value, version) {
+			continue
+		}
+		checks = append(checks, field.attach(rule.result(
+			fmt.Sprintf("what's new does not mention version %s", version),
+			fmt.Sprintf("Mention %s in the what's new text", version),
+		)))
+	}
+	return checks
+}
+
+func minScreenshotChecks(input Input, rule Rule) []CheckResult {
+	displayTypes := rule.DisplayTypes
+	if len(displayTypes) == 0 {
+		seen := map[string]bool{}
+		for _, set := range input.ScreenshotSets {
+			if set.DisplayType != "" && !seen[set.DisplayType] {
+				seen[set.DisplayType] = true
+				displayTypes = append(displayTypes, set.DisplayType)
+			}
+		}
+	}
+
+	var checks []CheckResult
+	for _, loc := range input.VersionLocalizations {
+		if !rule.appliesToLocale(loc.Locale) {
+			continue
+		}
+		for _, displayType := range displayTypes {
+			count := 0
+			setID := ""
+			for _, set := range input.ScreenshotSets {
+				if set.DisplayType == displayType && (set.LocalizationID == loc.ID || (set.LocalizationID == "" && set.Locale == loc.Locale)) {
+					count += len(set.Screenshots)
+					setID = set.ID
+				}
+			}
+			if count >= rule.Min {
+				continue
+			}
+			check := rule.result(
+				fmt.Sprintf("%s has %d screenshot(s), need at least %d", displayType, count, rule.Min),
+				fmt.Sprintf("Upload at least %d %s screenshots", rule.Min, displayType),
+			)
+			check.Locale = loc.Locale
+			if setID != "" {
+				check.ResourceType = "appScreenshotSet"
+				check.ResourceID = setID
+			} else {
+				check.ResourceType = "appStoreVersionLocalization"
+				check.ResourceID = loc.ID
+			}
+			checks = append(checks, check)
+		}
+	}
+	return checks
+}
+
+// containsWord reports whether word appears in text as a whole word, ignoring case.
+func containsWord(text, word string) bool {
+	word = strings.TrimSpace(word)
+	if word == "" {
+		return false
+	}
+	pattern := regexp.MustCompile(`(?i)(^|\W)` + regexp.QuoteMeta(word) + `($|\W)`)
+	return pattern.MatchString(text)
+}
+
+// containsKeyword matches comma-separated keyword lists entry by entry and
+// other fields as whole words.
+func containsKeyword(field, text, keyword string) bool {
+	if field != "keywords" {
+		return containsWord(text, keyword)
+	}
+	for _, entry := range strings.Split(text, ",") {
+		if strings.EqualFold(strings.TrimSpace(entry), strings.TrimSpace(keyword)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func mustParseRules(t *testing.T, data string) []Rule {
+	t.Helper()
+	file, err := ParseRuleFile([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseRuleFile() error: %v", err)
+	}
+	return file.Rules
+}
+
+func TestParseRuleFile_Defaults(t *testing.T) {
+	rules := mustParseRules(t, `
+rules:
+  - id: banned
+    type: banned_words
+    words: [free]
+  - id: urls
+    type: url_pattern
+    pattern: '^https://'
+`)
+	if rules[0].Severity != SeverityError {
+		t.Fatalf("expected default severity error, got %q", rules[0].Severity)
+	}
+	if len(rules[0].Fields) != 1 || rules[0].Fields[0] != "description" {
+		t.Fatalf("expected default description field, got %v", rules[0].Fields)
+	}
+	if len(rules[1].Fields) != 2 {
+		t.Fatalf("expected default URL fields, got %v", rules[1].Fields)
+	}
+}
+
+func TestParseRuleFile_Empty(t *testing.T) {
+	if rules := mustParseRules(t, ""); len(rules) != 0 {
+		t.Fatalf("expected no rules, got %d", len(rules))
+	}
+}
+
+func TestParseRuleFile_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"missing id":       "rules:\n  - type: banned_words\n    words: [x]\n",
+		"unknown type":     "rules:\n  - id: a\n    type: nope\n",
+		"bad severity":     "rules:\n  - id: a\n    type: banned_words\n    words: [x]\n    severity: fatal\n",
+		"unknown field":    "rules:\n  - id: a\n    type: banned_words\n    words: [x]\n    fields: [title]\n",
+		"missing words":    "rules:\n  - id: a\n    type: required_keywords\n",
+		"bad pattern":      "rules:\n  - id: a\n    type: url_pattern\n    pattern: '('\n",
+		"missing min":      "rules:\n  - id: a\n    type: min_screenshots\n",
+		"duplicate ids":    "rules:\n  - id: a\n    type: whats_new_mentions_version\n  - id: a\n    type: whats_new_mentions_version\n",
+		"unknown yaml key": "rules:\n  - id: a\n    type: whats_new_mentions_version\n    typo: true\n",
+	}
+	for name, data := range tests {
+		if _, err := ParseRuleFile([]byte(data)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestCustomRuleChecks(t *testing.T) {
+	rules := mustParseRules(t, `
+rules:
+  - id: no-android
+    type: banned_words
+    severity: warning
+    locales: [en-US]
+    fields: [description, subtitle]
+    words: [Android]
+  - id: keywords
+    type: required_keywords
+    words: [planner, todo]
+  - id: https
+    type: url_pattern
+    pattern: '^https://'
+  - id: whats-new
+    type: whats_new_mentions_version
+    message: Release notes must name the version
+  - id: screenshots
+    type: min_screenshots
+    display_types: [APP_IPHONE_67, APP_IPAD_PRO_3GEN_129]
+    min: 2
+`)
+	input := Input{
+		VersionString: "2.1",
+		VersionLocalizations: []VersionLocalization{
+			{ID: "loc-en", Locale: "en-US", Description: "Better than android apps", Keywords: "Planner, tasks", WhatsNew: "Bug fixes", SupportURL: "http://example.com"},
+			{ID: "loc-de", Locale: "de-DE", Description: "Android", Keywords: "planner,todo", WhatsNew: "Version 2.1"},
+		},
+		AppInfoLocalizations: []AppInfoLocalization{
+			{ID: "info-en", Locale: "en-US", Subtitle: "Not for androids"},
+		},
+		ScreenshotSets: []ScreenshotSet{
+			{ID: "set-en", DisplayType: "APP_IPHONE_67", Locale: "en-US", LocalizationID: "loc-en", Screenshots: []Screenshot{{ID: "1"}, {ID: "2"}}},
+			{ID: "set-de", DisplayType: "APP_IPHONE_67", Locale: "de-DE", LocalizationID: "loc-de", Screenshots: []Screenshot{{ID: "3"}}},
+		},
+		Rules: rules,
+	}
+
+	checks := customRuleChecks(input)
+	counts := map[string]int{}
+	for _, check := range checks {
+		counts[check.ID]++
+	}
+	want := map[string]int{
+		"custom.no-android":  1, // whole-word match only, en-US only
+		"custom.keywords":    1, // en-US is missing "todo"
+		"custom.https":       1,
+		"custom.whats-new":   1,
+		"custom.screenshots": 3, // de iPhone, en iPad, de iPad
+	}
+	for id, count := range want {
+		if counts[id] != count {
+			t.Errorf("expected %d %s checks, got %d (%+v)", count, id, counts[id], checks)
+		}
+	}
+
+	for _, check := range checks {
+		switch check.ID {
+		case "custom.no-android":
+			if check.Severity != SeverityWarning || check.Locale != "en-US" || check.Field != "description" {
+				t.Errorf("unexpected banned word check %+v", check)
+			}
+		case "custom.whats-new":
+			if check.Message != "Release notes must name the version" || check.ResourceID != "loc-en" {
+				t.Errorf("unexpected what's new check %+v", check)
+			}
+		}
+	}
+}
+
+func TestCustomRuleChecks_ReachableURLs(t *testing.T) {
+	rules := mustParseRules(t, "rules:\n  - id: reachable\n    type: url_pattern\n    reachable: true\n    fields: [supportUrl]\n")
+	input := Input{
+		VersionLocalizations: []VersionLocalization{
+			{ID: "a", Locale: "en-US", SupportURL: "https://ok.example.com"},
+			{ID: "b", Locale: "fr-FR", SupportURL: "https://down.example.com"},
+		},
+		Rules: rules,
+		ProbeURL: func(url string) error {
+			if strings.Contains(url, "down") {
+				return errors.New("HTTP 503")
+			}
+			return nil
+		},
+	}
+
+	checks := customRuleChecks(input)
+	if len(checks) != 1 || checks[0].ResourceID != "b" || !strings.Contains(checks[0].Message, "HTTP 503") {
+		t.Fatalf("unexpected checks %+v", checks)
+	}
+}
+
+func TestValidate_IncludesCustomRulesInSummary(t *testing.T) {
+	rules := mustParseRules(t, "rules:\n  - id: w\n    type: banned_words\n    severity: warning\n    words: [beta]\n")
+	report := Validate(Input{
+		VersionLocalizations: []VersionLocalization{{Locale: "en-US", Description: "beta build", Keywords: "k"}},
+		Rules:                rules,
+	}, true)
+	if !hasCheckID(report.Checks, "custom.w") {
+		t.Fatalf("expected custom check in report")
+	}
+	if report.Summary.Warnings == 0 || report.Summary.Blocking != report.Summary.Errors+report.Summary.Warnings {
+		t.Fatalf("unexpected summary %+v", report.Summary)
+	}
+}
@@ -51,6 +51,10 @@ type Input struct {
 	AppInfoLocalizations []AppInfoLocalization
 	ScreenshotSets       []ScreenshotSet
 	AgeRatingDeclaration *AgeRatingDeclaration
+	// Rules are custom checks loaded with LoadRuleFile or ParseRuleFile.
+	Rules []Rule
+	// ProbeURL checks URL reachability for url_pattern rules; nil skips the check.
+	ProbeURL func(url string) error
 }
 
 // VersionLocalization represents version-level metadata.